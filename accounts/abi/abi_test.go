@@ -19,6 +19,7 @@ package abi
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -1051,3 +1052,32 @@ func TestDoubleDuplicateMethodNames(t *testing.T) {
 		t.Fatalf("Should not have found extra method")
 	}
 }
+
+func TestUnpackRevert(t *testing.T) {
+	t.Parallel()
+
+	var cases = []struct {
+		input     string
+		expect    string
+		expectErr error
+	}{
+		{"", "", errors.New("invalid data for unpacking")},
+		{"08c379a1", "", errors.New("invalid data for unpacking")},
+		{"08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000d72657665727420726561736f6e00000000000000000000000000000000000000", "revert reason", nil},
+	}
+	for index, c := range cases {
+		got, err := UnpackRevert(common.Hex2Bytes(c.input))
+		if c.expectErr != nil {
+			if err == nil {
+				t.Fatalf("Expected non-nil error")
+			}
+			if err.Error() != c.expectErr.Error() {
+				t.Fatalf("Expected error mismatch, want %v, got %v", c.expectErr, err)
+			}
+			continue
+		}
+		if c.expect != got {
+			t.Fatalf("Output mismatch, index %d, want %s, got %s", index, c.expect, got)
+		}
+	}
+}
@@ -133,6 +133,7 @@ var (
 		utils.NodeKeyHexFlag,
 		utils.DeveloperFlag,
 		utils.DeveloperPeriodFlag,
+		utils.DeveloperOVMStateDumpFlag,
 		utils.TestnetFlag,
 		utils.RinkebyFlag,
 		utils.GoerliFlag,
@@ -158,6 +159,34 @@ var (
 		utils.TxIngestionPollIntervalFlag,
 		utils.TxIngestionSignerKeyHexFlag,
 		utils.TxIngestionSignerKeyFileFlag,
+		utils.TxIngestionL1EndpointFlag,
+		utils.TxIngestionL1QueueFlag,
+		utils.TxIngestionL1StartBlockFlag,
+		utils.TxIngestionL1ConfirmationsFlag,
+		utils.TxIngestionReplayFileFlag,
+		utils.RollupTxSenderFlag,
+		utils.RollupTxSenderKeyFileFlag,
+		utils.BatchBuilderDisableFlag,
+		utils.BatchBuilderMaxBatchAgeFlag,
+		utils.BatchBuilderMaxBatchGasFlag,
+		utils.BatchBuilderMaxBatchTxsFlag,
+		utils.BatchBuilderEncodingFlag,
+		utils.BatchBuilderMinBackoffFlag,
+		utils.BatchBuilderMaxBackoffFlag,
+		utils.BatchSubmitterL1EndpointFlag,
+		utils.BatchSubmitterL1ChainIDFlag,
+		utils.BatchSubmitterContractFlag,
+		utils.BatchSubmitterConfirmationsFlag,
+		utils.BatchSubmitterPollIntervalFlag,
+		utils.BatchSubmitterResubmitTimeoutFlag,
+		utils.BatchSubmitterKeyHexFlag,
+		utils.BatchSubmitterKeyFileFlag,
+		utils.VerifierL1EndpointFlag,
+		utils.VerifierContractFlag,
+		utils.VerifierStartBlockFlag,
+		utils.VerifierConfirmationsFlag,
+		utils.VerifierPollIntervalFlag,
+		utils.VerifierFixtureFlag,
 	}
 
 	rpcFlags = []cli.Flag{
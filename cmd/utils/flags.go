@@ -18,6 +18,7 @@
 package utils
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"errors"
 	"fmt"
@@ -45,6 +46,7 @@ import (
 	"github.com/ethereum/go-ethereum/eth"
 	"github.com/ethereum/go-ethereum/eth/downloader"
 	"github.com/ethereum/go-ethereum/eth/gasprice"
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/ethstats"
 	"github.com/ethereum/go-ethereum/graphql"
@@ -186,6 +188,10 @@ var (
 		Name:  "dev.period",
 		Usage: "Block period to use in developer mode (0 = mine only if transaction pending)",
 	}
+	DeveloperOVMStateDumpFlag = cli.StringFlag{
+		Name:  "dev.ovmstatedump",
+		Usage: "State dump with the OVM contracts to deploy in the developer mode genesis",
+	}
 	IdentityFlag = cli.StringFlag{
 		Name:  "identity",
 		Usage: "Custom node name",
@@ -794,6 +800,136 @@ var (
 		Name:  "txingestion.signerkeyfile",
 		Usage: "File holding key to authenticate L1 to L2 txs",
 	}
+	TxIngestionL1EndpointFlag = cli.StringFlag{
+		Name:  "txingestion.l1endpoint",
+		Usage: "Websocket RPC endpoint of the L1 node to ingest enqueued txs from (ingests from the SQL database if empty)",
+	}
+	TxIngestionL1QueueFlag = cli.StringFlag{
+		Name:  "txingestion.l1queue",
+		Usage: "Address of the L1 transaction queue contract",
+	}
+	TxIngestionL1StartBlockFlag = cli.Uint64Flag{
+		Name:  "txingestion.l1startblock",
+		Usage: "L1 block to start ingesting enqueued txs from",
+	}
+	TxIngestionL1ConfirmationsFlag = cli.Uint64Flag{
+		Name:  "txingestion.l1confirmations",
+		Usage: "Number of L1 blocks on top of an enqueued tx before it is ingested",
+		Value: eth.DefaultConfig.Rollup.TxIngestionL1Confirmations,
+	}
+	TxIngestionReplayFileFlag = cli.StringFlag{
+		Name:  "txingestion.replayfile",
+		Usage: "JSONL file to replay L1 to L2 tx submissions from instead of the database or L1",
+	}
+	// Flags associated with rollup transaction submissions
+	RollupTxSenderFlag = cli.StringFlag{
+		Name:   "rollup.txsender",
+		Usage:  "Account authenticating and signing rollup transaction submissions (address or keystore index, submissions rejected if empty)",
+		EnvVar: "ROLLUP_TX_SENDER",
+	}
+	RollupTxSenderKeyFileFlag = cli.StringFlag{
+		Name:  "rollup.txsenderkeyfile",
+		Usage: "File holding the key of the rollup transaction sender, held in memory without the keystore",
+	}
+	// Flags associated with the transition batch builder
+	BatchBuilderDisableFlag = cli.BoolFlag{
+		Name:  "batchbuilder.disable",
+		Usage: "Disable building and submitting transition batches",
+	}
+	BatchBuilderMaxBatchAgeFlag = cli.DurationFlag{
+		Name:  "batchbuilder.maxbatchage",
+		Usage: "Maximum time a non-empty transition batch is held before it is built",
+		Value: eth.DefaultConfig.Rollup.BatchBuilderMaxBatchAge,
+	}
+	BatchBuilderMaxBatchGasFlag = cli.Uint64Flag{
+		Name:  "batchbuilder.maxbatchgas",
+		Usage: "Maximum L1 gas a transition batch may use",
+		Value: eth.DefaultConfig.Rollup.BatchBuilderMaxBatchGas,
+	}
+	BatchBuilderMaxBatchTxsFlag = cli.IntFlag{
+		Name:  "batchbuilder.maxbatchtxs",
+		Usage: "Maximum number of transactions in a transition batch",
+		Value: eth.DefaultConfig.Rollup.BatchBuilderMaxBatchTransactions,
+	}
+	BatchBuilderEncodingFlag = cli.StringFlag{
+		Name:  "batchbuilder.encoding",
+		Usage: "Encoding of transition batches submitted to L1 (rlp, zlib)",
+		Value: eth.DefaultConfig.Rollup.BatchBuilderEncoding,
+	}
+	BatchBuilderMinBackoffFlag = cli.DurationFlag{
+		Name:  "batchbuilder.minbackoff",
+		Usage: "Delay before retrying a failed transition batch build or submission",
+		Value: eth.DefaultConfig.Rollup.BatchBuilderMinBackoff,
+	}
+	BatchBuilderMaxBackoffFlag = cli.DurationFlag{
+		Name:  "batchbuilder.maxbackoff",
+		Usage: "Maximum delay between retries of a failed transition batch build or submission",
+		Value: eth.DefaultConfig.Rollup.BatchBuilderMaxBackoff,
+	}
+	// Flags associated with the L1 transition batch submitter
+	BatchSubmitterL1EndpointFlag = cli.StringFlag{
+		Name:  "batchsubmitter.l1endpoint",
+		Usage: "RPC endpoint of the L1 node to submit transition batches to (submission disabled if empty)",
+	}
+	BatchSubmitterL1ChainIDFlag = cli.Uint64Flag{
+		Name:  "batchsubmitter.l1chainid",
+		Usage: "Chain ID transition batch submissions are signed for (queried from the L1 endpoint if not set)",
+	}
+	BatchSubmitterContractFlag = cli.StringFlag{
+		Name:  "batchsubmitter.contract",
+		Usage: "Address of the L1 canonical transition chain contract",
+	}
+	BatchSubmitterConfirmationsFlag = cli.Uint64Flag{
+		Name:  "batchsubmitter.confirmations",
+		Usage: "Number of L1 blocks on top of a submission before it is considered confirmed",
+		Value: eth.DefaultConfig.Rollup.BatchSubmitterConfirmations,
+	}
+	BatchSubmitterPollIntervalFlag = cli.DurationFlag{
+		Name:  "batchsubmitter.pollinterval",
+		Usage: "Time between polls of L1 for submission confirmations",
+		Value: eth.DefaultConfig.Rollup.BatchSubmitterPollInterval,
+	}
+	BatchSubmitterResubmitTimeoutFlag = cli.DurationFlag{
+		Name:  "batchsubmitter.resubmittimeout",
+		Usage: "Time after which a transition batch submission that is not mined is replaced with a higher gas price",
+		Value: eth.DefaultConfig.Rollup.BatchSubmitterResubmitTimeout,
+	}
+	BatchSubmitterKeyHexFlag = cli.StringFlag{
+		Name:   "batchsubmitter.key",
+		Usage:  "Hex private key signing L1 transition batch submissions",
+		EnvVar: "BATCH_SUBMITTER_KEY",
+	}
+	BatchSubmitterKeyFileFlag = cli.StringFlag{
+		Name:  "batchsubmitter.keyfile",
+		Usage: "File holding the key signing L1 transition batch submissions",
+	}
+	// Flags associated with the verifier
+	VerifierL1EndpointFlag = cli.StringFlag{
+		Name:  "verifier.l1endpoint",
+		Usage: "RPC endpoint of the L1 node to derive the chain from (verifier disabled if empty)",
+	}
+	VerifierContractFlag = cli.StringFlag{
+		Name:  "verifier.contract",
+		Usage: "Address of the L1 canonical transition chain contract to verify",
+	}
+	VerifierStartBlockFlag = cli.Uint64Flag{
+		Name:  "verifier.startblock",
+		Usage: "L1 block to start reading transition batches from",
+	}
+	VerifierConfirmationsFlag = cli.Uint64Flag{
+		Name:  "verifier.confirmations",
+		Usage: "Number of L1 blocks on top of a transition batch before it is verified",
+		Value: eth.DefaultConfig.Rollup.VerifierConfirmations,
+	}
+	VerifierPollIntervalFlag = cli.DurationFlag{
+		Name:  "verifier.pollinterval",
+		Usage: "Time between polls for new transition batches",
+		Value: eth.DefaultConfig.Rollup.VerifierPollInterval,
+	}
+	VerifierFixtureFlag = cli.StringFlag{
+		Name:  "verifier.fixture",
+		Usage: "JSON file of hex encoded transition batches to verify instead of reading L1",
+	}
 )
 
 // MakeDataDir retrieves the currently requested data directory, terminating
@@ -1040,6 +1176,25 @@ func setTxIngestion(ctx *cli.Context, cfg *rollup.Config) {
 	if ctx.GlobalIsSet(TxIngestionPollIntervalFlag.Name) {
 		cfg.TxIngestionPollInterval = ctx.GlobalDuration(TxIngestionPollIntervalFlag.Name)
 	}
+	if ctx.GlobalIsSet(TxIngestionL1EndpointFlag.Name) {
+		cfg.TxIngestionL1Endpoint = ctx.GlobalString(TxIngestionL1EndpointFlag.Name)
+	}
+	if ctx.GlobalIsSet(TxIngestionL1QueueFlag.Name) {
+		addr := ctx.GlobalString(TxIngestionL1QueueFlag.Name)
+		if !common.IsHexAddress(addr) {
+			Fatalf("Option %q: invalid address %q", TxIngestionL1QueueFlag.Name, addr)
+		}
+		cfg.TxIngestionL1QueueAddress = common.HexToAddress(addr)
+	}
+	if ctx.GlobalIsSet(TxIngestionL1StartBlockFlag.Name) {
+		cfg.TxIngestionL1StartBlock = ctx.GlobalUint64(TxIngestionL1StartBlockFlag.Name)
+	}
+	if ctx.GlobalIsSet(TxIngestionL1ConfirmationsFlag.Name) {
+		cfg.TxIngestionL1Confirmations = ctx.GlobalUint64(TxIngestionL1ConfirmationsFlag.Name)
+	}
+	if ctx.GlobalIsSet(TxIngestionReplayFileFlag.Name) {
+		cfg.TxIngestionReplayFile = ctx.GlobalString(TxIngestionReplayFileFlag.Name)
+	}
 
 	var (
 		hex  = ctx.GlobalString(TxIngestionSignerKeyHexFlag.Name)
@@ -1063,6 +1218,144 @@ func setTxIngestion(ctx *cli.Context, cfg *rollup.Config) {
 	}
 }
 
+// setRollupTxSender configures the account authenticating and signing rollup transaction
+// submissions. The key of a key file is only held in memory by the rollup configuration,
+// it is neither written to the keystore nor made available to the account manager.
+func setRollupTxSender(ctx *cli.Context, ks *keystore.KeyStore, cfg *rollup.Config) {
+	CheckExclusive(ctx, RollupTxSenderFlag, RollupTxSenderKeyFileFlag)
+
+	switch {
+	case ctx.GlobalIsSet(RollupTxSenderKeyFileFlag.Name):
+		key, err := crypto.LoadECDSA(ctx.GlobalString(RollupTxSenderKeyFileFlag.Name))
+		if err != nil {
+			Fatalf("Option %q: %v", RollupTxSenderKeyFileFlag.Name, err)
+		}
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		cfg.RollupTxSender, cfg.RollupTxSenderKey = &addr, key
+	case ctx.GlobalIsSet(RollupTxSenderFlag.Name):
+		// External signer accounts are not known to the keystore, so addresses are used as is
+		sender := ctx.GlobalString(RollupTxSenderFlag.Name)
+		switch {
+		case common.IsHexAddress(sender):
+			addr := common.HexToAddress(sender)
+			cfg.RollupTxSender = &addr
+		case ks != nil:
+			account, err := MakeAddress(ks, sender)
+			if err != nil {
+				Fatalf("Option %q: %v", RollupTxSenderFlag.Name, err)
+			}
+			cfg.RollupTxSender = &account.Address
+		default:
+			Fatalf("Option %q: invalid address %q", RollupTxSenderFlag.Name, sender)
+		}
+	}
+	if cfg.RollupTxSender != nil {
+		log.Info("Accepting rollup transaction submissions", "sender", cfg.RollupTxSender.Hex())
+	}
+}
+
+// setBatchBuilder configures the transition batch builder from the command line flags.
+func setBatchBuilder(ctx *cli.Context, cfg *rollup.Config) {
+	if ctx.GlobalIsSet(BatchBuilderDisableFlag.Name) {
+		cfg.BatchBuilderEnable = !ctx.GlobalBool(BatchBuilderDisableFlag.Name)
+	}
+	if ctx.GlobalIsSet(BatchBuilderMaxBatchAgeFlag.Name) {
+		cfg.BatchBuilderMaxBatchAge = ctx.GlobalDuration(BatchBuilderMaxBatchAgeFlag.Name)
+	}
+	if ctx.GlobalIsSet(BatchBuilderMaxBatchGasFlag.Name) {
+		cfg.BatchBuilderMaxBatchGas = ctx.GlobalUint64(BatchBuilderMaxBatchGasFlag.Name)
+	}
+	if ctx.GlobalIsSet(BatchBuilderMaxBatchTxsFlag.Name) {
+		cfg.BatchBuilderMaxBatchTransactions = ctx.GlobalInt(BatchBuilderMaxBatchTxsFlag.Name)
+	}
+	if ctx.GlobalIsSet(BatchBuilderEncodingFlag.Name) {
+		cfg.BatchBuilderEncoding = ctx.GlobalString(BatchBuilderEncodingFlag.Name)
+	}
+	if ctx.GlobalIsSet(BatchBuilderMinBackoffFlag.Name) {
+		cfg.BatchBuilderMinBackoff = ctx.GlobalDuration(BatchBuilderMinBackoffFlag.Name)
+	}
+	if ctx.GlobalIsSet(BatchBuilderMaxBackoffFlag.Name) {
+		cfg.BatchBuilderMaxBackoff = ctx.GlobalDuration(BatchBuilderMaxBackoffFlag.Name)
+	}
+}
+
+// setBatchSubmitter configures the L1 transition batch submitter from the command line flags.
+func setBatchSubmitter(ctx *cli.Context, cfg *rollup.Config) {
+	if ctx.GlobalIsSet(BatchSubmitterL1EndpointFlag.Name) {
+		cfg.BatchSubmitterL1Endpoint = ctx.GlobalString(BatchSubmitterL1EndpointFlag.Name)
+	}
+	if ctx.GlobalIsSet(BatchSubmitterContractFlag.Name) {
+		addr := ctx.GlobalString(BatchSubmitterContractFlag.Name)
+		if !common.IsHexAddress(addr) {
+			Fatalf("Option %q: invalid address %q", BatchSubmitterContractFlag.Name, addr)
+		}
+		cfg.BatchSubmitterContractAddress = common.HexToAddress(addr)
+	}
+	if ctx.GlobalIsSet(BatchSubmitterConfirmationsFlag.Name) {
+		cfg.BatchSubmitterConfirmations = ctx.GlobalUint64(BatchSubmitterConfirmationsFlag.Name)
+	}
+	if ctx.GlobalIsSet(BatchSubmitterPollIntervalFlag.Name) {
+		cfg.BatchSubmitterPollInterval = ctx.GlobalDuration(BatchSubmitterPollIntervalFlag.Name)
+	}
+	if ctx.GlobalIsSet(BatchSubmitterResubmitTimeoutFlag.Name) {
+		cfg.BatchSubmitterResubmitTimeout = ctx.GlobalDuration(BatchSubmitterResubmitTimeoutFlag.Name)
+	}
+	if ctx.GlobalIsSet(BatchSubmitterL1ChainIDFlag.Name) {
+		cfg.BatchSubmitterL1ChainID = new(big.Int).SetUint64(ctx.GlobalUint64(BatchSubmitterL1ChainIDFlag.Name))
+	}
+
+	var (
+		hex  = ctx.GlobalString(BatchSubmitterKeyHexFlag.Name)
+		file = ctx.GlobalString(BatchSubmitterKeyFileFlag.Name)
+		key  *ecdsa.PrivateKey
+		err  error
+	)
+	switch {
+	case file != "" && hex != "":
+		Fatalf("Options %q and %q are mutually exclusive", BatchSubmitterKeyFileFlag.Name, BatchSubmitterKeyHexFlag.Name)
+	case file != "":
+		if key, err = crypto.LoadECDSA(file); err != nil {
+			Fatalf("Option %q: %v", BatchSubmitterKeyFileFlag.Name, err)
+		}
+		cfg.BatchSubmitterKey = key
+	case hex != "":
+		if key, err = crypto.HexToECDSA(hex); err != nil {
+			Fatalf("Option %q: %v", BatchSubmitterKeyHexFlag.Name, err)
+		}
+		cfg.BatchSubmitterKey = key
+	}
+}
+
+// setVerifier configures the verifier from the command line flags. A verifier does not
+// build transition batches, so enabling it disables the batch builder.
+func setVerifier(ctx *cli.Context, cfg *rollup.Config) {
+	if ctx.GlobalIsSet(VerifierL1EndpointFlag.Name) {
+		cfg.VerifierL1Endpoint = ctx.GlobalString(VerifierL1EndpointFlag.Name)
+	}
+	if ctx.GlobalIsSet(VerifierContractFlag.Name) {
+		addr := ctx.GlobalString(VerifierContractFlag.Name)
+		if !common.IsHexAddress(addr) {
+			Fatalf("Option %q: invalid address %q", VerifierContractFlag.Name, addr)
+		}
+		cfg.VerifierContractAddress = common.HexToAddress(addr)
+	}
+	if ctx.GlobalIsSet(VerifierStartBlockFlag.Name) {
+		cfg.VerifierStartBlock = ctx.GlobalUint64(VerifierStartBlockFlag.Name)
+	}
+	if ctx.GlobalIsSet(VerifierConfirmationsFlag.Name) {
+		cfg.VerifierConfirmations = ctx.GlobalUint64(VerifierConfirmationsFlag.Name)
+	}
+	if ctx.GlobalIsSet(VerifierPollIntervalFlag.Name) {
+		cfg.VerifierPollInterval = ctx.GlobalDuration(VerifierPollIntervalFlag.Name)
+	}
+	if ctx.GlobalIsSet(VerifierFixtureFlag.Name) {
+		cfg.VerifierFixture = ctx.GlobalString(VerifierFixtureFlag.Name)
+	}
+	if cfg.IsVerifierEnabled() {
+		cfg.BatchBuilderEnable = false
+	}
+}
+
 // setLes configures the les server and ultra light client settings from the command line flags.
 func setLes(ctx *cli.Context, cfg *eth.Config) {
 	if ctx.GlobalIsSet(LightLegacyServFlag.Name) {
@@ -1521,6 +1814,13 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *eth.Config) {
 	setWhitelist(ctx, cfg)
 	setLes(ctx, cfg)
 	setTxIngestion(ctx, &cfg.Rollup)
+	setRollupTxSender(ctx, ks, &cfg.Rollup)
+	setBatchBuilder(ctx, &cfg.Rollup)
+	setBatchSubmitter(ctx, &cfg.Rollup)
+	setVerifier(ctx, &cfg.Rollup)
+	if err := cfg.Rollup.Validate(); err != nil {
+		Fatalf("Invalid rollup configuration: %v", err)
+	}
 
 	if ctx.GlobalIsSet(SyncModeFlag.Name) {
 		cfg.SyncMode = *GlobalTextMarshaler(ctx, SyncModeFlag.Name).(*downloader.SyncMode)
@@ -1610,6 +1910,9 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *eth.Config) {
 		log.Info("Using developer account", "address", developer.Address)
 
 		cfg.Genesis = core.DeveloperGenesisBlock(uint64(ctx.GlobalInt(DeveloperPeriodFlag.Name)), developer.Address)
+		if ctx.GlobalIsSet(DeveloperOVMStateDumpFlag.Name) {
+			cfg.Genesis.Config.OVM = &params.OVMConfig{StateDump: ctx.GlobalString(DeveloperOVMStateDumpFlag.Name)}
+		}
 		if !ctx.GlobalIsSet(MinerGasPriceFlag.Name) && !ctx.GlobalIsSet(MinerLegacyGasPriceFlag.Name) {
 			cfg.Miner.GasPrice = big.NewInt(1)
 		}
@@ -1625,6 +1928,32 @@ func RegisterEthService(stack *node.Node, cfg *eth.Config) {
 		})
 	} else {
 		err = stack.Register(func(ctx *node.ServiceContext) (node.Service, error) {
+			if cfg.Rollup.IsTxIngestionEnabled() && cfg.Rollup.TxIngestionL1Endpoint != "" && cfg.Rollup.TxIngestionL1Backend == nil {
+				client, err := ethclient.Dial(cfg.Rollup.TxIngestionL1Endpoint)
+				if err != nil {
+					return nil, fmt.Errorf("unable to connect to L1 node for transaction ingestion: %v", err)
+				}
+				cfg.Rollup.TxIngestionL1Backend = client
+			}
+			if cfg.Rollup.IsBatchSubmitterEnabled() && cfg.Rollup.BatchSubmitterL1Backend == nil {
+				client, err := ethclient.Dial(cfg.Rollup.BatchSubmitterL1Endpoint)
+				if err != nil {
+					return nil, fmt.Errorf("unable to connect to L1 node for transition batch submission: %v", err)
+				}
+				cfg.Rollup.BatchSubmitterL1Backend = client
+				if cfg.Rollup.BatchSubmitterL1ChainID == nil {
+					if cfg.Rollup.BatchSubmitterL1ChainID, err = client.ChainID(context.Background()); err != nil {
+						return nil, fmt.Errorf("unable to retrieve L1 chain ID for transition batch submission: %v", err)
+					}
+				}
+			}
+			if cfg.Rollup.VerifierL1Endpoint != "" && cfg.Rollup.VerifierL1Backend == nil {
+				client, err := ethclient.Dial(cfg.Rollup.VerifierL1Endpoint)
+				if err != nil {
+					return nil, fmt.Errorf("unable to connect to L1 node for verification: %v", err)
+				}
+				cfg.Rollup.VerifierL1Backend = client
+			}
 			fullNode, err := eth.New(ctx, cfg)
 			if fullNode != nil && cfg.LightServ > 0 {
 				ls, _ := les.NewLesServer(fullNode, cfg)
@@ -325,6 +325,10 @@ func (c *Clique) verifyCascadingFields(chain consensus.ChainReader, header *type
 	//if parent.Time+c.config.Period > header.Time {
 	//	return ErrInvalidTimestamp
 	//}
+	// OVM blocks take their timestamp from the L1 context instead
+	if err := misc.VerifyL1Context(chain.Config(), header, parent); err != nil {
+		return err
+	}
 	// Retrieve the snapshot needed to verify this header and cache it
 	snap, err := c.snapshot(chain, number-1, header.ParentHash, parents)
 	if err != nil {
@@ -303,6 +303,9 @@ func (ethash *Ethash) verifyHeader(chain consensus.ChainReader, header, parent *
 	if err := misc.VerifyForkHashes(chain.Config(), header, uncle); err != nil {
 		return err
 	}
+	if err := misc.VerifyL1Context(chain.Config(), header, parent); err != nil {
+		return err
+	}
 	return nil
 }
 
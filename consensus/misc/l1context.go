@@ -0,0 +1,60 @@
+/**
+ * Optimism 2020 Copyright
+ */
+
+package misc
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	// ErrMissingL1Context is returned if an OVM block header doesn't start its
+	// extra-data with the L1 context it was derived from.
+	ErrMissingL1Context = errors.New("missing l1 context extra-data")
+
+	// ErrL1ContextTime is returned if the timestamp of an OVM block differs from
+	// the timestamp of the L1 block it was derived from.
+	ErrL1ContextTime = errors.New("block timestamp differs from l1 timestamp")
+
+	// ErrL1ContextOrder is returned if the L1 context of an OVM block goes back
+	// relative to the L1 context of its parent.
+	ErrL1ContextOrder = errors.New("l1 context older than parent")
+)
+
+// VerifyL1Context validates the L1 context recorded in the extra-data of an OVM
+// block header.
+//
+// OVM extension to the header validity:
+//
+//	a) the extra-data must start with the encoded L1 context of the block
+//	b) the block timestamp must equal the L1 timestamp
+//	c) neither the L1 block number nor the L1 timestamp may go back relative to
+//	   the parent, unless the parent is the genesis block, which has no context
+func VerifyL1Context(config *params.ChainConfig, header, parent *types.Header) error {
+	// Short circuit validation if the chain isn't running the OVM
+	if !config.IsOVM() {
+		return nil
+	}
+	context, err := types.DecodeL1Context(header.Extra)
+	if err != nil {
+		return ErrMissingL1Context
+	}
+	if header.Time != context.Timestamp {
+		return ErrL1ContextTime
+	}
+	if parent.Number.Sign() == 0 {
+		return nil
+	}
+	parentContext, err := types.DecodeL1Context(parent.Extra)
+	if err != nil {
+		return ErrMissingL1Context
+	}
+	if context.BlockNumber < parentContext.BlockNumber || context.Timestamp < parentContext.Timestamp {
+		return ErrL1ContextOrder
+	}
+	return nil
+}
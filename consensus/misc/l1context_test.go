@@ -0,0 +1,45 @@
+package misc
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestVerifyL1Context(t *testing.T) {
+	ovm := &params.ChainConfig{ChainID: big.NewInt(1), OVM: &params.OVMConfig{}}
+
+	header := func(number uint64, time uint64, extra []byte) *types.Header {
+		return &types.Header{Number: new(big.Int).SetUint64(number), Time: time, Extra: extra}
+	}
+	var (
+		genesis = header(0, 0, nil)
+		parent  = header(5, 100, types.L1Context{BlockNumber: 10, Timestamp: 100}.Extra())
+	)
+	tests := []struct {
+		config *params.ChainConfig
+		header *types.Header
+		parent *types.Header
+		err    error
+	}{
+		// Non OVM chains don't carry an L1 context
+		{params.TestChainConfig, header(6, 90, nil), parent, nil},
+		// Blocks on top of genesis only need a valid context
+		{ovm, header(1, 100, types.L1Context{BlockNumber: 10, Timestamp: 100}.Extra()), genesis, nil},
+		{ovm, header(1, 100, nil), genesis, ErrMissingL1Context},
+		{ovm, header(1, 101, types.L1Context{BlockNumber: 10, Timestamp: 100}.Extra()), genesis, ErrL1ContextTime},
+		// Later blocks may stay on the same L1 block or move forward
+		{ovm, header(6, 100, types.L1Context{BlockNumber: 10, Timestamp: 100}.Extra()), parent, nil},
+		{ovm, header(6, 115, append(types.L1Context{BlockNumber: 11, Timestamp: 115}.Extra(), "geth"...)), parent, nil},
+		{ovm, header(6, 115, types.L1Context{BlockNumber: 9, Timestamp: 115}.Extra()), parent, ErrL1ContextOrder},
+		{ovm, header(6, 99, types.L1Context{BlockNumber: 11, Timestamp: 99}.Extra()), parent, ErrL1ContextOrder},
+		{ovm, header(6, 115, types.L1Context{BlockNumber: 11, Timestamp: 115}.Extra()), header(5, 100, nil), ErrMissingL1Context},
+	}
+	for i, tt := range tests {
+		if err := VerifyL1Context(tt.config, tt.header, tt.parent); err != tt.err {
+			t.Errorf("test %d: error mismatch: have %v, want %v", i, err, tt.err)
+		}
+	}
+}
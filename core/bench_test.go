@@ -18,15 +18,18 @@ package core
 
 import (
 	"crypto/ecdsa"
+	"fmt"
 	"io/ioutil"
 	"math/big"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -177,6 +180,72 @@ func benchInsertChain(b *testing.B, disk bool, gen func(int, *BlockGen)) {
 	}
 }
 
+// BenchmarkOvmStorage applies storage accesses through the Execution Manager.
+func BenchmarkOvmStorage(b *testing.B) {
+	benchOvmStorage(b, vm.Config{})
+}
+
+var (
+	// ovmStorageCode is the init code of a contract which reads and writes its
+	// storage through the Execution Manager, which is passed to the constructor.
+	ovmStorageCode = common.FromHex("608060405234801561001057600080fd5b5060405161026b38038061026b8339818101604052602081101561003357600080fd5b8101908080519060200190929190505050806000806101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff160217905550506101d7806100946000396000f3fe608060405234801561001057600080fd5b50600436106100365760003560e01c80633408f73a1461003b578063d3404b6d14610045575b600080fd5b61004361004f565b005b61004d6100fa565b005b600060e060405180807f6f766d534c4f4144282900000000000000000000000000000000000000000000815250600a0190506040518091039020901c905060008060009054906101000a900473ffffffffffffffffffffffffffffffffffffffff16905060405136600082378260181c81538260101c60018201538260081c60028201538260038201536040516207a1208136846000875af160008114156100f657600080fd5b3d82f35b600060e060405180807f6f766d5353544f52452829000000000000000000000000000000000000000000815250600b0190506040518091039020901c905060008060009054906101000a900473ffffffffffffffffffffffffffffffffffffffff16905060405136600082378260181c81538260101c60018201538260081c600282015382600382015360008036836000865af1600081141561019c57600080fd5b5050505056fea265627a7a72315820311a406c97055eec367b660092882e1a174e14333416a3de384439293b7b129264736f6c6343000510003200000000000000000000000000000000000000000000000000000000dead0000")
+
+	ovmStorageSender  = common.HexToAddress("0x8888888888888888888888888888888888888888")
+	ovmStorageAddress = common.HexToAddress("0x65486c8ec9167565eBD93c94ED04F0F71d1b5137")
+
+	// ovmChainConfig is the test chain configuration executing transactions on
+	// the OVM, with the OVM contracts deployed in the genesis state.
+	ovmChainConfig = func() *params.ChainConfig {
+		config := *params.TestChainConfig
+		config.OVM = &params.OVMConfig{StateDump: filepath.Join("vm", "ovm_state_dump.json")}
+		return &config
+	}()
+
+	// ovmStorageSet and ovmStorageGet are the calls storing and loading a slot.
+	ovmStorageSet = common.FromHex("d3404b6d99999999999999999999999999999999999999999999999999999999999999990101010101010101010101010101010101010101010101010101010101010101")
+	ovmStorageGet = common.FromHex("3408f73a9999999999999999999999999999999999999999999999999999999999999999")
+)
+
+// applyOvmMessage applies a message calling to, or deploying a contract if to
+// is nil, through the Execution Manager.
+func applyOvmMessage(statedb *state.StateDB, config vm.Config, to *common.Address, data []byte) ([]byte, uint64, bool, error) {
+	msg := types.NewMessage(ovmStorageSender, to, statedb.GetNonce(ovmStorageSender), new(big.Int), 15000000, new(big.Int), data, false, &common.Address{}, nil, types.QueueOriginSequencer, types.SighashEthSign)
+	header := &types.Header{Number: new(big.Int), Difficulty: new(big.Int), Time: 1}
+	evm := vm.NewEVM(NewEVMContext(msg, header, nil, &ovmStorageSender), statedb, ovmChainConfig, config)
+	return ApplyMessage(evm, msg, new(GasPool).AddGas(math.MaxUint64))
+}
+
+// newOvmStorageState creates an OVM state with the storage contract deployed.
+func newOvmStorageState(config vm.Config) (*state.StateDB, error) {
+	db := rawdb.NewMemoryDatabase()
+	genesis, err := (&Genesis{Config: ovmChainConfig}).ToBlock(db)
+	if err != nil {
+		return nil, err
+	}
+	statedb, _ := state.New(genesis.Root(), state.NewDatabase(db))
+	if _, _, failed, err := applyOvmMessage(statedb, config, nil, ovmStorageCode); err != nil || failed {
+		return nil, fmt.Errorf("failed to deploy storage contract: failed %t, err %v", failed, err)
+	}
+	return statedb, nil
+}
+
+func benchOvmStorage(b *testing.B, config vm.Config) {
+	statedb, err := newOvmStorageState(config)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, failed, err := applyOvmMessage(statedb, config, &ovmStorageAddress, ovmStorageSet); err != nil || failed {
+			b.Fatalf("set failed: failed %t, err %v", failed, err)
+		}
+		if _, _, failed, err := applyOvmMessage(statedb, config, &ovmStorageAddress, ovmStorageGet); err != nil || failed {
+			b.Fatalf("get failed: failed %t, err %v", failed, err)
+		}
+	}
+}
+
 func BenchmarkChainRead_header_10k(b *testing.B) {
 	benchReadChain(b, false, 10000)
 }
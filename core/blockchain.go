@@ -104,7 +104,11 @@ const (
 	// - Version 7
 	//  The following incompatible database changes were added:
 	//    * Use freezer as the ancient database to maintain all ancient data
-	BlockChainVersion uint64 = 7
+	// - Version 8
+	//  The following incompatible database changes were added:
+	//    * the `RevertReason` field of receipt is stored, receipts of version 7 still decode
+	//      without it, but older versions fail to decode the receipts written since
+	BlockChainVersion uint64 = 8
 )
 
 // CacheConfig contains the configuration values for the trie caching/pruning
@@ -151,7 +155,7 @@ type BlockChain struct {
 
 	chainmu sync.RWMutex // blockchain insertion lock
 
-	currentTimestamp atomic.Value // Timestamp to be used when mining the current block.
+	currentL1Context atomic.Value // L1 context to be used when mining the current block.
 
 	currentBlock     atomic.Value // Current head of the block chain
 	currentFastBlock atomic.Value // Current head of the fast-sync chain (may be above the block chain!)
@@ -252,10 +256,14 @@ func NewBlockChain(db ethdb.Database, cacheConfig *CacheConfig, chainConfig *par
 	bc.engine.VerifyHeader(bc, bc.CurrentHeader(), true)
 
 	if currentHeader := bc.CurrentHeader(); currentHeader != nil {
-		log.Debug("Read timestamp from last block. ", "timestamp", bc.CurrentHeader().Time)
-		bc.SetCurrentTimestamp(int64(bc.CurrentHeader().Time))
+		context, err := types.DecodeL1Context(currentHeader.Extra)
+		if err != nil {
+			context = types.L1Context{Timestamp: currentHeader.Time}
+		}
+		log.Debug("Read L1 context from last block", "l1BlockNumber", context.BlockNumber, "l1Timestamp", context.Timestamp)
+		bc.SetCurrentL1Context(context)
 	} else {
-		bc.SetCurrentTimestamp(int64(0))
+		bc.SetCurrentL1Context(types.L1Context{})
 	}
 
 	if frozen, err := bc.db.Ancients(); err == nil && frozen > 0 {
@@ -304,11 +312,90 @@ func NewBlockChain(db ethdb.Database, cacheConfig *CacheConfig, chainConfig *par
 			}
 		}
 	}
+	// Databases written before blocks stored their L1 rollup tx ids lack them
+	if chainConfig.IsOVM() {
+		bc.wg.Add(1)
+		go bc.backfillL1RollupTxIds()
+	}
 	// Take ownership of this particular state
 	go bc.update()
 	return bc, nil
 }
 
+// backfillL1RollupTxIds stores the L1 tx ids of the last L1-to-L2 transactions up to
+// the canonical blocks lacking them, resuming from the last backfilled block. It runs
+// in the background, so it must be started with the wait group increased.
+func (bc *BlockChain) backfillL1RollupTxIds() {
+	defer bc.wg.Done()
+
+	head := bc.CurrentBlock().NumberU64()
+	if fast := bc.CurrentFastBlock().NumberU64(); fast > head {
+		head = fast
+	}
+	var (
+		from uint64
+		last *uint64
+	)
+	if done := rawdb.ReadL1RollupTxIdBackfill(bc.db); done != nil {
+		if *done >= head {
+			return
+		}
+		from, last = *done+1, rawdb.ReadBlockL1RollupTxId(bc.db, rawdb.ReadCanonicalHash(bc.db, *done))
+	}
+	var (
+		batch  = bc.db.NewBatch()
+		start  = time.Now()
+		logged = time.Now()
+	)
+	flush := func(number uint64) bool {
+		rawdb.WriteL1RollupTxIdBackfill(batch, number)
+		if err := batch.Write(); err != nil {
+			log.Error("Failed to backfill L1 rollup tx ids", "number", number, "err", err)
+			return false
+		}
+		batch.Reset()
+		return true
+	}
+	for number := from; number <= head; number++ {
+		select {
+		case <-bc.quit:
+			if number > from {
+				flush(number - 1)
+			}
+			return
+		default:
+		}
+		hash := rawdb.ReadCanonicalHash(bc.db, number)
+		if id := rawdb.ReadBlockL1RollupTxId(bc.db, hash); id != nil {
+			last = id
+			continue
+		}
+		// Blocks of fast sync gaps lack their bodies, the ids following them are unknown
+		block := rawdb.ReadBlock(bc.db, hash, number)
+		if block == nil {
+			last = nil
+			continue
+		}
+		// Stored block bodies do not hold the transaction metadata
+		for _, tx := range block.Transactions() {
+			if meta := rawdb.ReadTransactionMeta(bc.db, tx.Hash()); meta != nil {
+				tx.SetTransactionMeta(meta)
+			}
+		}
+		last = writeBlockL1RollupTxId(batch, block, last)
+		if batch.ValueSize() >= ethdb.IdealBatchSize && !flush(number) {
+			return
+		}
+		if time.Since(logged) > 8*time.Second {
+			log.Info("Backfilling L1 rollup tx ids", "number", number, "head", head, "elapsed", common.PrettyDuration(time.Since(start)))
+			logged = time.Now()
+		}
+	}
+	if flush(head) && head > from {
+		log.Info("Backfilled L1 rollup tx ids", "head", head, "elapsed", common.PrettyDuration(time.Since(start)))
+	}
+}
+
 func (bc *BlockChain) getProcInterrupt() bool {
 	return atomic.LoadInt32(&bc.procInterrupt) == 1
 }
@@ -465,6 +552,7 @@ func (bc *BlockChain) SetHead(head uint64) error {
 			rawdb.DeleteBody(db, hash, num)
 			rawdb.DeleteReceipts(db, hash, num)
 		}
+		rawdb.DeleteBlockL1RollupTxId(db, hash)
 		// Todo(rjl493456442) txlookup, bloombits, etc
 	}
 	bc.hc.SetHead(head, updateFn, delFn)
@@ -506,15 +594,30 @@ func (bc *BlockChain) GasLimit() uint64 {
 	return bc.CurrentBlock().GasLimit()
 }
 
-// SetCurrentTimestamp sets the timestamp for blocks added to the canonical chain.
+// SetCurrentL1Context sets the L1 block which blocks added to the canonical chain
+// are derived from. Its timestamp is used as the block timestamp.
+func (bc *BlockChain) SetCurrentL1Context(context types.L1Context) {
+	bc.currentL1Context.Store(&context)
+}
+
+// CurrentL1Context retrieves the L1 block which blocks added to the canonical
+// chain are derived from.
+func (bc *BlockChain) CurrentL1Context() types.L1Context {
+	// Note: Can never be nil
+	return *bc.currentL1Context.Load().(*types.L1Context)
+}
+
+// SetCurrentTimestamp sets the timestamp for blocks added to the canonical chain,
+// keeping the L1 block number of the current L1 context.
 func (bc *BlockChain) SetCurrentTimestamp(timestamp int64) {
-	bc.currentTimestamp.Store(&timestamp)
+	context := bc.CurrentL1Context()
+	context.Timestamp = uint64(timestamp)
+	bc.SetCurrentL1Context(context)
 }
 
 // CurrentTimestamp retrieves the timestamp used for blocks added to the canonical chain.
 func (bc *BlockChain) CurrentTimestamp() int64 {
-	// Note: Can never be nil
-	return *bc.currentTimestamp.Load().(*int64)
+	return int64(bc.CurrentL1Context().Timestamp)
 }
 
 // CurrentBlock retrieves the current head block of the canonical chain. The
@@ -1059,8 +1162,9 @@ func (bc *BlockChain) InsertReceiptChain(blockChain types.Blocks, receiptChain [
 	// eventually.
 	writeAncient := func(blockChain types.Blocks, receiptChain []types.Receipts) (int, error) {
 		var (
-			previous = bc.CurrentFastBlock()
-			batch    = bc.db.NewBatch()
+			previous     = bc.CurrentFastBlock()
+			batch        = bc.db.NewBatch()
+			l1RollupTxId = rawdb.ReadBlockL1RollupTxId(bc.db, blockChain[0].ParentHash())
 		)
 		// If any error occurs before updating the head or we are inserting a side chain,
 		// all the data written this time wll be rolled back.
@@ -1146,6 +1250,7 @@ func (bc *BlockChain) InsertReceiptChain(blockChain types.Blocks, receiptChain [
 			// Flush data into ancient database.
 			size += rawdb.WriteAncientBlock(bc.db, block, receiptChain[i], bc.GetTd(block.Hash(), block.NumberU64()))
 			rawdb.WriteTxLookupEntries(batch, block)
+			l1RollupTxId = writeBlockL1RollupTxId(batch, block, l1RollupTxId)
 
 			stats.processed++
 		}
@@ -1204,6 +1309,7 @@ func (bc *BlockChain) InsertReceiptChain(blockChain types.Blocks, receiptChain [
 	// writeLive writes blockchain and corresponding receipt chain into active store.
 	writeLive := func(blockChain types.Blocks, receiptChain []types.Receipts) (int, error) {
 		batch := bc.db.NewBatch()
+		l1RollupTxId := rawdb.ReadBlockL1RollupTxId(bc.db, blockChain[0].ParentHash())
 		for i, block := range blockChain {
 			// Short circuit insertion if shutting down or processing failed
 			if atomic.LoadInt32(&bc.procInterrupt) == 1 {
@@ -1215,6 +1321,7 @@ func (bc *BlockChain) InsertReceiptChain(blockChain types.Blocks, receiptChain [
 			}
 			if bc.HasBlock(block.Hash(), block.NumberU64()) {
 				stats.ignored++
+				l1RollupTxId = rawdb.ReadBlockL1RollupTxId(bc.db, block.Hash())
 				continue
 			}
 			// Write all the data out into the database
@@ -1224,6 +1331,7 @@ func (bc *BlockChain) InsertReceiptChain(blockChain types.Blocks, receiptChain [
 			for _, tx := range block.Transactions() {
 				rawdb.WriteTransactionMeta(batch, tx.Hash(), tx.GetMeta())
 			}
+			l1RollupTxId = writeBlockL1RollupTxId(batch, block, l1RollupTxId)
 
 			// Write everything belongs to the blocks into the database. So that
 			// we can ensure all components of body is completed(body, receipts,
@@ -1315,6 +1423,23 @@ func (bc *BlockChain) writeKnownBlock(block *types.Block) error {
 	return nil
 }
 
+// writeBlockL1RollupTxId stores the L1 tx id of the last L1-to-L2 transaction included
+// in the block or its ancestors, given that of its parent, and returns it. Transaction
+// ingestion resumes from it without scanning the chain.
+func writeBlockL1RollupTxId(db ethdb.KeyValueWriter, block *types.Block, parent *uint64) *uint64 {
+	last := parent
+	for _, tx := range block.Transactions() {
+		if id := tx.L1RollupTxId(); id != nil {
+			included := uint64(*id)
+			last = &included
+		}
+	}
+	if last != nil {
+		rawdb.WriteBlockL1RollupTxId(db, block.Hash(), *last)
+	}
+	return last
+}
+
 // WriteBlockWithState writes the block and all associated state to the database.
 func (bc *BlockChain) WriteBlockWithState(block *types.Block, receipts []*types.Receipt, logs []*types.Log, state *state.StateDB, emitHeadEvent bool) (status WriteStatus, err error) {
 	bc.chainmu.Lock()
@@ -1349,6 +1474,7 @@ func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.
 	for _, tx := range block.Transactions() {
 		rawdb.WriteTransactionMeta(blockBatch, tx.Hash(), tx.GetMeta())
 	}
+	writeBlockL1RollupTxId(blockBatch, block, rawdb.ReadBlockL1RollupTxId(bc.db, block.ParentHash()))
 	rawdb.WriteReceipts(blockBatch, block.Hash(), block.NumberU64(), receipts)
 	rawdb.WritePreimages(blockBatch, state.Preimages())
 	if err := blockBatch.Write(); err != nil {
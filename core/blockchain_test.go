@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core/rawdb"
@@ -981,8 +982,6 @@ func TestLogReorgs(t *testing.T) {
 }
 
 func TestLogRebirth(t *testing.T) {
-	t.Skip("OVM Genesis breaks this test because it adds the OVM contracts to the state.")
-
 	var (
 		key1, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
 		addr1   = crypto.PubkeyToAddress(key1.PublicKey)
@@ -1421,8 +1420,6 @@ func TestEIP155Transition(t *testing.T) {
 }
 
 func TestEIP161AccountRemoval(t *testing.T) {
-	t.Skip("OVM breaks with `expected account to exist`, probably based on some unknown transaction failure.")
-
 	// Configure and generate a sample block chain
 	var (
 		db      = rawdb.NewMemoryDatabase()
@@ -1636,6 +1633,125 @@ func TestLargeReorgTrieGC(t *testing.T) {
 	}
 }
 
+// Tests that the L1 rollup tx ids of blocks are stored on both the live and the
+// ancient insertion paths, removed along with rewound blocks, and backfilled for
+// databases written before blocks stored them.
+func TestBlockL1RollupTxIds(t *testing.T) {
+	var (
+		gendb   = rawdb.NewMemoryDatabase()
+		key, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		address = crypto.PubkeyToAddress(key.PublicKey)
+		gspec   = &Genesis{Config: params.TestChainConfig, Alloc: GenesisAlloc{address: {Balance: big.NewInt(1000000000)}}}
+		genesis = gspec.MustCommit(gendb)
+		signer  = types.NewEIP155Signer(gspec.Config.ChainID)
+	)
+	// Every other block includes an L1-to-L2 transaction, of an L1 tx id of its number
+	blocks, receipts := GenerateChain(gspec.Config, genesis, ethash.NewFaker(), gendb, 8, func(i int, gen *BlockGen) {
+		if i%2 == 1 {
+			id := hexutil.Uint64(i + 1)
+			tx, _ := types.SignTx(types.NewTransaction(gen.TxNonce(address), common.Address{0xaa}, big.NewInt(1), params.TxGas, nil, nil, &address, &id, types.QueueOriginL1ToL2, types.SighashEIP155), signer, key)
+			gen.AddTx(tx)
+		}
+	})
+	check := func(name string, db ethdb.Reader, head uint64) {
+		for _, block := range blocks {
+			number, id := block.NumberU64(), rawdb.ReadBlockL1RollupTxId(db, block.Hash())
+			switch {
+			case number > head || number < 2:
+				if id != nil {
+					t.Errorf("%s: block #%d: unexpected L1 rollup tx id %d", name, number, *id)
+				}
+			case id == nil || *id != number-number%2:
+				t.Errorf("%s: block #%d: L1 rollup tx id mismatch: have %v, want %d", name, number, id, number-number%2)
+			}
+		}
+	}
+	// Import the chain as a full node, then rewind it
+	fulldb := rawdb.NewMemoryDatabase()
+	gspec.MustCommit(fulldb)
+	full, _ := NewBlockChain(fulldb, nil, gspec.Config, ethash.NewFaker(), vm.Config{}, nil)
+	if n, err := full.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert block %d: %v", n, err)
+	}
+	check("full", fulldb, 8)
+	full.SetHead(5)
+	check("rewound", fulldb, 5)
+	full.Stop()
+
+	// Drop the ids, as in databases written before blocks stored them, and reopen
+	// as an OVM chain, the only ones backfilling them
+	for _, block := range blocks[:5] {
+		rawdb.DeleteBlockL1RollupTxId(fulldb, block.Hash())
+	}
+	backfill := func(name string) {
+		ovmConfig := *gspec.Config
+		ovmConfig.OVM = &params.OVMConfig{}
+		full, _ = NewBlockChain(fulldb, nil, &ovmConfig, ethash.NewFaker(), vm.Config{}, nil)
+		defer full.Stop()
+
+		for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+			if done := rawdb.ReadL1RollupTxIdBackfill(fulldb); done != nil && *done == 5 {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("%s: backfill did not reach the head", name)
+			}
+		}
+	}
+	full, _ = NewBlockChain(fulldb, nil, gspec.Config, ethash.NewFaker(), vm.Config{}, nil)
+	full.Stop()
+	if done := rawdb.ReadL1RollupTxIdBackfill(fulldb); done != nil {
+		t.Fatalf("non-OVM chain backfilled up to block #%d", *done)
+	}
+	backfill("backfilled")
+	check("backfilled", fulldb, 5)
+
+	// Blocks lacking their bodies are skipped, along with the ids depending on them
+	for _, block := range blocks[:5] {
+		rawdb.DeleteBlockL1RollupTxId(fulldb, block.Hash())
+	}
+	rawdb.DeleteL1RollupTxIdBackfill(fulldb)
+	rawdb.DeleteBody(fulldb, blocks[1].Hash(), 2)
+	backfill("gapped")
+	for _, block := range blocks[:5] {
+		number, id := block.NumberU64(), rawdb.ReadBlockL1RollupTxId(fulldb, block.Hash())
+		switch {
+		case number < 4:
+			if id != nil {
+				t.Errorf("gapped: block #%d: unexpected L1 rollup tx id %d", number, *id)
+			}
+		case id == nil || *id != number-number%2:
+			t.Errorf("gapped: block #%d: L1 rollup tx id mismatch: have %v, want %d", number, id, number-number%2)
+		}
+	}
+
+	// Import the chain as an ancient-first node
+	frdir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("failed to create temp freezer dir: %v", err)
+	}
+	defer os.Remove(frdir)
+	ancientDb, err := rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), frdir, "")
+	if err != nil {
+		t.Fatalf("failed to create temp freezer db: %v", err)
+	}
+	gspec.MustCommit(ancientDb)
+	ancient, _ := NewBlockChain(ancientDb, nil, gspec.Config, ethash.NewFaker(), vm.Config{}, nil)
+	defer ancient.Stop()
+
+	headers := make([]*types.Header, len(blocks))
+	for i, block := range blocks {
+		headers[i] = block.Header()
+	}
+	if n, err := ancient.InsertHeaderChain(headers, 1); err != nil {
+		t.Fatalf("failed to insert header %d: %v", n, err)
+	}
+	if n, err := ancient.InsertReceiptChain(blocks, receipts, uint64(len(blocks)/2)); err != nil {
+		t.Fatalf("failed to insert receipt %d: %v", n, err)
+	}
+	check("ancient", ancientDb, 8)
+}
+
 func TestBlockchainRecovery(t *testing.T) {
 	// Configure and generate a sample block chain
 	var (
@@ -169,6 +169,10 @@ func (b *BlockGen) OffsetTime(seconds int64) {
 	if b.header.Time <= b.parent.Header().Time {
 		panic("block time out of range")
 	}
+	if context, err := types.DecodeL1Context(b.header.Extra); err == nil {
+		context.Timestamp = b.header.Time
+		b.header.Extra = context.Extra()
+	}
 	chainreader := &fakeChainReader{config: b.config}
 	b.header.Difficulty = b.engine.CalcDifficulty(chainreader, b.header.Time, b.parent.Header())
 }
@@ -248,7 +252,7 @@ func makeHeader(chain consensus.ChainReader, parent *types.Block, state *state.S
 		time = parent.Time() + 10 // block time is fixed at 10 seconds
 	}
 
-	return &types.Header{
+	header := &types.Header{
 		Root:       state.IntermediateRoot(chain.Config().IsEIP158(parent.Number())),
 		ParentHash: parent.Hash(),
 		Coinbase:   parent.Coinbase(),
@@ -262,6 +266,11 @@ func makeHeader(chain consensus.ChainReader, parent *types.Block, state *state.S
 		Number:   new(big.Int).Add(parent.Number(), common.Big1),
 		Time:     time,
 	}
+	// OVM blocks are derived from an L1 block, fake one moving along with the chain
+	if chain.Config().IsOVM() {
+		header.Extra = types.L1Context{BlockNumber: header.Number.Uint64(), Timestamp: time}.Extra()
+	}
+	return header
 }
 
 // makeHeaderChain creates a deterministic chain of headers rooted at parent.
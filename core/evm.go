@@ -44,17 +44,22 @@ func NewEVMContext(msg Message, header *types.Header, chain ChainContext, author
 	} else {
 		beneficiary = *author
 	}
+	var l1BlockNumber *big.Int
+	if l1Context, err := types.DecodeL1Context(header.Extra); err == nil {
+		l1BlockNumber = new(big.Int).SetUint64(l1Context.BlockNumber)
+	}
 	return vm.Context{
-		CanTransfer: CanTransfer,
-		Transfer:    Transfer,
-		GetHash:     GetHashFn(header, chain),
-		Origin:      msg.From(),
-		Coinbase:    beneficiary,
-		BlockNumber: new(big.Int).Set(header.Number),
-		Time:        new(big.Int).SetUint64(header.Time),
-		Difficulty:  new(big.Int).Set(header.Difficulty),
-		GasLimit:    header.GasLimit,
-		GasPrice:    new(big.Int).Set(msg.GasPrice()),
+		CanTransfer:   CanTransfer,
+		Transfer:      Transfer,
+		GetHash:       GetHashFn(header, chain),
+		Origin:        msg.From(),
+		Coinbase:      beneficiary,
+		BlockNumber:   new(big.Int).Set(header.Number),
+		Time:          new(big.Int).SetUint64(header.Time),
+		Difficulty:    new(big.Int).Set(header.Difficulty),
+		GasLimit:      header.GasLimit,
+		GasPrice:      new(big.Int).Set(msg.GasPrice()),
+		L1BlockNumber: l1BlockNumber,
 	}
 }
 
@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"math/big"
 	"strings"
 
@@ -31,7 +32,6 @@ import (
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/log"
@@ -42,7 +42,10 @@ import (
 //go:generate gencodec -type Genesis -field-override genesisSpecMarshaling -out gen_genesis.go
 //go:generate gencodec -type GenesisAccount -field-override genesisAccountMarshaling -out gen_genesis_account.go
 
-var errGenesisNoConfig = errors.New("genesis has no chain configuration")
+var (
+	errGenesisNoConfig = errors.New("genesis has no chain configuration")
+	errGenesisNoOvm    = errors.New("genesis state holds the OVM contracts, but the chain configuration does not enable the OVM, supply a genesis with an \"ovm\" configuration")
+)
 
 // Genesis specifies the header fields, state of a genesis block. It also defines hard
 // fork switch-over blocks through the chain configuration.
@@ -160,6 +163,13 @@ func SetupGenesisBlockWithOverride(db ethdb.Database, genesis *Genesis, override
 	if genesis != nil && genesis.Config == nil {
 		return params.AllEthashProtocolChanges, common.Hash{}, errGenesisNoConfig
 	}
+	if genesis != nil {
+		embedded, err := genesis.embedOvmStateDump()
+		if err != nil {
+			return genesis.Config, common.Hash{}, err
+		}
+		genesis = embedded
+	}
 	// Just commit the new block if there is no stored genesis block.
 	stored := rawdb.ReadCanonicalHash(db, 0)
 	if (stored == common.Hash{}) {
@@ -179,16 +189,21 @@ func SetupGenesisBlockWithOverride(db ethdb.Database, genesis *Genesis, override
 	// We have the genesis block in database(perhaps in ancient database)
 	// but the corresponding state is missing.
 	header := rawdb.ReadHeader(db, stored, 0)
-	if _, err := state.New(header.Root, state.NewDatabaseWithCache(db, 0)); err != nil {
+	statedb, err := state.New(header.Root, state.NewDatabaseWithCache(db, 0))
+	if err != nil {
 		if genesis == nil {
 			genesis = DefaultGenesisBlock()
 		}
 		// Ensure the stored genesis matches with the given one.
-		hash := genesis.ToBlock(nil).Hash()
+		block, err := genesis.ToBlock(nil)
+		if err != nil {
+			return genesis.Config, common.Hash{}, err
+		}
+		hash := block.Hash()
 		if hash != stored {
 			return genesis.Config, hash, &GenesisMismatchError{stored, hash}
 		}
-		block, err := genesis.Commit(db)
+		block, err = genesis.Commit(db)
 		if err != nil {
 			return genesis.Config, hash, err
 		}
@@ -197,7 +212,11 @@ func SetupGenesisBlockWithOverride(db ethdb.Database, genesis *Genesis, override
 
 	// Check whether the genesis block is already written.
 	if genesis != nil {
-		hash := genesis.ToBlock(nil).Hash()
+		block, err := genesis.ToBlock(nil)
+		if err != nil {
+			return genesis.Config, common.Hash{}, err
+		}
+		hash := block.Hash()
 		if hash != stored {
 			return genesis.Config, hash, &GenesisMismatchError{stored, hash}
 		}
@@ -217,6 +236,9 @@ func SetupGenesisBlockWithOverride(db ethdb.Database, genesis *Genesis, override
 	storedcfg := rawdb.ReadChainConfig(db, stored)
 	if storedcfg == nil {
 		log.Warn("Found genesis block without chain config")
+		if err := checkOvmGenesis(statedb, newcfg); err != nil {
+			return newcfg, stored, err
+		}
 		rawdb.WriteChainConfig(db, stored, newcfg)
 		return newcfg, stored, nil
 	}
@@ -224,7 +246,10 @@ func SetupGenesisBlockWithOverride(db ethdb.Database, genesis *Genesis, override
 	// config is supplied. These chains would get AllProtocolChanges (and a compat error)
 	// if we just continued here.
 	if genesis == nil && stored != params.MainnetGenesisHash {
-		return storedcfg, stored, nil
+		return storedcfg, stored, checkOvmGenesis(statedb, storedcfg)
+	}
+	if err := checkOvmGenesis(statedb, newcfg); err != nil {
+		return newcfg, stored, err
 	}
 
 	// Check config compatibility and write the config. Compatibility errors
@@ -241,6 +266,69 @@ func SetupGenesisBlockWithOverride(db ethdb.Database, genesis *Genesis, override
 	return newcfg, stored, nil
 }
 
+// checkOvmGenesis refuses to run a chain whose genesis state holds the OVM contracts
+// on the plain EVM. Chains created before the OVM was configurable always ran on the
+// OVM, but their stored chain configuration does not enable it.
+func checkOvmGenesis(statedb *state.StateDB, config *params.ChainConfig) error {
+	if !config.IsOVM() && statedb.GetCodeSize(params.OVMContractsV0.ExecutionManager) > 0 {
+		return errGenesisNoOvm
+	}
+	return nil
+}
+
+// embedOvmStateDump returns the genesis with the OVM contracts of the state dump its
+// chain configuration references embedded in its allocation, and the reference dropped
+// from the configuration. The genesis block and the stored configuration then depend
+// on the content of the dump only, not on where the file is.
+func (g *Genesis) embedOvmStateDump() (*Genesis, error) {
+	if g.Config == nil || g.Config.OVM == nil || g.Config.OVM.StateDump == "" {
+		return g, nil
+	}
+	ovmAlloc, err := ReadOvmStateDump(g.Config.OVM.StateDump)
+	if err != nil {
+		return nil, err
+	}
+	cpy, config, ovm := *g, *g.Config, *g.Config.OVM
+	ovm.StateDump = ""
+	config.OVM, cpy.Config = &ovm, &config
+	cpy.Alloc = mergeGenesisAlloc(ovmAlloc, g.Alloc)
+	return &cpy, nil
+}
+
+// mergeGenesisAlloc layers an allocation over a base one, as if both were applied to
+// the genesis state in turn: balances add up, while the code, nonce and storage slots
+// of the allocation replace those of the base one.
+func mergeGenesisAlloc(base, alloc GenesisAlloc) GenesisAlloc {
+	merged := make(GenesisAlloc, len(base)+len(alloc))
+	for addr, account := range base {
+		merged[addr] = account
+	}
+	for addr, account := range alloc {
+		prev, ok := merged[addr]
+		if !ok {
+			merged[addr] = account
+			continue
+		}
+		balance := new(big.Int)
+		if prev.Balance != nil {
+			balance.Add(balance, prev.Balance)
+		}
+		if account.Balance != nil {
+			balance.Add(balance, account.Balance)
+		}
+		storage := make(map[common.Hash]common.Hash, len(prev.Storage)+len(account.Storage))
+		for key, value := range prev.Storage {
+			storage[key] = value
+		}
+		for key, value := range account.Storage {
+			storage[key] = value
+		}
+		account.Balance, account.Storage = balance, storage
+		merged[addr] = account
+	}
+	return merged
+}
+
 func (g *Genesis) configOrDefault(ghash common.Hash) *params.ChainConfig {
 	switch {
 	case g != nil:
@@ -254,33 +342,58 @@ func (g *Genesis) configOrDefault(ghash common.Hash) *params.ChainConfig {
 	}
 }
 
-// ApplyOvmStateToState applies the initial OVM state to a state object.
-func ApplyOvmStateToState(statedb *state.StateDB) {
-	// Set up the OVM genesis state
-	var initOvmStateDump state.Dump
-	// Load the OVM genesis
-	initOvmStateDumpMarshaled, _ := hex.DecodeString(vm.InitialOvmStateDump)
-	json.Unmarshal(initOvmStateDumpMarshaled, &initOvmStateDump)
-	for addr, account := range initOvmStateDump.Accounts {
-		statedb.AddBalance(addr, big.NewInt(0))
-		statedb.SetCode(addr, common.FromHex(account.Code))
-		statedb.SetNonce(addr, account.Nonce)
+// ReadOvmStateDump reads the OVM contracts from a state dump file into a genesis
+// allocation.
+func ReadOvmStateDump(path string) (GenesisAlloc, error) {
+	blob, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OVM state dump: %v", err)
+	}
+	var dump state.Dump
+	if err := json.Unmarshal(blob, &dump); err != nil {
+		return nil, fmt.Errorf("invalid OVM state dump %s: %v", path, err)
+	}
+	alloc := make(GenesisAlloc, len(dump.Accounts))
+	for addr, account := range dump.Accounts {
+		balance, ok := new(big.Int).SetString(account.Balance, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid balance of %x in OVM state dump: %q", addr, account.Balance)
+		}
+		code, err := hex.DecodeString(strings.TrimPrefix(account.Code, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid code of %x in OVM state dump: %v", addr, err)
+		}
+		storage := make(map[common.Hash]common.Hash, len(account.Storage))
 		for key, value := range account.Storage {
-			statedb.SetState(addr, key, common.HexToHash(value))
+			val, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+			if err != nil {
+				return nil, fmt.Errorf("invalid storage of %x in OVM state dump: %v", addr, err)
+			}
+			storage[key] = common.BytesToHash(val)
+		}
+		alloc[addr] = GenesisAccount{
+			Code:    code,
+			Storage: storage,
+			Balance: balance,
+			Nonce:   account.Nonce,
 		}
 	}
+	return alloc, nil
 }
 
 // ToBlock creates the genesis block and writes state of a genesis specification
-// to the given database (or discards it if nil).
-func (g *Genesis) ToBlock(db ethdb.Database) *types.Block {
+// to the given database (or discards it if nil). The OVM contracts of the state
+// dump referenced by the chain configuration are deployed before the allocation.
+func (g *Genesis) ToBlock(db ethdb.Database) (*types.Block, error) {
 	if db == nil {
 		db = rawdb.NewMemoryDatabase()
 	}
 	statedb, _ := state.New(common.Hash{}, state.NewDatabase(db))
 
-	ApplyOvmStateToState(statedb)
-
+	g, err := g.embedOvmStateDump()
+	if err != nil {
+		return nil, err
+	}
 	for addr, account := range g.Alloc {
 		statedb.AddBalance(addr, account.Balance)
 		statedb.SetCode(addr, account.Code)
@@ -312,13 +425,20 @@ func (g *Genesis) ToBlock(db ethdb.Database) *types.Block {
 	statedb.Commit(false)
 	statedb.Database().TrieDB().Commit(root, true)
 
-	return types.NewBlock(head, nil, nil, nil)
+	return types.NewBlock(head, nil, nil, nil), nil
 }
 
 // Commit writes the block and state of a genesis specification to the database.
 // The block is committed as the canonical head block.
 func (g *Genesis) Commit(db ethdb.Database) (*types.Block, error) {
-	block := g.ToBlock(db)
+	g, err := g.embedOvmStateDump()
+	if err != nil {
+		return nil, err
+	}
+	block, err := g.ToBlock(db)
+	if err != nil {
+		return nil, err
+	}
 	if block.Number().Sign() != 0 {
 		return nil, fmt.Errorf("can't commit genesis block with number > 0")
 	}
@@ -17,7 +17,10 @@
 package core
 
 import (
+	"io/ioutil"
 	"math/big"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -25,29 +28,26 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/params"
 )
 
 func TestDefaultGenesisBlock(t *testing.T) {
-	t.Skip("OVM breaks this test because it adds the OVM contracts to the Genesis state.")
-
-	block := DefaultGenesisBlock().ToBlock(nil)
+	block, _ := DefaultGenesisBlock().ToBlock(nil)
 	if block.Hash() != params.OLDMainnetGenesisHash {
-		t.Errorf("wrong mainnet genesis hash, got %x, want %x", block.Hash(), params.MainnetGenesisHash)
+		t.Errorf("wrong mainnet genesis hash, got %x, want %x", block.Hash(), params.OLDMainnetGenesisHash)
 	}
-	block = DefaultTestnetGenesisBlock().ToBlock(nil)
+	block, _ = DefaultTestnetGenesisBlock().ToBlock(nil)
 	if block.Hash() != params.OLDTestnetGenesisHash {
-		t.Errorf("wrong testnet genesis hash, got %x, want %x", block.Hash(), params.TestnetGenesisHash)
+		t.Errorf("wrong testnet genesis hash, got %x, want %x", block.Hash(), params.OLDTestnetGenesisHash)
 	}
 }
 
 func TestSetupGenesis(t *testing.T) {
-	t.Skip("OVM Genesis breaks this test because it adds the OVM contracts to the state.")
-
 	var (
-		customghash = common.HexToHash("0x59e8ec65c976d6c8439c75702588a151ff0ca96e6d53ea2d641e93700c498d98")
+		customghash = common.HexToHash("0x9bef0f94093629df90b9040294303b8fbb7120557b2d63220c16d2c604c5c029")
 		customg     = Genesis{
 			Config: &params.ChainConfig{HomesteadBlock: big.NewInt(3)},
 			Alloc: GenesisAlloc{
@@ -166,3 +166,155 @@ func TestSetupGenesis(t *testing.T) {
 		}
 	}
 }
+
+// Tests that the OVM contracts of the state dump referenced by the chain
+// configuration are deployed in the genesis state, and that chains without one
+// start from the genesis allocation only.
+func TestGenesisOvmStateDump(t *testing.T) {
+	alloc := GenesisAlloc{{1}: {Balance: big.NewInt(1)}}
+
+	// Plain EVM chains don't get the OVM contracts
+	db := rawdb.NewMemoryDatabase()
+	block, err := (&Genesis{Config: params.TestChainConfig, Alloc: alloc}).ToBlock(db)
+	if err != nil {
+		t.Fatalf("failed to create genesis block: %v", err)
+	}
+	statedb, _ := state.New(block.Root(), state.NewDatabase(db))
+	if statedb.Exist(params.OVMContractsV0.ExecutionManager) {
+		t.Errorf("Execution Manager deployed without OVM configuration")
+	}
+	// OVM chains get the contracts of the state dump as well as the allocation
+	db = rawdb.NewMemoryDatabase()
+	block, err = (&Genesis{Config: ovmChainConfig, Alloc: alloc}).ToBlock(db)
+	if err != nil {
+		t.Fatalf("failed to create genesis block: %v", err)
+	}
+	statedb, _ = state.New(block.Root(), state.NewDatabase(db))
+	if len(statedb.GetCode(params.OVMContractsV0.ExecutionManager)) == 0 {
+		t.Errorf("Execution Manager not deployed")
+	}
+	if statedb.GetBalance(common.Address{1}).Cmp(big.NewInt(1)) != 0 {
+		t.Errorf("genesis allocation not applied")
+	}
+	// Unreadable and malformed state dumps are rejected
+	dir, err := ioutil.TempDir("", "ovm-genesis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	malformed := filepath.Join(dir, "malformed.json")
+	if err := ioutil.WriteFile(malformed, []byte(`{"accounts": {"0x00000000000000000000000000000000dead0000": {"balance": "0", "code": "zz"}}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{filepath.Join(dir, "missing.json"), malformed} {
+		config := *params.TestChainConfig
+		config.OVM = &params.OVMConfig{StateDump: path}
+		if _, err := (&Genesis{Config: &config}).ToBlock(nil); err == nil {
+			t.Errorf("state dump %s: expected error", path)
+		}
+	}
+}
+
+// Tests that the state dump of an OVM chain is embedded in its genesis, so that the
+// stored chain does not depend on the dump file, and that a chain whose genesis state
+// holds the OVM contracts does not start without the OVM, as databases created before
+// it was configurable would.
+func TestSetupGenesisOvm(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ovm-genesis")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	dump, err := ioutil.ReadFile(ovmChainConfig.OVM.StateDump)
+	if err != nil {
+		t.Fatalf("failed to read state dump: %v", err)
+	}
+	path := filepath.Join(dir, "dump.json")
+	if err := ioutil.WriteFile(path, dump, 0600); err != nil {
+		t.Fatalf("failed to write state dump: %v", err)
+	}
+	ovmConfig := *ovmChainConfig
+	ovmConfig.OVM = &params.OVMConfig{StateDump: path}
+	genesis := &Genesis{Config: &ovmConfig}
+	block, err := genesis.ToBlock(nil)
+	if err != nil {
+		t.Fatalf("failed to create genesis block: %v", err)
+	}
+
+	db := rawdb.NewMemoryDatabase()
+	config, hash, err := SetupGenesisBlock(db, genesis)
+	if err != nil {
+		t.Fatalf("failed to set up genesis: %v", err)
+	}
+	if hash != block.Hash() {
+		t.Errorf("genesis hash mismatch: have %x, want %x", hash, block.Hash())
+	}
+	if config.OVM.StateDump != "" {
+		t.Errorf("state dump referenced by the configuration: %s", config.OVM.StateDump)
+	}
+	if stored := rawdb.ReadChainConfig(db, hash); stored.OVM.StateDump != "" {
+		t.Errorf("state dump referenced by the stored configuration: %s", stored.OVM.StateDump)
+	}
+	if genesis.Config.OVM.StateDump != path {
+		t.Errorf("supplied genesis modified: state dump %s", genesis.Config.OVM.StateDump)
+	}
+	os.Remove(path)
+	if _, stored, err := SetupGenesisBlock(db, nil); err != nil {
+		t.Errorf("failed to set up stored genesis without the state dump: %v", err)
+	} else if stored != block.Hash() {
+		t.Errorf("stored genesis hash mismatch: have %x, want %x", stored, block.Hash())
+	}
+
+	// Drop the OVM from the stored configuration, as in databases predating it.
+	legacy := *config
+	legacy.OVM = nil
+	rawdb.WriteChainConfig(db, hash, &legacy)
+	if _, _, err := SetupGenesisBlock(db, nil); err != errGenesisNoOvm {
+		t.Errorf("expected %v without the OVM configuration, got %v", errGenesisNoOvm, err)
+	}
+	if _, _, err := SetupGenesisBlock(db, &Genesis{Config: ovmChainConfig}); err != nil {
+		t.Errorf("failed to set up genesis with the OVM configuration: %v", err)
+	}
+}
+
+// Tests that embedding a state dump layers the genesis allocation over it the same
+// way the genesis state applies them in turn.
+func TestMergeGenesisAlloc(t *testing.T) {
+	var (
+		shared  = common.HexToAddress("0x01")
+		dumped  = common.HexToAddress("0x02")
+		granted = common.HexToAddress("0x03")
+	)
+	base := GenesisAlloc{
+		shared: {Code: []byte{1}, Nonce: 1, Balance: big.NewInt(1), Storage: map[common.Hash]common.Hash{{1}: {1}, {2}: {2}}},
+		dumped: {Code: []byte{2}, Balance: big.NewInt(2)},
+	}
+	alloc := GenesisAlloc{
+		shared:  {Nonce: 2, Balance: big.NewInt(3), Storage: map[common.Hash]common.Hash{{2}: {3}}},
+		granted: {Balance: big.NewInt(4)},
+	}
+	merged := mergeGenesisAlloc(base, alloc)
+
+	applied, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()))
+	for _, alloc := range []GenesisAlloc{base, alloc} {
+		for addr, account := range alloc {
+			applied.AddBalance(addr, account.Balance)
+			applied.SetCode(addr, account.Code)
+			applied.SetNonce(addr, account.Nonce)
+			for key, value := range account.Storage {
+				applied.SetState(addr, key, value)
+			}
+		}
+	}
+	block, err := (&Genesis{Alloc: merged}).ToBlock(nil)
+	if err != nil {
+		t.Fatalf("failed to create genesis block: %v", err)
+	}
+	if root := applied.IntermediateRoot(false); block.Root() != root {
+		t.Errorf("state root mismatch: have %x, want %x", block.Root(), root)
+	}
+	if base[shared].Balance.Int64() != 1 || len(base[shared].Storage) != 2 {
+		t.Errorf("base allocation modified: %v", base[shared])
+	}
+}
@@ -385,6 +385,33 @@ func DeleteTransactionMeta(db ethdb.KeyValueWriter, hash common.Hash) {
 	}
 }
 
+// ReadBlockL1RollupTxId retrieves the L1 tx id of the last L1-to-L2 transaction included
+// in the block with the provided hash or one of its ancestors, or nil if there is none.
+func ReadBlockL1RollupTxId(db ethdb.KeyValueReader, hash common.Hash) *uint64 {
+	data, _ := db.Get(blockL1RollupTxIdKey(hash))
+	if len(data) != 8 {
+		return nil
+	}
+	id := binary.BigEndian.Uint64(data)
+	return &id
+}
+
+// WriteBlockL1RollupTxId stores the L1 tx id of the last L1-to-L2 transaction included
+// in the block with the provided hash or one of its ancestors.
+func WriteBlockL1RollupTxId(db ethdb.KeyValueWriter, hash common.Hash, id uint64) {
+	if err := db.Put(blockL1RollupTxIdKey(hash), encodeBlockNumber(id)); err != nil {
+		log.Crit("Failed to store block L1 rollup tx id", "err", err)
+	}
+}
+
+// DeleteBlockL1RollupTxId removes the L1 tx id of the last L1-to-L2 transaction up to
+// the block with the provided hash.
+func DeleteBlockL1RollupTxId(db ethdb.KeyValueWriter, hash common.Hash) {
+	if err := db.Delete(blockL1RollupTxIdKey(hash)); err != nil {
+		log.Crit("Failed to delete block L1 rollup tx id", "err", err)
+	}
+}
+
 // ReadTdRLP retrieves a block's total difficulty corresponding to the hash in RLP encoding.
 func ReadTdRLP(db ethdb.Reader, hash common.Hash, number uint64) rlp.RawValue {
 	// First try to look up the data in ancient database. Extra hash
@@ -623,10 +650,12 @@ func DeleteBlock(db ethdb.KeyValueWriter, hash common.Hash, number uint64) {
 	DeleteHeader(db, hash, number)
 	DeleteBody(db, hash, number)
 	DeleteTd(db, hash, number)
+	DeleteBlockL1RollupTxId(db, hash)
 }
 
 // DeleteBlockWithoutNumber removes all block data associated with a hash, except
-// the hash to number mapping.
+// the hash to number mapping and the L1 rollup tx id, which are kept for blocks
+// moved to the ancient store.
 func DeleteBlockWithoutNumber(db ethdb.KeyValueWriter, hash common.Hash, number uint64) {
 	DeleteReceipts(db, hash, number)
 	deleteHeaderWithoutNumber(db, hash, number)
@@ -471,3 +471,31 @@ func TestBlockMetaStorage(t *testing.T) {
 		t.Fatalf("Could not recover sighash type")
 	}
 }
+
+func TestBlockL1RollupTxIdStorage(t *testing.T) {
+	db := NewMemoryDatabase()
+	hash := common.HexToHash("0x01")
+
+	if id := ReadBlockL1RollupTxId(db, hash); id != nil {
+		t.Fatalf("non existent L1 rollup tx id returned: %d", *id)
+	}
+	WriteBlockL1RollupTxId(db, hash, 777)
+	if id := ReadBlockL1RollupTxId(db, hash); id == nil || *id != 777 {
+		t.Fatalf("L1 rollup tx id mismatch: have %v, want 777", id)
+	}
+	DeleteBlockL1RollupTxId(db, hash)
+	if id := ReadBlockL1RollupTxId(db, hash); id != nil {
+		t.Fatalf("deleted L1 rollup tx id returned: %d", *id)
+	}
+
+	// Blocks moved to the ancient store keep their id, deleted blocks do not
+	WriteBlockL1RollupTxId(db, hash, 777)
+	DeleteBlockWithoutNumber(db, hash, 1)
+	if id := ReadBlockL1RollupTxId(db, hash); id == nil || *id != 777 {
+		t.Fatalf("L1 rollup tx id of ancient block mismatch: have %v, want 777", id)
+	}
+	DeleteBlock(db, hash, 1)
+	if id := ReadBlockL1RollupTxId(db, hash); id != nil {
+		t.Fatalf("L1 rollup tx id of deleted block returned: %d", *id)
+	}
+}
@@ -17,6 +17,7 @@
 package rawdb
 
 import (
+	"encoding/binary"
 	"encoding/json"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -52,6 +53,59 @@ func WriteDatabaseVersion(db ethdb.KeyValueWriter, version uint64) {
 	}
 }
 
+// ReadRollupSubmissionNumber retrieves the number of the last accepted rollup
+// transaction submission, or nil if none was accepted.
+func ReadRollupSubmissionNumber(db ethdb.KeyValueReader) *uint64 {
+	enc, _ := db.Get(rollupSubmissionKey)
+	if len(enc) != 8 {
+		return nil
+	}
+	number := binary.BigEndian.Uint64(enc)
+	return &number
+}
+
+// WriteRollupSubmissionNumber stores the number of the last accepted rollup
+// transaction submission.
+func WriteRollupSubmissionNumber(db ethdb.KeyValueWriter, number uint64) {
+	if err := db.Put(rollupSubmissionKey, encodeBlockNumber(number)); err != nil {
+		log.Crit("Failed to store the rollup submission number", "err", err)
+	}
+}
+
+// DeleteRollupSubmissionNumber removes the number of the last accepted rollup
+// transaction submission.
+func DeleteRollupSubmissionNumber(db ethdb.KeyValueWriter) {
+	if err := db.Delete(rollupSubmissionKey); err != nil {
+		log.Crit("Failed to delete the rollup submission number", "err", err)
+	}
+}
+
+// ReadL1RollupTxIdBackfill retrieves the number of the last canonical block up to
+// which the L1 rollup tx ids were backfilled, or nil if there is none.
+func ReadL1RollupTxIdBackfill(db ethdb.KeyValueReader) *uint64 {
+	enc, _ := db.Get(l1RollupTxIdBackfillKey)
+	if len(enc) != 8 {
+		return nil
+	}
+	number := binary.BigEndian.Uint64(enc)
+	return &number
+}
+
+// WriteL1RollupTxIdBackfill stores the number of the last canonical block up to
+// which the L1 rollup tx ids were backfilled.
+func WriteL1RollupTxIdBackfill(db ethdb.KeyValueWriter, number uint64) {
+	if err := db.Put(l1RollupTxIdBackfillKey, encodeBlockNumber(number)); err != nil {
+		log.Crit("Failed to store the L1 rollup tx id backfill progress", "err", err)
+	}
+}
+
+// DeleteL1RollupTxIdBackfill removes the L1 rollup tx id backfill progress.
+func DeleteL1RollupTxIdBackfill(db ethdb.KeyValueWriter) {
+	if err := db.Delete(l1RollupTxIdBackfillKey); err != nil {
+		log.Crit("Failed to delete the L1 rollup tx id backfill progress", "err", err)
+	}
+}
+
 // ReadChainConfig retrieves the consensus settings based on the given genesis hash.
 func ReadChainConfig(db ethdb.KeyValueReader, hash common.Hash) *params.ChainConfig {
 	data, _ := db.Get(configKey(hash))
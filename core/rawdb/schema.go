@@ -41,6 +41,12 @@ var (
 	// fastTrieProgressKey tracks the number of trie entries imported during fast sync.
 	fastTrieProgressKey = []byte("TrieSync")
 
+	// rollupSubmissionKey tracks the number of the last accepted rollup transaction submission.
+	rollupSubmissionKey = []byte("LastRollupSubmission")
+
+	// l1RollupTxIdBackfillKey tracks the last canonical block whose L1 rollup tx id was backfilled.
+	l1RollupTxIdBackfillKey = []byte("L1RollupTxIdBackfill")
+
 	// Data item prefixes (use single byte to avoid mixing data types, avoid `i`, used for indexes).
 	headerPrefix       = []byte("h") // headerPrefix + num (uint64 big endian) + hash -> header
 	headerTDSuffix     = []byte("t") // headerPrefix + num (uint64 big endian) + hash + headerTDSuffix -> td
@@ -54,7 +60,8 @@ var (
 	bloomBitsPrefix = []byte("B") // bloomBitsPrefix + bit (uint16 big endian) + section (uint64 big endian) + hash -> bloom bits
 
 	// Optmism specific
-	txMetaPrefix = []byte("x") // txMetaPrefix + hash -> transaction metadata
+	txMetaPrefix            = []byte("x") // txMetaPrefix + hash -> transaction metadata
+	blockL1RollupTxIdPrefix = []byte("q") // blockL1RollupTxIdPrefix + hash -> L1 tx id of the last L1-to-L2 transaction up to the block (uint64 big endian)
 
 	preimagePrefix = []byte("secure-key-")      // preimagePrefix + hash -> preimage
 	configPrefix   = []byte("ethereum-config-") // config prefix for the db
@@ -153,6 +160,11 @@ func txMetaKey(hash common.Hash) []byte {
 	return append(txMetaPrefix, hash.Bytes()...)
 }
 
+// blockL1RollupTxIdKey = blockL1RollupTxIdPrefix + hash
+func blockL1RollupTxIdKey(hash common.Hash) []byte {
+	return append(blockL1RollupTxIdPrefix, hash.Bytes()...)
+}
+
 // bloomBitsKey = bloomBitsPrefix + bit (uint16 big endian) + section (uint64 big endian) + hash
 func bloomBitsKey(bit uint, section uint64, hash common.Hash) []byte {
 	key := append(append(bloomBitsPrefix, make([]byte, 10)...), hash.Bytes()...)
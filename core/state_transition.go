@@ -21,11 +21,11 @@ import (
 	"fmt"
 	"math"
 	"math/big"
-	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
@@ -33,17 +33,8 @@ import (
 
 var (
 	errInsufficientBalanceForGas = errors.New("insufficient balance to pay for gas")
-	executionManagerAbi          abi.ABI
 )
 
-func init() {
-	var err error
-	executionManagerAbi, err = abi.JSON(strings.NewReader(vm.RawExecutionManagerAbi))
-	if err != nil {
-		panic(fmt.Sprintf("Error reading ExecutionManagerAbi! Error: %s", err))
-	}
-}
-
 /*
 The State Transitioning Model
 
@@ -236,9 +227,16 @@ func (st *StateTransition) TransitionDb() (ret []byte, usedGas uint64, failed bo
 	if executionMgrTime.Cmp(big.NewInt(0)) == 0 {
 		executionMgrTime = big.NewInt(1)
 	}
+	executionMgrNumber := st.evm.L1BlockNumber
+	if executionMgrNumber == nil {
+		executionMgrNumber = new(big.Int)
+	}
 
-	// TODO: queue origin is always 0 with current version of em
-	queueOrigin := big.NewInt(0)
+	// Messages without transaction metadata are treated as sent to the sequencer.
+	queueOrigin := msg.QueueOrigin()
+	if queueOrigin == nil {
+		queueOrigin = big.NewInt(int64(types.QueueOriginSequencer))
+	}
 
 	l1MessageSender := msg.L1MessageSender()
 	if l1MessageSender == nil {
@@ -246,42 +244,69 @@ func (st *StateTransition) TransitionDb() (ret []byte, usedGas uint64, failed bo
 		l1MessageSender = &addr
 	}
 
-	log.Debug("Applying transaction", "from", sender.Address().Hex(), "to", to, "nonce", msg.Nonce(), "l1MessageSender", l1MessageSender.Hex(), "data", hexutil.Encode(msg.Data()))
+	log.Debug("Applying transaction", "from", sender.Address().Hex(), "to", to, "nonce", msg.Nonce(), "queueOrigin", queueOrigin, "l1MessageSender", l1MessageSender.Hex(), "data", hexutil.Bytes(msg.Data()))
 
-	if contractCreation {
+	var (
+		ovm                 = evm.ChainConfig().IsOVM()
+		contracts           *params.OVMContracts
+		executionManagerAbi abi.ABI
+	)
+	if ovm {
+		contracts = evm.ChainConfig().OVM.SystemContractsAt(evm.BlockNumber)
+		if executionManagerAbi, err = vm.ExecutionManagerAbi(contracts); err != nil {
+			return nil, 0, false, err
+		}
+	}
+	switch {
+	case !ovm && contractCreation:
+		ret, _, st.gas, vmerr = evm.Create(sender, st.data, st.gas, st.value)
+	case !ovm:
+		// Increment the nonce for the next transaction
+		st.state.SetNonce(msg.From(), st.state.GetNonce(sender.Address())+1)
+		ret, st.gas, vmerr = evm.Call(sender, st.to(), st.data, st.gas, st.value)
+	case contractCreation:
 		// Here we are going to call the EM directly
-		deployContractCalldata, _ := executionManagerAbi.Pack(
-			"executeTransaction",
-			executionMgrTime,        // lastL1Timestamp
-			queueOrigin,             // queueOrigin
-			common.HexToAddress(""), // ovmEntrypoint
-			st.data,                 // callBytes
-			sender,                  // fromAddress
-			l1MessageSender,         // l1MsgSenderAddress
-			true,                    // allowRevert
-		)
-
-		ret, st.gas, vmerr = evm.Call(sender, vm.ExecutionManagerAddress, deployContractCalldata, st.gas, st.value)
-	} else {
-		callContractCalldata, _ := executionManagerAbi.Pack(
-			"executeTransaction",
-			executionMgrTime, // lastL1Timestamp
-			queueOrigin,      // queueOrigin
-			st.to(),          // ovmEntrypoint
-			st.data,          // callBytes
-			sender,           // fromAddress
-			l1MessageSender,  // l1MsgSenderAddress
-			true,             // allowRevert
-		)
-
-		ret, st.gas, vmerr = evm.Call(sender, vm.ExecutionManagerAddress, callContractCalldata, st.gas, st.value)
+		deployContractCalldata, err := executeTransactionCalldata(executionManagerAbi, map[string]interface{}{
+			"_timestamp":          executionMgrTime,
+			"_blockNumber":        executionMgrNumber,
+			"_queueOrigin":        queueOrigin,
+			"_ovmEntrypoint":      common.HexToAddress(""),
+			"_callBytes":          st.data,
+			"_fromAddress":        sender,
+			"_l1MsgSenderAddress": l1MessageSender,
+			"_allowRevert":        true,
+		})
+		if err != nil {
+			return nil, 0, false, err
+		}
+
+		ret, st.gas, vmerr = evm.Call(sender, contracts.ExecutionManager, deployContractCalldata, st.gas, st.value)
+	default:
+		callContractCalldata, err := executeTransactionCalldata(executionManagerAbi, map[string]interface{}{
+			"_timestamp":          executionMgrTime,
+			"_blockNumber":        executionMgrNumber,
+			"_queueOrigin":        queueOrigin,
+			"_ovmEntrypoint":      st.to(),
+			"_callBytes":          st.data,
+			"_fromAddress":        sender,
+			"_l1MsgSenderAddress": l1MessageSender,
+			"_allowRevert":        true,
+		})
+		if err != nil {
+			return nil, 0, false, err
+		}
+
+		ret, st.gas, vmerr = evm.Call(sender, contracts.ExecutionManager, callContractCalldata, st.gas, st.value)
 	}
 	if vmerr != nil {
 		log.Debug("VM returned with error", "err", vmerr)
 
-		// If the tx fails we won't have incremented the nonce. In this case, increment it manually
-		log.Debug("Incrementing nonce due to transaction failure")
-		st.state.SetNonce(msg.From(), st.state.GetNonce(sender.Address())+1)
+		// If the tx fails the Execution Manager won't have incremented the nonce.
+		// In this case, increment it manually
+		if ovm {
+			log.Debug("Incrementing nonce due to transaction failure")
+			st.state.SetNonce(msg.From(), st.state.GetNonce(sender.Address())+1)
+		}
 
 		// The only possible consensus-error would be if there wasn't
 		// sufficient balance to make the transfer happen. The first
@@ -293,10 +318,30 @@ func (st *StateTransition) TransitionDb() (ret []byte, usedGas uint64, failed bo
 	st.refundGas()
 	st.state.AddBalance(st.evm.Coinbase, new(big.Int).Mul(new(big.Int).SetUint64(st.gasUsed()), st.gasPrice))
 
-	log.Debug("return data", "data", hexutil.Encode(ret))
+	log.Debug("return data", "data", hexutil.Bytes(ret))
 	return ret, st.gasUsed(), vmerr != nil, err
 }
 
+// executeTransactionCalldata packs a call to executeTransaction of the Execution
+// Manager, taking each argument from args by the name of its ABI input. This lets
+// Execution Managers which take the L1 block number as _blockNumber expose it to
+// ovmNUMBER, while earlier versions without that input keep working.
+func executeTransactionCalldata(executionManagerAbi abi.ABI, args map[string]interface{}) ([]byte, error) {
+	method, ok := executionManagerAbi.Methods["executeTransaction"]
+	if !ok {
+		return nil, errors.New("execution manager has no executeTransaction method")
+	}
+	values := make([]interface{}, len(method.Inputs))
+	for i, input := range method.Inputs {
+		value, ok := args[input.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown executeTransaction input %q", input.Name)
+		}
+		values[i] = value
+	}
+	return executionManagerAbi.Pack("executeTransaction", values...)
+}
+
 func (st *StateTransition) refundGas() {
 	// Apply refund counter, capped to half of the used gas.
 	refund := st.gasUsed() / 2
@@ -318,3 +363,28 @@ func (st *StateTransition) refundGas() {
 func (st *StateTransition) gasUsed() uint64 {
 	return st.initialGas - st.gas
 }
+
+// RevertData returns the revert data of a message executed on the given chain. On
+// OVM chains, reverts which the Execution Manager caught and reported through an
+// EOACallRevert event take precedence over the returndata of a failed message.
+func RevertData(config *params.ChainConfig, number *big.Int, ret []byte, failed bool, logs []*types.Log) []byte {
+	if config.IsOVM() {
+		contracts := config.OVM.SystemContractsAt(number)
+		if executionManagerAbi, err := vm.ExecutionManagerAbi(contracts); err == nil {
+			event := executionManagerAbi.Events["EOACallRevert"]
+			for _, log := range logs {
+				if log.Address != contracts.ExecutionManager || len(log.Topics) == 0 || log.Topics[0] != event.ID() {
+					continue
+				}
+				var message []byte
+				if err := event.Inputs.Unpack(&message, log.Data); err == nil {
+					return message
+				}
+			}
+		}
+	}
+	if failed {
+		return ret
+	}
+	return nil
+}
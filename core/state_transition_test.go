@@ -0,0 +1,203 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Tests that the queue origin of a transaction is passed to the Execution Manager,
+// defaulting to the sequencer queue for transactions without one.
+func TestStateTransitionQueueOrigin(t *testing.T) {
+	// The Execution Manager stand-in stores the queueOrigin argument of
+	// executeTransaction, the second word of its calldata, in slot 0.
+	code := []byte{byte(vm.PUSH1), 0x24, byte(vm.CALLDATALOAD), byte(vm.PUSH1), 0x00, byte(vm.SSTORE), byte(vm.STOP)}
+	key, _ := crypto.GenerateKey()
+
+	tests := []struct {
+		origin   *big.Int
+		expected types.QueueOrigin
+	}{
+		{big.NewInt(int64(types.QueueOriginL1ToL2)), types.QueueOriginL1ToL2},
+		{big.NewInt(int64(types.QueueOriginSafety)), types.QueueOriginSafety},
+		{big.NewInt(int64(types.QueueOriginSequencer)), types.QueueOriginSequencer},
+		{nil, types.QueueOriginSequencer},
+	}
+	for i, tt := range tests {
+		statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()))
+		statedb.SetCode(params.OVMContractsV0.ExecutionManager, code)
+		statedb.SetState(params.OVMContractsV0.ExecutionManager, common.Hash{}, common.BigToHash(big.NewInt(0xff)))
+
+		tx, _ := types.SignTx(types.NewTransaction(0, common.HexToAddress("0x01"), big.NewInt(0), 100000, big.NewInt(0), nil, nil, nil, types.QueueOriginSequencer, types.SighashEIP155), types.HomesteadSigner{}, key)
+		meta := tx.GetMeta()
+		meta.QueueOrigin = tt.origin
+		tx.SetTransactionMeta(meta)
+		msg, err := tx.AsMessage(types.HomesteadSigner{})
+		if err != nil {
+			t.Fatalf("test %d: failed to create message: %v", i, err)
+		}
+		context := vm.Context{
+			CanTransfer: CanTransfer,
+			Transfer:    Transfer,
+			BlockNumber: big.NewInt(1),
+			Time:        big.NewInt(1),
+			Difficulty:  big.NewInt(1),
+			GasLimit:    1000000,
+		}
+		evm := vm.NewEVM(context, statedb, ovmChainConfig, vm.Config{})
+		if _, _, _, err := ApplyMessage(evm, msg, new(GasPool).AddGas(1000000)); err != nil {
+			t.Fatalf("test %d: failed to apply message: %v", i, err)
+		}
+		if origin := statedb.GetState(params.OVMContractsV0.ExecutionManager, common.Hash{}).Big(); origin.Int64() != int64(tt.expected) {
+			t.Errorf("test %d: queue origin mismatch: have %v, want %d", i, origin, tt.expected)
+		}
+	}
+}
+
+// Tests that the L1 block number recorded in the header is passed to Execution
+// Managers taking it as the _blockNumber input of executeTransaction.
+func TestStateTransitionL1BlockNumber(t *testing.T) {
+	// The Execution Manager stand-in stores the _blockNumber argument of
+	// executeTransaction, the second word of its calldata, in slot 0.
+	code := []byte{byte(vm.PUSH1), 0x24, byte(vm.CALLDATALOAD), byte(vm.PUSH1), 0x00, byte(vm.SSTORE), byte(vm.STOP)}
+	key, _ := crypto.GenerateKey()
+
+	contracts := *params.OVMContractsV0
+	contracts.ExecutionManagerAbi = `[{"type":"function","name":"executeTransaction","inputs":[
+		{"name":"_timestamp","type":"uint256"},{"name":"_blockNumber","type":"uint256"},{"name":"_queueOrigin","type":"uint256"},
+		{"name":"_ovmEntrypoint","type":"address"},{"name":"_callBytes","type":"bytes"},{"name":"_fromAddress","type":"address"},
+		{"name":"_l1MsgSenderAddress","type":"address"},{"name":"_allowRevert","type":"bool"}],"outputs":[]}]`
+	config := *params.TestChainConfig
+	config.OVM = &params.OVMConfig{Contracts: &contracts}
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()))
+	statedb.SetCode(contracts.ExecutionManager, code)
+
+	tx, _ := types.SignTx(types.NewTransaction(0, common.HexToAddress("0x01"), big.NewInt(0), 100000, big.NewInt(0), nil, nil, nil, types.QueueOriginSequencer, types.SighashEIP155), types.HomesteadSigner{}, key)
+	msg, err := tx.AsMessage(types.HomesteadSigner{})
+	if err != nil {
+		t.Fatalf("failed to create message: %v", err)
+	}
+	header := &types.Header{
+		Number:     big.NewInt(1),
+		Time:       1601475125,
+		Difficulty: big.NewInt(1),
+		GasLimit:   1000000,
+		Extra:      types.L1Context{BlockNumber: 10934832, Timestamp: 1601475125}.Extra(),
+	}
+	evm := vm.NewEVM(NewEVMContext(msg, header, nil, &common.Address{}), statedb, &config, vm.Config{})
+	if _, _, _, err := ApplyMessage(evm, msg, new(GasPool).AddGas(1000000)); err != nil {
+		t.Fatalf("failed to apply message: %v", err)
+	}
+	if number := statedb.GetState(contracts.ExecutionManager, common.Hash{}).Big(); number.Uint64() != 10934832 {
+		t.Errorf("l1 block number mismatch: have %v, want %d", number, 10934832)
+	}
+}
+
+// Tests that contracts running through the shipped Execution Manager read the L1 block
+// number recorded in the header with NUMBER.
+func TestOvmNumberL1BlockNumber(t *testing.T) {
+	statedb, err := newOvmStorageState(vm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The deployed contract returns NUMBER.
+	runtime := []byte{byte(vm.NUMBER), byte(vm.PUSH1), 0x00, byte(vm.MSTORE), byte(vm.PUSH1), 0x20, byte(vm.PUSH1), 0x00, byte(vm.RETURN)}
+	code := append([]byte{
+		byte(vm.PUSH1), byte(len(runtime)), byte(vm.PUSH1), 0x0c, byte(vm.PUSH1), 0x00, byte(vm.CODECOPY),
+		byte(vm.PUSH1), byte(len(runtime)), byte(vm.PUSH1), 0x00, byte(vm.RETURN),
+	}, runtime...)
+	ret, _, failed, err := applyOvmMessage(statedb, vm.Config{}, nil, code)
+	if err != nil || failed {
+		t.Fatalf("failed to deploy contract: failed %t, err %v", failed, err)
+	}
+	contract := common.BytesToAddress(ret)
+
+	header := &types.Header{
+		Number:     big.NewInt(1),
+		Time:       1601475125,
+		Difficulty: big.NewInt(1),
+		GasLimit:   15000000,
+		Extra:      types.L1Context{BlockNumber: 10934832, Timestamp: 1601475125}.Extra(),
+	}
+	msg := types.NewMessage(ovmStorageSender, &contract, statedb.GetNonce(ovmStorageSender), new(big.Int), 15000000, new(big.Int), nil, false, &common.Address{}, nil, types.QueueOriginSequencer, types.SighashEthSign)
+	evm := vm.NewEVM(NewEVMContext(msg, header, nil, &ovmStorageSender), statedb, ovmChainConfig, vm.Config{})
+	ret, _, failed, err = ApplyMessage(evm, msg, new(GasPool).AddGas(15000000))
+	if err != nil || failed {
+		t.Fatalf("failed to call contract: failed %t, err %v", failed, err)
+	}
+	if number := new(big.Int).SetBytes(ret); number.Uint64() != 10934832 {
+		t.Errorf("l1 block number mismatch: have %v, want %d", number, 10934832)
+	}
+}
+
+// Tests that the revert data of a message is taken from the EOACallRevert event
+// of the Execution Manager if there is one, and from the returndata otherwise.
+func TestRevertData(t *testing.T) {
+	executionManagerAbi, err := vm.ExecutionManagerAbi(params.OVMContractsV0)
+	if err != nil {
+		t.Fatalf("failed to parse Execution Manager ABI: %v", err)
+	}
+	event := executionManagerAbi.Events["EOACallRevert"]
+	eventData, err := event.Inputs.Pack([]byte("event"))
+	if err != nil {
+		t.Fatalf("failed to pack event: %v", err)
+	}
+	revertLog := &types.Log{Address: params.OVMContractsV0.ExecutionManager, Topics: []common.Hash{event.ID()}, Data: eventData}
+	otherLog := &types.Log{Address: common.HexToAddress("0x01"), Topics: []common.Hash{event.ID()}, Data: eventData}
+
+	// From block 5 on, the Execution Manager is moved by an upgrade.
+	upgradedContracts := *params.OVMContractsV0
+	upgradedContracts.ExecutionManager = common.HexToAddress("0x01")
+	upgradedConfig := *ovmChainConfig
+	upgradedConfig.OVM = &params.OVMConfig{Upgrades: []*params.OVMUpgrade{{Block: big.NewInt(5), Contracts: &upgradedContracts}}}
+
+	tests := []struct {
+		config *params.ChainConfig
+		number int64
+		ret    []byte
+		failed bool
+		logs   []*types.Log
+		want   []byte
+	}{
+		{ovmChainConfig, 1, []byte("returndata"), false, nil, nil},
+		{ovmChainConfig, 1, []byte("returndata"), true, nil, []byte("returndata")},
+		{ovmChainConfig, 1, []byte("returndata"), true, []*types.Log{otherLog}, []byte("returndata")},
+		{ovmChainConfig, 1, []byte("returndata"), false, []*types.Log{otherLog, revertLog}, []byte("event")},
+		{ovmChainConfig, 1, []byte("returndata"), true, []*types.Log{revertLog}, []byte("event")},
+		{&upgradedConfig, 4, []byte("returndata"), true, []*types.Log{otherLog}, []byte("returndata")},
+		{&upgradedConfig, 5, []byte("returndata"), true, []*types.Log{otherLog}, []byte("event")},
+		{&upgradedConfig, 5, []byte("returndata"), true, []*types.Log{revertLog}, []byte("returndata")},
+		{params.TestChainConfig, 1, []byte("returndata"), false, []*types.Log{revertLog}, nil},
+		{params.TestChainConfig, 1, []byte("returndata"), true, []*types.Log{revertLog}, []byte("returndata")},
+	}
+	for i, tt := range tests {
+		if have := RevertData(tt.config, big.NewInt(tt.number), tt.ret, tt.failed, tt.logs); !bytes.Equal(have, tt.want) {
+			t.Errorf("test %d: revert data mismatch: have %q, want %q", i, have, tt.want)
+		}
+	}
+}
@@ -40,6 +40,56 @@ type devNull struct{}
 func (*devNull) Write(p []byte) (n int, err error) { return len(p), nil }
 func (*devNull) Close() error                      { return nil }
 
+// journalEntry is a journaled transaction along with its metadata, which is not
+// part of the RLP encoding of the transaction.
+type journalEntry struct {
+	Tx   *types.Transaction
+	Meta []byte
+
+	// Submission holds the rollup submission details of transactions that came in
+	// one. It is empty for other transactions, and in entries journaled before the
+	// details were.
+	Submission []journalSubmission `rlp:"tail"`
+}
+
+// journalSubmission is the part of a rollup submission a journaled transaction
+// keeps: the L1 context it is mined in, and the submission size if it opens it.
+type journalSubmission struct {
+	L1Context types.L1Context
+	Size      uint64
+}
+
+func newJournalEntry(tx *types.Transaction) *journalEntry {
+	entry := &journalEntry{Tx: tx, Meta: types.TxMetaEncode(tx.GetMeta())}
+	if l1Context := tx.L1Context(); l1Context != nil {
+		entry.Submission = []journalSubmission{{L1Context: *l1Context, Size: uint64(tx.SubmissionSize())}}
+	}
+	return entry
+}
+
+// decodeJournalEntry decodes a journaled transaction. Journals written before
+// the metadata was journaled hold bare transactions.
+func decodeJournalEntry(data []byte) (*types.Transaction, error) {
+	entry := new(journalEntry)
+	if err := rlp.DecodeBytes(data, entry); err != nil {
+		tx := new(types.Transaction)
+		if err := rlp.DecodeBytes(data, tx); err != nil {
+			return nil, err
+		}
+		return tx, nil
+	}
+	meta, err := types.TxMetaDecode(entry.Meta)
+	if err != nil {
+		return nil, err
+	}
+	entry.Tx.SetTransactionMeta(meta)
+	if len(entry.Submission) > 0 {
+		entry.Tx.SetL1Context(entry.Submission[0].L1Context)
+		entry.Tx.SetSubmissionSize(int(entry.Submission[0].Size))
+	}
+	return entry.Tx, nil
+}
+
 // txJournal is a rotating log of transactions with the aim of storing locally
 // created transactions to allow non-executed ones to survive node restarts.
 type txJournal struct {
@@ -55,7 +105,8 @@ func newTxJournal(path string) *txJournal {
 }
 
 // load parses a transaction journal dump from disk, loading its contents into
-// the specified pool.
+// the specified pool. The transactions of a rollup submission are always loaded
+// in the same batch.
 func (journal *txJournal) load(add func([]*types.Transaction) []error) error {
 	// Skip the parsing if the journal file doesn't exist at all
 	if _, err := os.Stat(journal.path); os.IsNotExist(err) {
@@ -90,11 +141,16 @@ func (journal *txJournal) load(add func([]*types.Transaction) []error) error {
 	var (
 		failure error
 		batch   types.Transactions
+		pending int // Transactions of the last submission yet to be parsed
 	)
 	for {
 		// Parse the next transaction and terminate on error
-		tx := new(types.Transaction)
-		if err = stream.Decode(tx); err != nil {
+		var tx *types.Transaction
+		data, err := stream.Raw()
+		if err == nil {
+			tx, err = decodeJournalEntry(data)
+		}
+		if err != nil {
 			if err != io.EOF {
 				failure = err
 			}
@@ -106,7 +162,12 @@ func (journal *txJournal) load(add func([]*types.Transaction) []error) error {
 		// New transaction parsed, queue up for later, import if threshold is reached
 		total++
 
-		if batch = append(batch, tx); batch.Len() > 1024 {
+		if size := tx.SubmissionSize(); size > 1 {
+			pending = size - 1
+		} else if pending > 0 {
+			pending--
+		}
+		if batch = append(batch, tx); batch.Len() > 1024 && pending == 0 {
 			loadBatch(batch)
 			batch = batch[:0]
 		}
@@ -121,7 +182,7 @@ func (journal *txJournal) insert(tx *types.Transaction) error {
 	if journal.writer == nil {
 		return errNoActiveJournal
 	}
-	if err := rlp.Encode(journal.writer, tx); err != nil {
+	if err := rlp.Encode(journal.writer, newJournalEntry(tx)); err != nil {
 		return err
 	}
 	return nil
@@ -145,7 +206,7 @@ func (journal *txJournal) rotate(all map[common.Address]types.Transactions) erro
 	journaled := 0
 	for _, txs := range all {
 		for _, tx := range txs {
-			if err = rlp.Encode(replacement, tx); err != nil {
+			if err = rlp.Encode(replacement, newJournalEntry(tx)); err != nil {
 				replacement.Close()
 				return err
 			}
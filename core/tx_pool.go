@@ -88,6 +88,14 @@ var (
 	// than some meaningful limit a user might use. This is not a consensus error
 	// making the transaction invalid, rather a DOS protection.
 	ErrOversizedData = errors.New("oversized data")
+
+	// ErrBatchRejected is returned for the valid transactions of an atomically added
+	// batch that was rejected because of another transaction in it.
+	ErrBatchRejected = errors.New("transaction batch rejected")
+
+	// ErrNonceOverlap is returned if a transaction of an atomically added batch has the
+	// nonce of a transaction already in the pool or earlier in the batch.
+	ErrNonceOverlap = errors.New("nonce already in use")
 )
 
 var (
@@ -300,7 +308,7 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig, chain block
 	if !config.NoLocals && config.Journal != "" {
 		pool.journal = newTxJournal(config.Journal)
 
-		if err := pool.journal.load(pool.AddLocals); err != nil {
+		if err := pool.journal.load(pool.addJournaled); err != nil {
 			log.Warn("Failed to load transaction journal", "err", err)
 		}
 		if err := pool.journal.rotate(pool.local()); err != nil {
@@ -574,6 +582,11 @@ func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
 // whitelisted, preventing any associated transaction from being dropped out of the pool
 // due to pricing constraints.
 func (pool *TxPool) add(tx *types.Transaction, local bool) (replaced bool, err error) {
+	return pool.addTx(tx, local, true)
+}
+
+// addTx inserts a transaction as add does, journaling it only if requested.
+func (pool *TxPool) addTx(tx *types.Transaction, local, journal bool) (replaced bool, err error) {
 	// If the transaction is already known, discard it
 	hash := tx.Hash()
 	if pool.all.Get(hash) != nil {
@@ -620,7 +633,9 @@ func (pool *TxPool) add(tx *types.Transaction, local bool) (replaced bool, err e
 		}
 		pool.all.Add(tx)
 		pool.priced.Put(tx)
-		pool.journalTx(from, tx)
+		if journal {
+			pool.journalTx(from, tx)
+		}
 		pool.queueTxEvent(tx)
 		log.Trace("Pooled new executable transaction", "hash", hash, "from", from, "to", tx.To())
 		return old != nil, nil
@@ -640,7 +655,9 @@ func (pool *TxPool) add(tx *types.Transaction, local bool) (replaced bool, err e
 	if local || pool.locals.contains(from) {
 		localGauge.Inc(1)
 	}
-	pool.journalTx(from, tx)
+	if journal {
+		pool.journalTx(from, tx)
+	}
 
 	log.Trace("Pooled new future transaction", "hash", hash, "from", from, "to", tx.To())
 	return replaced, nil
@@ -740,6 +757,185 @@ func (pool *TxPool) AddLocals(txs []*types.Transaction) []error {
 	return pool.addTxs(txs, !pool.config.NoLocals, true)
 }
 
+// AddLocalsAtomic enqueues a batch of transactions into the pool as local ones, either all
+// of them or none. The whole batch is validated before any of it is added, so that no
+// transaction of a rejected batch ever becomes pending and a rejected batch leaves the
+// pool untouched. If the batch is rejected, the valid transactions of it fail with
+// ErrBatchRejected.
+//
+// Transactions of the batch may not replace pooled transactions.
+func (pool *TxPool) AddLocalsAtomic(txs []*types.Transaction) []error {
+	// Cache senders in transactions before obtaining lock (pool.signer is immutable)
+	for _, tx := range txs {
+		types.Sender(pool.signer, tx)
+	}
+	pool.mu.Lock()
+	errs, dirtyAddrs := pool.addTxsAtomicLocked(txs, !pool.config.NoLocals)
+	pool.mu.Unlock()
+
+	if dirtyAddrs != nil {
+		<-pool.requestPromoteExecutables(dirtyAddrs)
+	}
+	return errs
+}
+
+// addJournaled adds transactions loaded from the journal back into the pool as local
+// ones. The transactions of a rollup submission are added atomically, as they were
+// first, so that a submission is never restored in part.
+func (pool *TxPool) addJournaled(txs []*types.Transaction) []error {
+	errs := make([]error, 0, len(txs))
+	for len(txs) > 0 {
+		if size := txs[0].SubmissionSize(); size > 1 {
+			if size > len(txs) {
+				size = len(txs)
+			}
+			errs = append(errs, pool.AddLocalsAtomic(txs[:size])...)
+			txs = txs[size:]
+			continue
+		}
+		n := 1
+		for n < len(txs) && txs[n].SubmissionSize() <= 1 {
+			n++
+		}
+		errs = append(errs, pool.AddLocals(txs[:n])...)
+		txs = txs[n:]
+	}
+	return errs
+}
+
+// addTxsAtomicLocked attempts to queue a batch of transactions if all of them are valid,
+// returning nil dirty accounts if the batch was rejected. The pool is only touched once
+// the whole batch is known to be accepted: room is made for all of it at once, and local
+// transactions are only journaled once all of them are queued.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *TxPool) addTxsAtomicLocked(txs []*types.Transaction, local bool) ([]error, *accountSet) {
+	var (
+		errs     = make([]error, len(txs))
+		rejected = false
+		nonces   = make(map[common.Address]map[uint64]struct{})
+	)
+	for i, tx := range txs {
+		if errs[i] = pool.validateBatchTx(tx, local, nonces); errs[i] != nil {
+			rejected = true
+		}
+	}
+	if rejected || !pool.makeBatchRoom(txs, local, errs) {
+		return rejectBatch(errs), nil
+	}
+	dirty := newAccountSet(pool.signer)
+	for i, tx := range txs {
+		if _, err := pool.addTx(tx, local, false); err != nil {
+			// Validated transactions are not expected to fail, undo the partial batch
+			log.Error("Failed to add validated batch transaction", "hash", tx.Hash(), "err", err)
+			for _, added := range txs[:i] {
+				pool.removeTx(added.Hash(), true)
+			}
+			errs[i] = err
+			return rejectBatch(errs), nil
+		}
+		dirty.addTx(tx)
+	}
+	for _, tx := range txs {
+		from, _ := types.Sender(pool.signer, tx) // already validated
+		pool.journalTx(from, tx)
+	}
+	validTxMeter.Mark(int64(len(dirty.accounts)))
+	return errs, dirty
+}
+
+// makeBatchRoom discards the cheapest remote transactions to make room for a batch in
+// a full pool, as add does for single transactions. Remote batches that are underpriced
+// or that would not fit even once all other remote transactions are gone are rejected
+// without discarding anything, with the errors of their transactions set.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *TxPool) makeBatchRoom(txs []*types.Transaction, local bool, errs []error) bool {
+	slots := 0
+	for _, tx := range txs {
+		slots += numSlots(tx)
+	}
+	excess := pool.all.Slots() + slots - int(pool.config.GlobalSlots+pool.config.GlobalQueue)
+	if excess <= 0 {
+		return true
+	}
+	if !local {
+		underpriced := false
+		for i, tx := range txs {
+			if pool.all.Count() > 0 && pool.priced.Underpriced(tx, pool.locals) {
+				underpricedTxMeter.Mark(1)
+				errs[i], underpriced = ErrUnderpriced, true
+			}
+		}
+		if underpriced {
+			return false
+		}
+	}
+	drop := pool.priced.Discard(excess, pool.locals)
+	if !local {
+		// Remote batch transactions beyond the room would be discarded by the batch itself
+		freed := 0
+		for _, tx := range drop {
+			freed += numSlots(tx)
+		}
+		if freed < excess {
+			for _, tx := range drop {
+				pool.priced.Put(tx)
+			}
+			for i := range errs {
+				errs[i] = ErrUnderpriced
+			}
+			underpricedTxMeter.Mark(int64(len(txs)))
+			return false
+		}
+	}
+	for _, tx := range drop {
+		log.Trace("Discarding freshly underpriced transaction", "hash", tx.Hash(), "price", tx.GasPrice())
+		underpricedTxMeter.Mark(1)
+		pool.removeTx(tx.Hash(), false)
+	}
+	return true
+}
+
+// validateBatchTx checks whether a transaction of an atomically added batch can be added
+// to the pool without replacing a pooled transaction or one earlier in the batch, whose
+// nonces are tracked in the provided set.
+func (pool *TxPool) validateBatchTx(tx *types.Transaction, local bool, nonces map[common.Address]map[uint64]struct{}) error {
+	if pool.all.Get(tx.Hash()) != nil {
+		knownTxMeter.Mark(1)
+		return fmt.Errorf("known transaction: %x", tx.Hash())
+	}
+	if err := pool.validateTx(tx, local); err != nil {
+		invalidTxMeter.Mark(1)
+		return err
+	}
+	from, _ := types.Sender(pool.signer, tx) // already validated
+	if list := pool.pending[from]; list != nil && list.Overlaps(tx) {
+		return ErrNonceOverlap
+	}
+	if list := pool.queue[from]; list != nil && list.Overlaps(tx) {
+		return ErrNonceOverlap
+	}
+	if nonces[from] == nil {
+		nonces[from] = make(map[uint64]struct{})
+	}
+	if _, ok := nonces[from][tx.Nonce()]; ok {
+		return ErrNonceOverlap
+	}
+	nonces[from][tx.Nonce()] = struct{}{}
+	return nil
+}
+
+// rejectBatch fails the transactions of a rejected batch that have no error of their own.
+func rejectBatch(errs []error) []error {
+	for i, err := range errs {
+		if err == nil {
+			errs[i] = ErrBatchRejected
+		}
+	}
+	return errs
+}
+
 // AddLocal enqueues a single local transaction into the pool if it is valid. This is
 // a convenience wrapper aroundd AddLocals.
 func (pool *TxPool) AddLocal(tx *types.Transaction) error {
@@ -27,12 +27,14 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 // testTxPoolConfig is a transaction pool configuration without stateful disk
@@ -464,6 +466,120 @@ func TestTransactionNonceRecovery(t *testing.T) {
 	}
 }
 
+// Tests that atomically added batches are either pooled and promoted as a whole or
+// rejected without any of their transactions being pooled.
+func TestTransactionAddLocalsAtomic(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPool()
+	defer pool.Stop()
+
+	account := crypto.PubkeyToAddress(key.PublicKey)
+	pool.currentState.AddBalance(account, big.NewInt(1000000000))
+
+	// A batch with an invalid transaction is rejected as a whole
+	batch := []*types.Transaction{transaction(0, 100000, key), transaction(1, 100, key), transaction(2, 100000, key)}
+	errs := pool.AddLocalsAtomic(batch)
+	if errs[0] != ErrBatchRejected || errs[1] != ErrIntrinsicGas || errs[2] != ErrBatchRejected {
+		t.Fatalf("unexpected errors for invalid batch: %v", errs)
+	}
+	if pending, queued := pool.Stats(); pending != 0 || queued != 0 {
+		t.Fatalf("rejected batch pooled: %d pending, %d queued", pending, queued)
+	}
+	// Duplicate nonces within a batch are rejected
+	errs = pool.AddLocalsAtomic([]*types.Transaction{transaction(0, 100000, key), pricedTransaction(0, 100000, big.NewInt(2), key)})
+	if errs[0] != ErrBatchRejected || errs[1] != ErrNonceOverlap {
+		t.Fatalf("unexpected errors for overlapping batch: %v", errs)
+	}
+	// A valid batch is pooled and promoted as a whole
+	batch = []*types.Transaction{transaction(0, 100000, key), transaction(1, 100000, key), transaction(2, 100000, key)}
+	for i, err := range pool.AddLocalsAtomic(batch) {
+		if err != nil {
+			t.Fatalf("transaction %d: unexpected error: %v", i, err)
+		}
+	}
+	if pending, queued := pool.Stats(); pending != 3 || queued != 0 {
+		t.Fatalf("pool mismatch: have %d pending, %d queued, want 3 pending", pending, queued)
+	}
+	// Transactions of a batch may not replace pooled ones
+	errs = pool.AddLocalsAtomic([]*types.Transaction{transaction(3, 100000, key), pricedTransaction(2, 100000, big.NewInt(2), key)})
+	if errs[0] != ErrBatchRejected || errs[1] != ErrNonceOverlap {
+		t.Fatalf("unexpected errors for replacing batch: %v", errs)
+	}
+	if pending, _ := pool.Stats(); pending != 3 {
+		t.Fatalf("pending transactions mismatch: have %d, want 3", pending)
+	}
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
+// Tests that atomically added batches make room for themselves in a full pool only
+// once they are accepted, so that rejected batches discard no pooled transactions.
+func TestTransactionAddLocalsAtomicFullPool(t *testing.T) {
+	t.Parallel()
+
+	// Create a full pool of remote transactions, batches being remote as well
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()))
+	blockchain := &testBlockChain{statedb, 1000000, new(event.Feed)}
+
+	config := testTxPoolConfig
+	config.GlobalSlots = 2
+	config.GlobalQueue = 2
+	config.NoLocals = true
+
+	pool := NewTxPool(config, params.TestChainConfig, blockchain)
+	defer pool.Stop()
+
+	keys := make([]*ecdsa.PrivateKey, 5)
+	for i := 0; i < len(keys); i++ {
+		keys[i], _ = crypto.GenerateKey()
+		pool.currentState.AddBalance(crypto.PubkeyToAddress(keys[i].PublicKey), big.NewInt(1000000))
+	}
+	pooled := types.Transactions{
+		pricedTransaction(0, 100000, big.NewInt(2), keys[0]),
+		pricedTransaction(0, 100000, big.NewInt(2), keys[1]),
+		pricedTransaction(0, 100000, big.NewInt(2), keys[2]),
+		pricedTransaction(1, 100000, big.NewInt(2), keys[3]),
+	}
+	for i, err := range pool.AddRemotesSync(pooled) {
+		if err != nil {
+			t.Fatalf("transaction %d: failed to pool: %v", i, err)
+		}
+	}
+	// A batch with an underpriced transaction is rejected without discarding any
+	errs := pool.AddLocalsAtomic([]*types.Transaction{pricedTransaction(0, 100000, big.NewInt(3), keys[4]), pricedTransaction(1, 100000, big.NewInt(1), keys[4])})
+	if errs[0] != ErrBatchRejected || errs[1] != ErrUnderpriced {
+		t.Fatalf("unexpected errors for underpriced batch: %v", errs)
+	}
+	for i, tx := range pooled {
+		if pool.Get(tx.Hash()) == nil {
+			t.Fatalf("transaction %d: discarded by rejected batch", i)
+		}
+	}
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+	// A well priced batch discards the cheapest transactions to make room for itself
+	batch := []*types.Transaction{pricedTransaction(0, 100000, big.NewInt(3), keys[4]), pricedTransaction(1, 100000, big.NewInt(3), keys[4])}
+	for i, err := range pool.AddLocalsAtomic(batch) {
+		if err != nil {
+			t.Fatalf("transaction %d: unexpected error: %v", i, err)
+		}
+	}
+	for i, tx := range batch {
+		if pool.Get(tx.Hash()) == nil {
+			t.Fatalf("batch transaction %d: not pooled", i)
+		}
+	}
+	if pending, queued := pool.Stats(); pending+queued != 4 {
+		t.Fatalf("pool size mismatch: have %d pending, %d queued, want 4 in total", pending, queued)
+	}
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
 // Tests that if an account runs out of funds, any pending and queued transactions
 // are dropped.
 func TestTransactionDropping(t *testing.T) {
@@ -1762,6 +1878,130 @@ func testTransactionJournaling(t *testing.T, nolocals bool) {
 	pool.Stop()
 }
 
+// Tests that the metadata and rollup submission details of journaled transactions
+// survive restarts, and that journals holding bare transactions, or transactions
+// without their submission details, can still be loaded.
+func TestTransactionJournalingMeta(t *testing.T) {
+	t.Parallel()
+
+	file, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("failed to create temporary journal: %v", err)
+	}
+	journal := file.Name()
+	defer os.Remove(journal)
+
+	key, _ := crypto.GenerateKey()
+	sender, l1TxId := common.HexToAddress("0x1234"), hexutil.Uint64(7)
+	legacy, _ := types.SignTx(types.NewTransaction(0, common.Address{}, big.NewInt(100), 100000, big.NewInt(1), nil, nil, nil, types.QueueOriginSequencer, types.SighashEIP155), types.HomesteadSigner{}, key)
+	ingested, _ := types.SignTx(types.NewTransaction(1, common.Address{}, big.NewInt(100), 100000, big.NewInt(1), nil, &sender, &l1TxId, types.QueueOriginL1ToL2, types.SighashEIP155), types.HomesteadSigner{}, key)
+	if err := rlp.Encode(file, legacy); err != nil {
+		t.Fatalf("failed to write legacy journal entry: %v", err)
+	}
+	entry := newJournalEntry(ingested)
+	if err := rlp.Encode(file, []interface{}{entry.Tx, entry.Meta}); err != nil {
+		t.Fatalf("failed to write journal entry without submission details: %v", err)
+	}
+	l1Context := types.L1Context{BlockNumber: 10, Timestamp: 100}
+	submission := make([]*types.Transaction, 2)
+	for i := range submission {
+		submission[i] = pricedTransaction(uint64(2+i), 100000, big.NewInt(1), key)
+		submission[i].SetL1Context(l1Context)
+	}
+	submission[0].SetSubmissionSize(len(submission))
+	for _, tx := range submission {
+		if err := rlp.Encode(file, newJournalEntry(tx)); err != nil {
+			t.Fatalf("failed to write submission journal entry: %v", err)
+		}
+	}
+	file.Close()
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()))
+	statedb.AddBalance(crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1000000000))
+	blockchain := &testBlockChain{statedb, 1000000, new(event.Feed)}
+
+	config := testTxPoolConfig
+	config.Journal = journal
+
+	// Check the transactions as loaded, and once more from the rotated journal
+	for i := 0; i < 2; i++ {
+		pool := NewTxPool(config, params.TestChainConfig, blockchain)
+
+		if pending, _ := pool.Stats(); pending != 4 {
+			t.Fatalf("restart %d: pending transactions mismatched: have %d, want %d", i, pending, 4)
+		}
+		tx := pool.Get(ingested.Hash())
+		if tx == nil {
+			t.Fatalf("restart %d: journaled transaction missing", i)
+		}
+		if origin := tx.QueueOrigin(); origin == nil || origin.Int64() != int64(types.QueueOriginL1ToL2) {
+			t.Fatalf("restart %d: queue origin mismatch: have %v, want %d", i, origin, types.QueueOriginL1ToL2)
+		}
+		if *tx.L1MessageSender() != sender || *tx.L1RollupTxId() != l1TxId {
+			t.Fatalf("restart %d: L1 metadata mismatch: have %s and %d, want %s and %d", i, tx.L1MessageSender().Hex(), *tx.L1RollupTxId(), sender.Hex(), l1TxId)
+		}
+		if tx.L1Context() != nil || tx.SubmissionSize() != 0 {
+			t.Fatalf("restart %d: submission details restored for a transaction without any", i)
+		}
+		for j, want := range []int{len(submission), 0} {
+			tx := pool.Get(submission[j].Hash())
+			if tx == nil {
+				t.Fatalf("restart %d: submission transaction %d missing", i, j)
+			}
+			if have := tx.L1Context(); have == nil || *have != l1Context {
+				t.Fatalf("restart %d: submission transaction %d: l1 context mismatch: have %v, want %+v", i, j, have, l1Context)
+			}
+			if tx.SubmissionSize() != want {
+				t.Fatalf("restart %d: submission transaction %d: size mismatch: have %d, want %d", i, j, tx.SubmissionSize(), want)
+			}
+		}
+		pool.Stop()
+	}
+}
+
+// Tests that the transactions of a journaled rollup submission are restored together,
+// all of them or none.
+func TestTransactionJournalingSubmission(t *testing.T) {
+	t.Parallel()
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()))
+	blockchain := &testBlockChain{statedb, 1000000, new(event.Feed)}
+
+	pool := NewTxPool(testTxPoolConfig, params.TestChainConfig, blockchain)
+	defer pool.Stop()
+
+	key, _ := crypto.GenerateKey()
+	pool.currentState.AddBalance(crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1000000))
+
+	// The last transaction of the submission is no longer valid, so none is restored
+	txs := []*types.Transaction{
+		pricedTransaction(0, 100000, big.NewInt(1), key),
+		pricedTransaction(1, 100000, big.NewInt(1), key),
+		pricedTransaction(2, 100000, big.NewInt(1), key),
+		pricedTransaction(3, 1000000000, big.NewInt(1), key),
+	}
+	for _, tx := range txs[1:] {
+		tx.SetL1Context(types.L1Context{BlockNumber: 1, Timestamp: 1})
+	}
+	txs[1].SetSubmissionSize(3)
+
+	errs := pool.addJournaled(txs)
+	if len(errs) != len(txs) {
+		t.Fatalf("error count mismatch: have %d, want %d", len(errs), len(txs))
+	}
+	if errs[0] != nil {
+		t.Fatalf("transaction outside the submission rejected: %v", errs[0])
+	}
+	for i, err := range errs[1:] {
+		if err == nil {
+			t.Errorf("submission transaction %d restored", i)
+		}
+	}
+	if pending, queued := pool.Stats(); pending != 1 || queued != 0 {
+		t.Fatalf("pool mismatch: have %d pending and %d queued, want 1 and 0", pending, queued)
+	}
+}
+
 // TestTransactionStatusCheck tests that the pool can correctly retrieve the
 // pending status of individual transactions.
 func TestTransactionStatusCheck(t *testing.T) {
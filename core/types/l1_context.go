@@ -0,0 +1,71 @@
+/**
+ * Optimism 2020 Copyright
+ */
+
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+)
+
+// L1ContextPrefix marks header extra-data that carries an L1 context.
+var L1ContextPrefix = []byte{'L', '1', 'C', 0x01}
+
+// L1ContextLength is the number of extra-data bytes taken by an encoded L1 context.
+const L1ContextLength = 4 + 8 + 8
+
+var (
+	errL1ContextShort  = errors.New("extra-data too short for l1 context")
+	errL1ContextPrefix = errors.New("extra-data has no l1 context prefix")
+)
+
+// L1Context is the L1 block an L2 block was derived from. It is stored at
+// the start of the header extra-data of every OVM block.
+type L1Context struct {
+	BlockNumber uint64
+	Timestamp   uint64
+}
+
+// After reports whether the context is later than the other one. Contexts are
+// ordered by L1 block number first, and by timestamp within an L1 block number.
+func (c L1Context) After(other L1Context) bool {
+	if c.BlockNumber != other.BlockNumber {
+		return c.BlockNumber > other.BlockNumber
+	}
+	return c.Timestamp > other.Timestamp
+}
+
+// Follows reports whether the context may succeed the previous one. L1 time never
+// goes back, so neither the block number nor the timestamp may be lower than those
+// of the previous context. A context following another one is never before it.
+func (c L1Context) Follows(prev L1Context) bool {
+	return c.BlockNumber >= prev.BlockNumber && c.Timestamp >= prev.Timestamp
+}
+
+// Extra returns the header extra-data encoding of the context:
+//
+//	prefix || uint64be(BlockNumber) || uint64be(Timestamp)
+func (c L1Context) Extra() []byte {
+	extra := make([]byte, L1ContextLength)
+	copy(extra, L1ContextPrefix)
+	binary.BigEndian.PutUint64(extra[4:12], c.BlockNumber)
+	binary.BigEndian.PutUint64(extra[12:20], c.Timestamp)
+	return extra
+}
+
+// DecodeL1Context reads the L1 context from the start of header extra-data.
+// Any bytes following the context are ignored.
+func DecodeL1Context(extra []byte) (L1Context, error) {
+	if len(extra) < L1ContextLength {
+		return L1Context{}, errL1ContextShort
+	}
+	if !bytes.Equal(extra[:4], L1ContextPrefix) {
+		return L1Context{}, errL1ContextPrefix
+	}
+	return L1Context{
+		BlockNumber: binary.BigEndian.Uint64(extra[4:12]),
+		Timestamp:   binary.BigEndian.Uint64(extra[12:20]),
+	}, nil
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestL1ContextExtraRoundTrip(t *testing.T) {
+	ctx := L1Context{BlockNumber: 10934832, Timestamp: 1601475125}
+
+	extra := ctx.Extra()
+	if len(extra) != L1ContextLength {
+		t.Fatalf("extra length mismatch: have %d, want %d", len(extra), L1ContextLength)
+	}
+	if !bytes.HasPrefix(extra, L1ContextPrefix) {
+		t.Fatalf("extra missing prefix: %x", extra)
+	}
+	// Trailing vanity bytes must not affect decoding
+	decoded, err := DecodeL1Context(append(extra, []byte("vanity")...))
+	if err != nil {
+		t.Fatalf("failed to decode l1 context: %v", err)
+	}
+	if decoded != ctx {
+		t.Fatalf("l1 context mismatch: have %+v, want %+v", decoded, ctx)
+	}
+}
+
+func TestDecodeL1ContextInvalid(t *testing.T) {
+	tests := []struct {
+		extra []byte
+		err   error
+	}{
+		{nil, errL1ContextShort},
+		{L1ContextPrefix, errL1ContextShort},
+		{make([]byte, L1ContextLength), errL1ContextPrefix},
+		{append([]byte("geth"), make([]byte, 28)...), errL1ContextPrefix},
+	}
+	for i, tt := range tests {
+		if _, err := DecodeL1Context(tt.extra); err != tt.err {
+			t.Errorf("test %d: error mismatch: have %v, want %v", i, err, tt.err)
+		}
+	}
+}
+
+func TestL1ContextOrder(t *testing.T) {
+	prev := L1Context{BlockNumber: 10, Timestamp: 100}
+	tests := []struct {
+		next           L1Context
+		after, follows bool
+	}{
+		{L1Context{BlockNumber: 10, Timestamp: 100}, false, true},
+		{L1Context{BlockNumber: 10, Timestamp: 101}, true, true},
+		{L1Context{BlockNumber: 11, Timestamp: 100}, true, true},
+		{L1Context{BlockNumber: 11, Timestamp: 99}, true, false},
+		{L1Context{BlockNumber: 10, Timestamp: 99}, false, false},
+		{L1Context{BlockNumber: 9, Timestamp: 101}, false, false},
+	}
+	for i, tt := range tests {
+		if after := tt.next.After(prev); after != tt.after {
+			t.Errorf("test %d: after mismatch: have %t, want %t", i, after, tt.after)
+		}
+		if tt.after && prev.After(tt.next) {
+			t.Errorf("test %d: contexts ordered both ways", i)
+		}
+		if follows := tt.next.Follows(prev); follows != tt.follows {
+			t.Errorf("test %d: follows mismatch: have %t, want %t", i, follows, tt.follows)
+		}
+	}
+}
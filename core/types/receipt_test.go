@@ -38,6 +38,10 @@ func TestLegacyReceiptDecoding(t *testing.T) {
 			"StoredReceiptRLP",
 			encodeAsStoredReceiptRLP,
 		},
+		{
+			"V5StoredReceiptRLP",
+			encodeAsV5StoredReceiptRLP,
+		},
 		{
 			"V4StoredReceiptRLP",
 			encodeAsV4StoredReceiptRLP,
@@ -113,6 +117,7 @@ func encodeAsStoredReceiptRLP(want *Receipt) ([]byte, error) {
 		PostStateOrStatus: want.statusEncoding(),
 		CumulativeGasUsed: want.CumulativeGasUsed,
 		Logs:              make([]*LogForStorage, len(want.Logs)),
+		RevertReason:      want.RevertReason,
 	}
 	for i, log := range want.Logs {
 		stored.Logs[i] = (*LogForStorage)(log)
@@ -120,6 +125,39 @@ func encodeAsStoredReceiptRLP(want *Receipt) ([]byte, error) {
 	return rlp.EncodeToBytes(stored)
 }
 
+func encodeAsV5StoredReceiptRLP(want *Receipt) ([]byte, error) {
+	stored := &v5StoredReceiptRLP{
+		PostStateOrStatus: want.statusEncoding(),
+		CumulativeGasUsed: want.CumulativeGasUsed,
+		Logs:              make([]*LogForStorage, len(want.Logs)),
+	}
+	for i, log := range want.Logs {
+		stored.Logs[i] = (*LogForStorage)(log)
+	}
+	return rlp.EncodeToBytes(stored)
+}
+
+// Tests that the revert reason of a failed receipt survives the storage encoding.
+func TestRevertReasonStorage(t *testing.T) {
+	receipt := &Receipt{
+		Status:            ReceiptStatusFailed,
+		CumulativeGasUsed: 1,
+		Logs:              []*Log{},
+		RevertReason:      common.FromHex("0x08c379a0deadbeef"),
+	}
+	enc, err := rlp.EncodeToBytes((*ReceiptForStorage)(receipt))
+	if err != nil {
+		t.Fatalf("Error encoding receipt: %v", err)
+	}
+	var dec ReceiptForStorage
+	if err := rlp.DecodeBytes(enc, &dec); err != nil {
+		t.Fatalf("Error decoding RLP receipt: %v", err)
+	}
+	if !bytes.Equal(dec.RevertReason, receipt.RevertReason) {
+		t.Fatalf("Receipt revert reason mismatch, want %x, have %x", receipt.RevertReason, dec.RevertReason)
+	}
+}
+
 func encodeAsV4StoredReceiptRLP(want *Receipt) ([]byte, error) {
 	stored := &v4StoredReceiptRLP{
 		PostStateOrStatus: want.statusEncoding(),
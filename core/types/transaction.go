@@ -46,6 +46,12 @@ const (
 type Transaction struct {
 	data txdata
 	meta TransactionMeta
+	// l1Context is the L1 block of the submission the transaction came in, if
+	// known. It is neither encoded nor stored.
+	l1Context *L1Context
+	// submissionSize is the number of transactions of the submission opened by the
+	// transaction, which are mined together. It is neither encoded nor stored.
+	submissionSize int
 	// caches
 	hash atomic.Value
 	size atomic.Value
@@ -136,6 +142,34 @@ func (t *Transaction) GetMeta() *TransactionMeta {
 	return &t.meta
 }
 
+// L1Context returns the L1 context of the submission the transaction came in. It
+// returns nil if it is unknown, e.g. for transactions received from peers.
+func (tx *Transaction) L1Context() *L1Context {
+	if tx.l1Context == nil {
+		return nil
+	}
+	l1Context := *tx.l1Context
+	return &l1Context
+}
+
+// SetL1Context ties the transaction to the L1 context of the submission it came in.
+func (tx *Transaction) SetL1Context(l1Context L1Context) {
+	tx.l1Context = &l1Context
+}
+
+// SubmissionSize returns the number of transactions of the submission opened by the
+// transaction: the transaction and the following ones of its sender, which are mined
+// in one block or not at all. It returns 0 if the transaction opens no submission.
+func (tx *Transaction) SubmissionSize() int {
+	return tx.submissionSize
+}
+
+// SetSubmissionSize marks the transaction as opening a submission of the provided
+// number of transactions.
+func (tx *Transaction) SetSubmissionSize(size int) {
+	tx.submissionSize = size
+}
+
 // Appends the provided 64-bit nonce to this Transaction's calldata as the last 4 bytes
 func (t *Transaction) AddNonceToWrappedTransaction(nonce uint64) {
 	bytes := make([]byte, 8)
@@ -334,7 +368,7 @@ func (tx *Transaction) WithSignature(signer Signer, sig []byte) (*Transaction, e
 	if err != nil {
 		return nil, err
 	}
-	cpy := &Transaction{data: tx.data, meta: tx.meta}
+	cpy := &Transaction{data: tx.data, meta: tx.meta, l1Context: tx.l1Context, submissionSize: tx.submissionSize}
 	cpy.data.R, cpy.data.S, cpy.data.V = r, s, v
 	return cpy, nil
 }
@@ -472,6 +506,32 @@ func (t *TransactionsByPriceAndNonce) Shift() {
 	}
 }
 
+// PeekN returns the next transaction by price followed by the next ones from the
+// same account, n transactions in total or fewer if the account has no more.
+func (t *TransactionsByPriceAndNonce) PeekN(n int) Transactions {
+	if len(t.heads) == 0 || n <= 0 {
+		return nil
+	}
+	acc, _ := Sender(t.signer, t.heads[0])
+	txs := t.txs[acc]
+	if len(txs) > n-1 {
+		txs = txs[:n-1]
+	}
+	return append(Transactions{t.heads[0]}, txs...)
+}
+
+// ShiftN replaces the current best head with the n-th next one from the same
+// account, skipping the transactions in between.
+func (t *TransactionsByPriceAndNonce) ShiftN(n int) {
+	acc, _ := Sender(t.signer, t.heads[0])
+	if txs, ok := t.txs[acc]; ok && len(txs) >= n {
+		t.heads[0], t.txs[acc] = txs[n-1], txs[n:]
+		heap.Fix(&t.heads, 0)
+	} else {
+		heap.Pop(&t.heads)
+	}
+}
+
 // Pop removes the best transaction, *not* replacing it with the next one from
 // the same account. This should be used when a transaction cannot be executed
 // and hence all subsequent ones should be discarded from the same account.
@@ -22,6 +22,20 @@ const (
 	QueueOriginSequencer QueueOrigin = 2
 )
 
+// String returns the name of the queue, as shown in RPC responses.
+func (q QueueOrigin) String() string {
+	switch q {
+	case QueueOriginL1ToL2:
+		return "l1"
+	case QueueOriginSafety:
+		return "safety"
+	case QueueOriginSequencer:
+		return "sequencer"
+	default:
+		return ""
+	}
+}
+
 //go:generate gencodec -type TransactionMeta -out gen_tx_meta_json.go
 
 type TransactionMeta struct {
@@ -31,12 +45,9 @@ type TransactionMeta struct {
 	QueueOrigin       *big.Int          `json:"queueOrigin" gencodec:"required"`
 }
 
-// Hard code the queue origin as 2 since it represents the origin as the
-// sequencer. Add the queue origin to the function signature once l1 transaction
-// ingestion is ready.
-func NewTransactionMeta(L1RollupTxId *hexutil.Uint64, L1MessageSender *common.Address, sighashType SignatureHashType) *TransactionMeta {
-	queueOrigin := new(big.Int).SetUint64(2)
-	return &TransactionMeta{L1RollupTxId: L1RollupTxId, L1MessageSender: L1MessageSender, SignatureHashType: sighashType, QueueOrigin: queueOrigin}
+// NewTransactionMeta creates the TransactionMeta of a transaction from the provided queue.
+func NewTransactionMeta(L1RollupTxId *hexutil.Uint64, L1MessageSender *common.Address, queueOrigin QueueOrigin, sighashType SignatureHashType) *TransactionMeta {
+	return &TransactionMeta{L1RollupTxId: L1RollupTxId, L1MessageSender: L1MessageSender, SignatureHashType: sighashType, QueueOrigin: big.NewInt(int64(queueOrigin))}
 }
 
 // TxMetaDecode deserializes bytes as a TransactionMeta struct.
@@ -74,7 +74,7 @@ var (
 
 func TestTransactionMetaEncode(t *testing.T) {
 	for _, test := range txMetaSerializationTests {
-		txmeta := NewTransactionMeta(test.txid, test.msgSender, test.sighashType)
+		txmeta := NewTransactionMeta(test.txid, test.msgSender, QueueOriginSequencer, test.sighashType)
 		txmeta.QueueOrigin = test.queueOrigin
 
 		encoded := TxMetaEncode(txmeta)
@@ -92,7 +92,7 @@ func TestTransactionMetaEncode(t *testing.T) {
 
 func TestTransactionSighashEncode(t *testing.T) {
 	for _, test := range txMetaSighashEncodeTests {
-		txmeta := NewTransactionMeta(&txid, &addr, test.input)
+		txmeta := NewTransactionMeta(&txid, &addr, QueueOriginSequencer, test.input)
 		encoded := TxMetaEncode(txmeta)
 		decoded, err := TxMetaDecode(encoded)
 
@@ -106,6 +106,18 @@ func TestTransactionSighashEncode(t *testing.T) {
 	}
 }
 
+func TestTransactionMetaQueueOrigin(t *testing.T) {
+	for _, origin := range []QueueOrigin{QueueOriginL1ToL2, QueueOriginSafety, QueueOriginSequencer} {
+		decoded, err := TxMetaDecode(TxMetaEncode(NewTransactionMeta(&txid, &addr, origin, SighashEIP155)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if decoded.QueueOrigin == nil || decoded.QueueOrigin.Int64() != int64(origin) {
+			t.Fatalf("expected queue origin %d, got %v", origin, decoded.QueueOrigin)
+		}
+	}
+}
+
 func isTxMetaEqual(meta1 *TransactionMeta, meta2 *TransactionMeta) bool {
 	if meta1.L1MessageSender == nil || meta2.L1MessageSender == nil {
 		if meta1.L1MessageSender != meta2.L1MessageSender {
@@ -20,7 +20,6 @@ import (
 	"encoding/hex"
 	"errors"
 	"math/big"
-	"strconv"
 	"sync/atomic"
 	"time"
 
@@ -48,8 +47,8 @@ type (
 // run runs the given contract and takes care of running precompiles with a fallback to the byte code interpreter.
 func run(evm *EVM, contract *Contract, input []byte, readOnly bool) ([]byte, error) {
 	// Intercept the StateManager calls
-	if contract.Address() == StateManagerAddress {
-		log.Debug("Calling State Manager contract.", "StateManagerAddress", StateManagerAddress.Hex())
+	if evm.chainRules.IsOVM && contract.Address() == evm.ovmContracts.StateManager {
+		log.Debug("Calling State Manager contract.", "StateManagerAddress", evm.ovmContracts.StateManager)
 		ret, err := callStateManager(input, evm, contract)
 		if err != nil {
 			log.Error("State manager error!", "error", err)
@@ -106,6 +105,11 @@ type Context struct {
 	BlockNumber *big.Int       // Provides information for NUMBER
 	Time        *big.Int       // Provides information for TIME
 	Difficulty  *big.Int       // Provides information for DIFFICULTY
+
+	// L1BlockNumber is the number of the L1 block an OVM block was derived
+	// from. It provides information for NUMBER in the OVM, and is passed to
+	// Execution Managers taking it as _blockNumber. It may be nil.
+	L1BlockNumber *big.Int
 }
 
 // EVM is the Ethereum Virtual Machine base object and provides
@@ -129,6 +133,8 @@ type EVM struct {
 	chainConfig *params.ChainConfig
 	// chain rules contains the chain rules for the current epoch
 	chainRules params.Rules
+	// ovmContracts are the OVM system contracts, nil on plain EVM chains
+	ovmContracts *params.OVMContracts
 	// virtual machine configuration options used to initialise the
 	// evm.
 	vmConfig Config
@@ -156,6 +162,9 @@ func NewEVM(ctx Context, statedb StateDB, chainConfig *params.ChainConfig, vmCon
 		chainRules:   chainConfig.Rules(ctx.BlockNumber),
 		interpreters: make([]Interpreter, 0, 1),
 	}
+	if chainConfig.IsOVM() {
+		evm.ovmContracts = chainConfig.OVM.SystemContractsAt(ctx.BlockNumber)
+	}
 
 	if chainConfig.IsEWASM(ctx.BlockNumber) {
 		// to be implemented by EVM-C and Wagon PRs.
@@ -202,7 +211,7 @@ func (evm *EVM) Interpreter() Interpreter {
 // the necessary steps to create accounts and reverses the state in case of an
 // execution error or failed value transfer.
 func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas uint64, value *big.Int) (ret []byte, leftOverGas uint64, err error) {
-	log.Debug("~~~ New Call ~~~", "Contract caller", caller.Address().Hex(), "Contract target address", addr.Hex(), "Calldata", hexutil.Encode(input))
+	log.Debug("~~~ New Call ~~~", "Contract caller", caller.Address(), "Contract target address", addr, "Calldata", hexutil.Bytes(input))
 	if evm.vmConfig.NoRecursion && evm.depth > 0 {
 		return nil, gas, nil
 	}
@@ -348,7 +357,7 @@ func (evm *EVM) DelegateCall(caller ContractRef, addr common.Address, input []by
 // Opcodes that attempt to perform such modifications will result in exceptions
 // instead of performing the modifications.
 func (evm *EVM) StaticCall(caller ContractRef, addr common.Address, input []byte, gas uint64) (ret []byte, leftOverGas uint64, err error) {
-	log.Debug("~~~ New StaticCall ~~~", "Contract caller", caller.Address().Hex(), "Contract target address", addr.Hex())
+	log.Debug("~~~ New StaticCall ~~~", "Contract caller", caller.Address(), "Contract target address", addr)
 	if evm.vmConfig.NoRecursion && evm.depth > 0 {
 		return nil, gas, nil
 	}
@@ -407,6 +416,11 @@ func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gas uint64,
 	if !evm.CanTransfer(evm.StateDB, caller.Address(), value) {
 		return nil, common.Address{}, gas, ErrInsufficientBalance
 	}
+	// Nonces of OVM contracts are managed by the Execution Manager
+	if !evm.chainRules.IsOVM {
+		nonce := evm.StateDB.GetNonce(caller.Address())
+		evm.StateDB.SetNonce(caller.Address(), nonce+1)
+	}
 
 	// Ensure there's no existing contract already at the designated address
 	contractHash := evm.StateDB.GetCodeHash(address)
@@ -416,6 +430,9 @@ func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gas uint64,
 	// Create a new account on the state
 	snapshot := evm.StateDB.Snapshot()
 	evm.StateDB.CreateAccount(address)
+	if !evm.chainRules.IsOVM && evm.chainRules.IsEIP158 {
+		evm.StateDB.SetNonce(address, 1)
+	}
 	evm.Transfer(evm.StateDB, caller.Address(), address, value)
 
 	// Initialise a new contract and set the code that is to be used by the EVM.
@@ -471,13 +488,16 @@ func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gas uint64,
 
 // Create creates a new contract using code as deployment code.
 func (evm *EVM) Create(caller ContractRef, code []byte, gas uint64, value *big.Int) (ret []byte, contractAddr common.Address, leftOverGas uint64, err error) {
-	if caller.Address() != ExecutionManagerAddress {
+	if !evm.chainRules.IsOVM {
+		contractAddr = crypto.CreateAddress(caller.Address(), evm.StateDB.GetNonce(caller.Address()))
+		return evm.create(caller, &codeAndHash{code: code}, gas, value, contractAddr)
+	}
+	if caller.Address() != evm.ovmContracts.ExecutionManager {
 		log.Error("Creation called by non-Execution Manager contract! This should never happen.", "Offending address", caller.Address().Hex())
 		return nil, caller.Address(), 0, errors.New("creation called by non-Execution Manager contract")
 	}
-	// The contract address is stored at the Zero storage slot
-	contractAddrStorageSlot := common.HexToHash(strconv.FormatInt(ActiveContractStorageSlot, 16))
-	contractAddr = common.BytesToAddress(evm.StateDB.GetState(ExecutionManagerAddress, contractAddrStorageSlot).Bytes())
+	// The contract address is stored at the active contract storage slot
+	contractAddr = common.BytesToAddress(evm.StateDB.GetState(evm.ovmContracts.ExecutionManager, evm.ovmContracts.ActiveContractSlot).Bytes())
 	log.Debug("[EM] Creating contract.", "New contract address", contractAddr.Hex(), "Caller Addr", caller.Address().Hex(), "Caller nonce", evm.StateDB.GetNonce(caller.Address()))
 	return evm.create(caller, &codeAndHash{code: code}, gas, value, contractAddr)
 }
@@ -0,0 +1,27 @@
+package vm
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// executionManagerAbis caches the parsed Execution Manager ABIs by their JSON.
+var executionManagerAbis sync.Map
+
+// ExecutionManagerAbi returns the parsed ABI of the Execution Manager of the given
+// OVM system contracts.
+func ExecutionManagerAbi(contracts *params.OVMContracts) (abi.ABI, error) {
+	if parsed, ok := executionManagerAbis.Load(contracts.ExecutionManagerAbi); ok {
+		return parsed.(abi.ABI), nil
+	}
+	parsed, err := abi.JSON(strings.NewReader(contracts.ExecutionManagerAbi))
+	if err != nil {
+		return abi.ABI{}, fmt.Errorf("invalid Execution Manager ABI: %v", err)
+	}
+	executionManagerAbis.Store(contracts.ExecutionManagerAbi, parsed)
+	return parsed, nil
+}
@@ -582,7 +582,12 @@ func opTimestamp(pc *uint64, interpreter *EVMInterpreter, contract *Contract, me
 }
 
 func opNumber(pc *uint64, interpreter *EVMInterpreter, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
-	stack.push(math.U256(interpreter.intPool.get().Set(interpreter.evm.BlockNumber)))
+	number := interpreter.evm.BlockNumber
+	// OVM contracts see the number of the L1 block the OVM block was derived from.
+	if interpreter.evm.L1BlockNumber != nil && interpreter.evm.chainConfig.IsOVM() {
+		number = interpreter.evm.L1BlockNumber
+	}
+	stack.push(math.U256(interpreter.intPool.get().Set(number)))
 	return nil, nil
 }
 
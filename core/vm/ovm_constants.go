@@ -1,199 +1,106 @@
 package vm
 
-import (
-	"github.com/ethereum/go-ethereum/common"
-)
-
-var (
-	ExecutionManagerAddress = common.HexToAddress("00000000000000000000000000000000dead0000")
-	StateManagerAddress     = common.HexToAddress("00000000000000000000000000000000dead0001")
-	WORD_SIZE               = 32
-)
-
-const ActiveContractStorageSlot = int64(6)
-
-const RawExecutionManagerAbi = `[
+const RawStateManagerAbi = `[
   {
+    "constant": false,
     "inputs": [
-      {
-        "internalType": "uint256",
-        "name": "_opcodeWhitelistMask",
-        "type": "uint256"
-      },
       {
         "internalType": "address",
-        "name": "_owner",
+        "name": "_ovmContractAddress",
         "type": "address"
       },
       {
-        "internalType": "uint256",
-        "name": "_blockGasLimit",
-        "type": "uint256"
-      },
-      {
-        "internalType": "bool",
-        "name": "_overridePurityChecker",
-        "type": "bool"
+        "internalType": "address",
+        "name": "_codeContractAddress",
+        "type": "address"
       }
     ],
+    "name": "associateCodeContract",
+    "outputs": [],
     "payable": false,
     "stateMutability": "nonpayable",
-    "type": "constructor"
+    "type": "function"
   },
   {
-    "anonymous": false,
+    "constant": true,
     "inputs": [
       {
-        "indexed": false,
         "internalType": "address",
-        "name": "_activeContract",
+        "name": "_ovmContractAddress",
         "type": "address"
       }
     ],
-    "name": "ActiveContract",
-    "type": "event"
-  },
-  {
-    "anonymous": false,
-    "inputs": [
-      {
-        "indexed": false,
-        "internalType": "address",
-        "name": "_ovmFromAddress",
-        "type": "address"
-      },
+    "name": "getCodeContractAddressFromOvmAddress",
+    "outputs": [
       {
-        "indexed": false,
         "internalType": "address",
-        "name": "_ovmToAddress",
+        "name": "",
         "type": "address"
       }
     ],
-    "name": "CallingWithEOA",
-    "type": "event"
+    "payable": false,
+    "stateMutability": "view",
+    "type": "function"
   },
   {
-    "anonymous": false,
+    "constant": true,
     "inputs": [
       {
-        "indexed": false,
-        "internalType": "address",
-        "name": "_ovmContractAddress",
-        "type": "address"
-      },
-      {
-        "indexed": false,
         "internalType": "address",
         "name": "_codeContractAddress",
         "type": "address"
-      },
-      {
-        "indexed": false,
-        "internalType": "bytes32",
-        "name": "_codeContractHash",
-        "type": "bytes32"
       }
     ],
-    "name": "CreatedContract",
-    "type": "event"
-  },
-  {
-    "anonymous": false,
-    "inputs": [
+    "name": "getCodeContractBytecode",
+    "outputs": [
       {
-        "indexed": false,
         "internalType": "bytes",
-        "name": "_revertMessage",
+        "name": "codeContractBytecode",
         "type": "bytes"
       }
     ],
-    "name": "EOACallRevert",
-    "type": "event"
+    "payable": false,
+    "stateMutability": "view",
+    "type": "function"
   },
   {
-    "anonymous": false,
+    "constant": true,
     "inputs": [
       {
-        "indexed": false,
         "internalType": "address",
-        "name": "_ovmContractAddress",
+        "name": "_codeContractAddress",
         "type": "address"
       }
     ],
-    "name": "EOACreatedContract",
-    "type": "event"
-  },
-  {
-    "anonymous": false,
-    "inputs": [
-      {
-        "indexed": false,
-        "internalType": "address",
-        "name": "_ovmContractAddress",
-        "type": "address"
-      },
-      {
-        "indexed": false,
-        "internalType": "bytes32",
-        "name": "_slot",
-        "type": "bytes32"
-      },
+    "name": "getCodeContractHash",
+    "outputs": [
       {
-        "indexed": false,
         "internalType": "bytes32",
-        "name": "_value",
+        "name": "_codeContractHash",
         "type": "bytes32"
       }
     ],
-    "name": "SetStorage",
-    "type": "event"
+    "payable": false,
+    "stateMutability": "view",
+    "type": "function"
   },
   {
     "constant": false,
     "inputs": [
-      {
-        "internalType": "uint256",
-        "name": "_timestamp",
-        "type": "uint256"
-      },
-      {
-        "internalType": "uint256",
-        "name": "_queueOrigin",
-        "type": "uint256"
-      },
-      {
-        "internalType": "uint256",
-        "name": "_nonce",
-        "type": "uint256"
-      },
       {
         "internalType": "address",
-        "name": "_ovmEntrypoint",
+        "name": "_ovmContractAddress",
         "type": "address"
-      },
-      {
-        "internalType": "bytes",
-        "name": "_callBytes",
-        "type": "bytes"
-      },
-      {
-        "internalType": "uint8",
-        "name": "_v",
-        "type": "uint8"
-      },
-      {
-        "internalType": "bytes32",
-        "name": "_r",
-        "type": "bytes32"
-      },
+      }
+    ],
+    "name": "getOvmContractNonce",
+    "outputs": [
       {
-        "internalType": "bytes32",
-        "name": "_s",
-        "type": "bytes32"
+        "internalType": "uint256",
+        "name": "",
+        "type": "uint256"
       }
     ],
-    "name": "executeEOACall",
-    "outputs": [],
     "payable": false,
     "stateMutability": "nonpayable",
     "type": "function"
@@ -201,57 +108,23 @@ const RawExecutionManagerAbi = `[
   {
     "constant": false,
     "inputs": [
-      {
-        "internalType": "uint256",
-        "name": "_timestamp",
-        "type": "uint256"
-      },
-      {
-        "internalType": "uint256",
-        "name": "_queueOrigin",
-        "type": "uint256"
-      },
       {
         "internalType": "address",
-        "name": "_ovmEntrypoint",
-        "type": "address"
-      },
-      {
-        "internalType": "bytes",
-        "name": "_callBytes",
-        "type": "bytes"
-      },
-      {
-        "internalType": "address",
-        "name": "_fromAddress",
-        "type": "address"
-      },
-      {
-        "internalType": "address",
-        "name": "_l1MsgSenderAddress",
+        "name": "_ovmContractAddress",
         "type": "address"
       },
       {
-        "internalType": "bool",
-        "name": "_allowRevert",
-        "type": "bool"
+        "internalType": "bytes32",
+        "name": "_slot",
+        "type": "bytes32"
       }
     ],
-    "name": "executeTransaction",
-    "outputs": [],
-    "payable": false,
-    "stateMutability": "nonpayable",
-    "type": "function"
-  },
-  {
-    "constant": false,
-    "inputs": [],
-    "name": "getL1MessageSender",
+    "name": "getStorage",
     "outputs": [
       {
-        "internalType": "address",
+        "internalType": "bytes32",
         "name": "",
-        "type": "address"
+        "type": "bytes32"
       }
     ],
     "payable": false,
@@ -259,18 +132,18 @@ const RawExecutionManagerAbi = `[
     "type": "function"
   },
   {
-    "constant": true,
-    "inputs": [],
-    "name": "getStateManagerAddress",
-    "outputs": [
+    "constant": false,
+    "inputs": [
       {
         "internalType": "address",
-        "name": "",
+        "name": "_ovmContractAddress",
         "type": "address"
       }
     ],
+    "name": "incrementOvmContractNonce",
+    "outputs": [],
     "payable": false,
-    "stateMutability": "view",
+    "stateMutability": "nonpayable",
     "type": "function"
   },
   {
@@ -278,225 +151,39 @@ const RawExecutionManagerAbi = `[
     "inputs": [
       {
         "internalType": "address",
-        "name": "addr",
+        "name": "_ovmContractAddress",
         "type": "address"
       }
     ],
-    "name": "incrementNonce",
+    "name": "registerCreatedContract",
     "outputs": [],
     "payable": false,
     "stateMutability": "nonpayable",
     "type": "function"
   },
-  {
-    "constant": true,
-    "inputs": [],
-    "name": "isStaticContext",
-    "outputs": [],
-    "payable": false,
-    "stateMutability": "view",
-    "type": "function"
-  },
-  {
-    "constant": true,
-    "inputs": [],
-    "name": "ovmADDRESS",
-    "outputs": [],
-    "payable": false,
-    "stateMutability": "view",
-    "type": "function"
-  },
-  {
-    "constant": true,
-    "inputs": [],
-    "name": "ovmBlockGasLimit",
-    "outputs": [],
-    "payable": false,
-    "stateMutability": "view",
-    "type": "function"
-  },
-  {
-    "constant": false,
-    "inputs": [],
-    "name": "ovmCALL",
-    "outputs": [],
-    "payable": false,
-    "stateMutability": "nonpayable",
-    "type": "function"
-  },
-  {
-    "constant": true,
-    "inputs": [],
-    "name": "ovmCALLER",
-    "outputs": [],
-    "payable": false,
-    "stateMutability": "view",
-    "type": "function"
-  },
-  {
-    "constant": false,
-    "inputs": [],
-    "name": "ovmCREATE",
-    "outputs": [],
-    "payable": false,
-    "stateMutability": "nonpayable",
-    "type": "function"
-  },
-  {
-    "constant": false,
-    "inputs": [],
-    "name": "ovmCREATE2",
-    "outputs": [],
-    "payable": false,
-    "stateMutability": "nonpayable",
-    "type": "function"
-  },
-  {
-    "constant": false,
-    "inputs": [],
-    "name": "ovmDELEGATECALL",
-    "outputs": [],
-    "payable": false,
-    "stateMutability": "nonpayable",
-    "type": "function"
-  },
-  {
-    "constant": true,
-    "inputs": [],
-    "name": "ovmEXTCODECOPY",
-    "outputs": [],
-    "payable": false,
-    "stateMutability": "view",
-    "type": "function"
-  },
-  {
-    "constant": true,
-    "inputs": [],
-    "name": "ovmEXTCODEHASH",
-    "outputs": [],
-    "payable": false,
-    "stateMutability": "view",
-    "type": "function"
-  },
-  {
-    "constant": true,
-    "inputs": [],
-    "name": "ovmEXTCODESIZE",
-    "outputs": [],
-    "payable": false,
-    "stateMutability": "view",
-    "type": "function"
-  },
-  {
-    "constant": true,
-    "inputs": [],
-    "name": "ovmGASLIMIT",
-    "outputs": [],
-    "payable": false,
-    "stateMutability": "view",
-    "type": "function"
-  },
-  {
-    "constant": true,
-    "inputs": [],
-    "name": "ovmORIGIN",
-    "outputs": [],
-    "payable": false,
-    "stateMutability": "view",
-    "type": "function"
-  },
-  {
-    "constant": true,
-    "inputs": [],
-    "name": "ovmQueueOrigin",
-    "outputs": [],
-    "payable": false,
-    "stateMutability": "view",
-    "type": "function"
-  },
-  {
-    "constant": true,
-    "inputs": [],
-    "name": "ovmSLOAD",
-    "outputs": [],
-    "payable": false,
-    "stateMutability": "view",
-    "type": "function"
-  },
   {
     "constant": false,
-    "inputs": [],
-    "name": "ovmSSTORE",
-    "outputs": [],
-    "payable": false,
-    "stateMutability": "nonpayable",
-    "type": "function"
-  },
-  {
-    "constant": false,
-    "inputs": [],
-    "name": "ovmSTATICCALL",
-    "outputs": [],
-    "payable": false,
-    "stateMutability": "nonpayable",
-    "type": "function"
-  },
-  {
-    "constant": true,
-    "inputs": [],
-    "name": "ovmTIMESTAMP",
-    "outputs": [],
-    "payable": false,
-    "stateMutability": "view",
-    "type": "function"
-  },
-  {
-    "constant": true,
     "inputs": [
-      {
-        "internalType": "uint256",
-        "name": "_nonce",
-        "type": "uint256"
-      },
       {
         "internalType": "address",
-        "name": "_to",
+        "name": "_ovmContractAddress",
         "type": "address"
       },
-      {
-        "internalType": "bytes",
-        "name": "_callData",
-        "type": "bytes"
-      },
-      {
-        "internalType": "uint8",
-        "name": "_v",
-        "type": "uint8"
-      },
       {
         "internalType": "bytes32",
-        "name": "_r",
+        "name": "_slot",
         "type": "bytes32"
       },
       {
         "internalType": "bytes32",
-        "name": "_s",
+        "name": "_value",
         "type": "bytes32"
       }
     ],
-    "name": "recoverEOAAddress",
-    "outputs": [
-      {
-        "internalType": "address",
-        "name": "",
-        "type": "address"
-      }
-    ],
+    "name": "setStorage",
+    "outputs": [],
     "payable": false,
-    "stateMutability": "view",
+    "stateMutability": "nonpayable",
     "type": "function"
   }
 ]`
-
-// The initial state dump which should be loaded before any new state is created.
-const InitialOvmStateDump = "7b22726f6f74223a22222c226163636f756e7473223a7b22307830303030303030303030303030303030303030303030303030303030303030306465616430303030223a7b2262616c616e6365223a2230222c226e6f6e6365223a332c22726f6f74223a2232356533386662346666313161306430623533346235363238663331363265613232633636643739663364336237343931303834653537616562666531316335222c22636f646548617368223a2263343637646566656466313638306536376466656566653862306564316662643939653964373966333937336162313034316331313366376237633834373336222c22636f6465223a22363038303630343035323334383031353631303031303537363030303830666435623530363030343336313036313031613935373630303033353630653031633830363337333530393036343131363130306639353738303633626462663863333631313631303039373537383036336432303334313036313136313030373135373830363364323033343130363134363130326161353738303633643831373865336431343631303262323537383036336635356335633732313436313032626135373830363366626230663739643134363130326364353736313031613935363562383036336264626638633336313436313032393235373830363363333338326430663134363130323961353738303633633836343534313631343631303261323537363130316139353635623830363338653033623264313131363130306433353738303633386530336232643131343631303237323537383036333930353830323536313436313032376135373830363339393664373961353134363130323832353738303633623031363862613331343631303238613537363130316139353635623830363337333530393036343134363130323466353738303633373761356136326631343631303235373537383036333761656330613932313436313032366135373631303161393536356238303633343565393764646231313631303136363537383036333463366437633834313136313031343035373830363334633664376338343134363130323139353738303633356139386333363131343631303232313537383036333563386530313239313436313032323935373830363335636263636434363134363130323363353736313031613935363562383036333435653937646462313436313032303135373830363334396436356666393134363130323039353738303633346261383734366631343631303231313537363130316139353635623830363330333561323030353134363130316165353738303633313639303264353731343631303163633537383036333230313630663361313436313031643635373830363332303936363230383134363130316465353738303633323332636465653631343631303165363537383036333238646362326130313436313031663935373562363030303830666435623631303162363631303264353536356236303430353136313031633339313930363132363730353635623630343035313830393130333930663335623631303164343631303265363536356230303562363130316434363130333331353635623631303164343631303333663536356236313031623636313031663433363630303436313165643835363562363130336535353635623631303164343631303436653536356236313031643436313035313135363562363130316434363130356531353635623631303164343631303739363536356236313031643436313037613435363562363130316434363130383962353635623631303164343631303233373336363030343631316661633536356236313038613935363562363130316434363130323461333636303034363132303630353635623631306237313536356236313031643436313063366435363562363130316434363130323635333636303034363131653233353635623631306361663536356236313031643436313064313435363562363130316434363130646463353635623631303164343631306538613536356236313031643436313065393935363562363130316434363130656462353635623631303164343631306565393536356236313031643436313065663735363562363130316434363130663964353635623631303162363631306663343536356236313031643436313130353935363562363130316236363130326338333636303034363131663064353635623631313063613536356236313031643436313133306335363562363030303830363130326530363131343164353635623931353035303930353635623630303835343630303136303031363061303162303331363631303331373537363034303531363234363162636436306535316238313532363030343031363130333065393036313237326235363562363034303531383039313033393066643562363030383534363034303531363030313630303136306130316230333930393131363830383235323930363032303831663335623630306135343630343035313831383135323630323038316633356236303030363130333439363131343164353635623630303635343630343035313633363263353130613336306530316238313532393139323530363030343830333539323630303039323630303136303031363061303162303338303837313639333633363263353130613339333631303338363933393039323136393138373931303136313236393935363562363032303630343035313830383330333831363030303837383033623135383031353631303361303537363030303830666435623530356166313135383031353631303362343537336436303030383033653364363030306664356235303530353035303630343035313364363031663139363031663832303131363832303138303630343035323530363130336438393139303831303139303631316538353536356239303530363034303531383138313532363032303831663335623630303038303534363034303531363362663430666163313630653031623831353236303031363030313630613031623033393039313136393036336266343066616331393036313034313639303835393036303034303136313237316135363562363032303630343035313830383330333831383638303362313538303135363130343265353736303030383066643562353035616661313538303135363130343432353733643630303038303365336436303030666435623530353035303530363034303531336436303166313936303166383230313136383230313830363034303532353036313034363639313930383130313930363131653439353635623930353035623931393035303536356236303030363130343738363131343164353635623630303135343930393135303630666631363135363130343965353736303430353136323436316263643630653531623831353236303034303136313033306539303631323765613536356236303036353436303430353136333339653530336162363065303162383135323630303438303335393236303234333539323630303136303031363061303162303338303837313639333633333965353033616239333631303464613933393231363931383739313837393130313631323662343536356236303030363034303531383038333033383136303030383738303362313538303135363130346634353736303030383066643562353035616631313538303135363130353038353733643630303038303365336436303030666435623530353035303530353035303530353635623630303036313035316236313134316435363562363034303830353136303233313933363830383330313832303139303933353239323933353039313031393036303130333538323630323438333337363034303531363333646563356438353630653031623831353236303630383239303163393036303030393036303031363030313630613031623033383731363930363333646563356438353930363130353665393038353930363030343031363132363730353635623630323036303430353138303833303338313630303038373830336231353830313536313035383835373630303038306664356235303561663131353830313536313035396335373364363030303830336533643630303066643562353035303530353036303430353133643630316631393630316638323031313638323031383036303430353235303631303563303931393038313031393036313165343935363562393035303630303038303836383636303030383535616631363034303531336436303030383233653831363130356464353733643831666435623364383166333562363030303631303565623631313431643536356236303031353439303931353036306666313631353631303630353537363034303531363030303831353236303230383166333562363034303531363030333139333630313830383235323830363030343630323038343031333738313031363032303831303136303430383139303532363030363534363330653764306666663630653031623930393135323630303136303031363061303162303339303831313639313630303039313835313639303633306537643066666639303631303635373930383539303630323430313631323637303536356236303230363034303531383038333033383136303030383738303362313538303135363130363731353736303030383066643562353035616631313538303135363130363835353733643630303038303365336436303030666435623530353035303530363034303531336436303166313936303166383230313136383230313830363034303532353036313036613939313930383130313930363131653835353635623930353036303030363130366237383338333631313435313536356239303530363130366333383138353631313465643536356236303430353136333333646461313137363065313162383135323630303136303031363061303162303338363136393036333637626234323265393036313036656639303834393036303034303136313236373035363562363030303630343035313830383330333831363030303837383033623135383031353631303730393537363030303830666435623530356166313135383031353631303731643537336436303030383033653364363030306664356235303530363034303531363364393039616135333630653031623831353236303031363030313630613031623033383831363932353036336439303961613533393135303631303734643930383639303630303430313631323637303536356236303030363034303531383038333033383136303030383738303362313538303135363130373637353736303030383066643562353035616631313538303135363130373762353733643630303038303365336436303030666435623530353036303430353136303031363030313630613031623033383431363830383235323932353039303530363032303831663335623630306335343630343035313831383135323630323038316633356236303030363130376165363131343164353635623630343038303531363032333139333638303833303138323031393039333532393239333530393130313930363031303335383236303234383333373630363038313930316336303030383036313037646438333631313734653536356239313530393135303630303038373630303136303031363061303162303331363633336465633564383538353630343035313832363366666666666666663136363065303162383135323630303430313631303830663931393036313236373035363562363032303630343035313830383330333831363030303837383033623135383031353631303832393537363030303830666435623530356166313135383031353631303833643537336436303030383033653364363030306664356235303530353035303630343035313364363031663139363031663832303131363832303138303630343035323530363130383631393139303831303139303631316534393536356239303530363036303630303038303630303038613861363030303837356166313630343035313932353033643630303038343365383036313038383435373364383366643562353035303364383138313031363034303532363130383937383538353631313738323536356238303832663335623630303435343630343035313831383135323630323038316633356236303030363130386233363131343164353635623930353036313038633538383630303038393837383736303030363131376233353635623631303863653834363131373465353635623530363030303930353038303630303136303031363061303162303338383136313538303135363130613535353736313038663736303030363030323630323136303939316230313631313738323536356236313038666636313138303135363562363030313630303136306130316230333136363362313534306130313838363034303531383236336666666666666666313636306530316238313532363030343031363130393261393139303631323637303536356236303230363034303531383038333033383136303030383738303362313538303135363130393434353736303030383066643562353035616631313538303135363130393538353733643630303038303365336436303030666435623530353035303530363034303531336436303166313936303166383230313136383230313830363034303532353036313039376339313930383130313930363131653637353635623631303939383537363034303531363234363162636436306535316238313532363030343031363130333065393036313237346235363562363130396133363030303838363131373832353635623630653036303430353136313039623139303631323635613536356236303430353138303931303339303230393031633932353038373531363030343031393135303630303036313061346438383836363030313630303136306130316230333136363330653764306666663862363034303531383236336666666666666666313636306530316238313532363030343031363130396636393139303631323637303536356236303230363034303531383038333033383136303030383738303362313538303135363130613130353736303030383066643562353035616631313538303135363130613234353733643630303038303365336436303030666435623530353035303530363034303531336436303166313936303166383230313136383230313830363034303532353036313061343839313930383130313930363131653835353635623631313435313536356239303530353036313061643535363562363065303630343035313631306136333930363132363635353635623630343035313930383139303033383132303861353136336439303961613533363065303162383335323932316339343530363032343930393130313932353036303031363030313630613031623033383531363930363364393039616135333930363130616132393038613930363030343031363132363730353635623630303036303430353138303833303338313630303038373830336231353830313536313061626335373630303038306664356235303561663131353830313536313061643035373364363030303830336533643630303066643562353035303530353035623830363130616535353738383838353236303033313939303937303139363562363030313831313431353631306166353537363031633838303139373530356238323630313831633838353338323630313031633630303138393031353338323630303831633630303238393031353338323630303338393031353336303030363036303831383038303836386438323330356166313932353033643930353036303430353139313530363032303832303138313630303038323365383138333532383130313630343035323630323038323031363030313834313431353631306234633537383138316633356236303031383931343135363130623539353738313831666435623530383236313062363135373030356235303530353035303530353035303530353035303530353035303530353635623630303036313062376236313134316435363562393035303630303036313062386438383838383838383838383836313130636135363562393035303630303136303031363061303162303338313136363130626235353736303430353136323436316263643630653531623831353236303034303136313033306539303631323736623536356236303430353136333065376430666666363065303162383135323630303136303031363061303162303338333136393036333065376430666666393036313062653139303834393036303034303136313236373035363562363032303630343035313830383330333831363030303837383033623135383031353631306266623537363030303830666435623530356166313135383031353631306330663537336436303030383033653364363030306664356235303530353035303630343035313364363031663139363031663832303131363832303138303630343035323530363130633333393139303831303139303631316538353536356238383134363130633531353736303430353136323436316263643630653531623831353236303034303136313033306539303631323735623536356236313063363138613861383938393835363030303830363130386139353635623530353035303530353035303530353035303530353635623630303735343630303136303031363061303162303331363631306339353537363034303531363234363162636436306535316238313532363030343031363130333065393036313237336235363562363030373534363034303531363030313630303136306130316230333930393131363830383235323930363032303831663335623630303035343630343035313633396232656134626436306530316238313532363030313630303136306130316230333930393131363930363339623265613462643930363130636466393038343930363030343031363132376362353635623630303036303430353138303833303338313630303038373830336231353830313536313063663935373630303038306664356235303561663131353830313536313064306435373364363030303830336533643630303066643562353035303530353035303536356236303030363130643165363131343164353635623630343035313633336465633564383536306530316238313532393039313530363031303335393036303630383239303163393036303030393036303031363030313630613031623033383531363930363333646563356438353930363130643561393038353930363030343031363132363730353635623630323036303430353138303833303338313630303038373830336231353830313536313064373435373630303038306664356235303561663131353830313536313064383835373364363030303830336533643630303066643562353035303530353036303430353133643630316631393630316638323031313638323031383036303430353235303631306461633931393038313031393036313165343935363562393035303630303038343630303136303031363061303162303331363633646663616337376438333630343035313832363366666666666666663136363065303162383135323630303430313631303338363931393036313236373035363562363030303631306465363631313431643536356236303430353136333364656335643835363065303162383135323930393135303630313033353930363032343335393036303434333539303630363038343930316339303630303039303630303136303031363061303162303338373136393036333364656335643835393036313065326139303835393036303034303136313236373035363562363032303630343035313830383330333831363030303837383033623135383031353631306534343537363030303830666435623530356166313135383031353631306535383537336436303030383033653364363030306664356235303530353035303630343035313364363031663139363031663832303131363832303138303630343035323530363130653763393139303831303139303631316534393536356239303530363034303531383338353832383433633833383166333562363034303531363130316134383038323532393036303230383166333562363030363534363030313630303136306130316230333136363130656331353736303430353136323436316263643630653531623831353236303034303136313033306539303631323762623536356236303036353436303430353136303031363030313630613031623033393039313136383038323532393036303230383166333562363030353534363034303531383138313532363032303831663335623630303335343630343035313831383135323630323038316633356236303030363130663031363131343164353635623630343035313633336465633564383536306530316238313532393039313530363031303335393036303630383239303163393036303030393036303031363030313630613031623033383531363930363333646563356438353930363130663364393038353930363030343031363132363730353635623630323036303430353138303833303338313630303038373830336231353830313536313066353735373630303038306664356235303561663131353830313536313066366235373364363030303830336533643630303066643562353035303530353036303430353133643630316631393630316638323031313638323031383036303430353235303631306638663931393038313031393036313165343935363562393035303630343035313831336238313532363032303831663335623630303135343630303039303630666631363631306662313537363030303631306662343536356236303031356236306666313639303530363034303531383138313532363032303831663335623630303635343630303039303630303136303031363061303162303331363630303136303231363039393162303131343631306666383537363034303531363234363162636436306535316238313532363030343031363130333065393036313237666135363562363030393534363030313630303136306130316230333136363131303230353736303430353136323436316263643630653531623831353236303034303136313033306539303631323737623536356236303037353436303031363030313630613031623033313631353631313034393537363034303531363234363162636436306535316238313532363030343031363130333065393036313237616235363562353036303039353436303031363030313630613031623033313639303536356236303031353436306666313631353631313037303537363034303531363030303831353236303230383166333562363034303531363032333139333630313830383235323630303433353930383036303234363032303835303133373832303136303230303136303430353236303036353436303031363030313630613031623033313636303030363131306138383238343836363131383335353635623930353036313130623438313835363131346564353635623630343035313630303136303031363061303162303338323136383038323532393036303230383166333562363034303830353136303039383038323532363130313430383230313930393235323630303039313630363039313930383136303230303135623630363038313532363032303031393036303031393030333930383136313130653735373930353035303930353036313131303738383631313838363536356238313630303038313531383131303631313131343537666535623630323030323630323030313031383139303532353036313131323936303030363131383836353635623831363030313831353138313130363131313336353766653562363032303032363032303031303138313930353235303631313134663630306236303031303135343631313838363536356238313630303238313531383131303631313135633537666535623630323039303831303239313930393130313031353236303031363030313630613031623033383731363631313139633537363131313766363030303631313838363536356238313630303338313531383131303631313138633537666535623630323030323630323030313031383139303532353036313131626535363562363131316135383736313138393935363562383136303033383135313831313036313131623235376665356236303230303236303230303130313831393035323530356236313131633836303030363131383836353635623831363030343831353138313130363131316435353766653562363032303032363032303031303138313930353235303631313165393836363131386238353635623831363030353831353138313130363131316636353766653562363032303032363032303031303138313930353235303631313230653630303138303031353436313138383635363562383136303036383135313831313036313132316235376665356236303230303236303230303130313831393035323530363131323330363030303631313838363536356238313630303738313531383131303631313233643537666535623630323030323630323030313031383139303532353036313132353236303030363131383836353635623831363030383831353138313130363131323566353766653562363032303032363032303031303138313930353235303630363036313132373538323631313930313536356239303530363030303831363034303531363032303031363131323861393139303631323634653536356236303430353136303230383138333033303338313532393036303430353238303531393036303230303132303930353036303031383136303038363030313830303135343630303230323861303330333838383836303430353136303030383135323630323030313630343035323630343035313631313264313934393339323931393036313236646335363562363032303630343035313630323038313033393038303834303339303835356166613135383031353631313266333537336436303030383033653364363030306664356235303530363034303531363031663139303135313962396135303530353035303530353035303530353035303530353635623630303036313133313636313134316435363562363034303830353136303233313933363830383330313832303139303933353239323933353039313031393036303130333538323630323438333337363030313830353436306666313938313136383231373930393135353630666631363630363038323930316336303030383036313133353638333631313734653536356239313530393135303630303038383630303136303031363061303162303331363633336465633564383538353630343035313832363366666666666666663136363065303162383135323630303430313631313338383931393036313236373035363562363032303630343035313830383330333831363030303837383033623135383031353631313361323537363030303830666435623530356166313135383031353631313362363537336436303030383033653364363030306664356235303530353035303630343035313364363031663139363031663832303131363832303138303630343035323530363131336461393139303831303139303631316534393536356239303530363036303630303038303630303038623862363030303837356166313630343035313932353033643630303038343365336439313530383036313134303035373364383366643562353036313134306238353835363131373832353635623630303138303534363066663139313638383135313531373930353538303832663335623630303036313134346336303430353138303630343030313630343035323830363030633831353236303230303136623239626133306261333261366230623733306233623262393630613131623831353235303631303365353536356239303530393035363562363034303830353136303032383038323532363036303832383130313930393335323630303039323931393038313630323030313562363036303831353236303230303139303630303139303033393038313631313436633537393035303530393035303631313438633834363131383939353635623831363030303831353138313130363131343939353766653562363032303032363032303031303138313930353235303631313461643833363131383836353635623831363030313831353138313130363131346261353766653562363032303032363032303031303138313930353235303630363036313134643038323631313930313536356239303530363131346532383138303531393036303230303132303631313932343536356239323530353035303562393239313530353035363562363030303631313466373631313431643536356239303530363030303631313530333631313933303536356236303430353136333532323735616364363065313162383135323930393135303630303136303031363061303162303338323136393036336134346562353961393036313135333239303836393036303034303136313237316135363562363032303630343035313830383330333831383638303362313538303135363131353461353736303030383066643562353035616661313538303135363131353565353733643630303038303365336436303030666435623530353035303530363034303531336436303166313936303166383230313136383230313830363034303532353036313135383239313930383130313930363131653637353635623631313539653537363034303531363234363162636436306535316238313532363030343031363130333065393036313237396235363562363030303830363131356161383636313137346535363562393135303931353036303030363131356239383636313139363035363562393035303630363038353630303136303031363061303162303331363633643762353535356538333630343035313832363366666666666666663136363065303162383135323630303430313631313565393931393036313236373035363562363030303630343035313830383330333831363030303837383033623135383031353631313630333537363030303830666435623530356166313135383031353631313631373537336436303030383033653364363030306664356235303530353035303630343035313364363030303832336536303166336439303831303136303166313931363832303136303430353236313136336639313930383130313930363131656133353635623630343035313633353232373561636436306531316238313532393039313530363030313630303136306130316230333836313639303633613434656235396139303631313636653930383439303630303430313631323731613536356236303230363034303531383038333033383138363830336231353830313536313136383635373630303038306664356235303561666131353830313536313136396135373364363030303830336533643630303066643562353035303530353036303430353133643630316631393630316638323031313638323031383036303430353235303631313662653931393038313031393036313165363735363562363131366461353736303430353136323436316263643630653531623831353236303034303136313033306539303631323738623536356236303430353136333662386235376531363065303162383135323630303136303031363061303162303338373136393036333662386235376531393036313137303839303862393038363930363030343031363132363765353635623630303036303430353138303833303338313630303038373830336231353830313536313137323235373630303038306664356235303561663131353830313536313137333635373364363030303830336533643630303066643562353035303530353036313137343438343834363131373832353635623530353035303530353035303530353035363562363030363830353436303037383035343630303136303031363061303162303331393830383431363630303136303031363061303162303339363837313631373930393435353931383431363932383231363833313739303535393139303931313639313536356236303036383035343630303136303031363061303162303339323833313636303031363030313630613031623033313939313832313631373930393135353630303738303534393339303932313639323136393139303931313739303535353635623631313762653630303038303631313738323536356236303033393539303935353536303034393339303933353536303035393139303931353536303038383035343630303136303031363061303162303339323833313636303031363030313630613031623033313939313832313631373930393135353630303938303534393239303933313639313136313739303535363030613535353635623630303036313134346336303430353138303630343030313630343035323830363031313831353236303230303137303131313935633162316264653539356339356461316135643139356231613563646436303761316238313532353036313033653535363562363030303830363066663630663831623835383538353830353139303630323030313230363034303531363032303031363131383561393439333932393139303631323630363536356236303430353136303230383138333033303338313532393036303430353238303531393036303230303132303930353036313138376238313631313932343536356239313530353035623933393235303530353035363562363036303631303436363631313839343833363131393731353635623631313862383536356236303430383035313630303536306132316238333138363031343832303135323630333438313031393039313532363036303930363131383766383135623630363038303832353136303031313438303135363131386530353735303630383038333630303038313531383131303631313864363537666535623031363032303031353136306638316331303562313536313138656335373530383136313034363635363562363131383766363131386662383435313630383036313161363335363562383436313162396335363562363036303830363131393064383336313163313935363562393035303631313837663631313931653832353136306330363131613633353635623832363131623963353635623630303136303031363061303162303331363930353635623630303036313134346336303430353138303630343030313630343035323830363030643831353236303230303136633239623062333332626133636131623433326231623562326239363039393162383135323530363130336535353635623630303038313531363032303833303136303030663039323931353035303536356236303430383035313630323038303832353238313833303139303932353236303630393138323931393036303230383230313831383033383833333935303530353036303230383130313834393035323930353036303030356236303230383131303135363131396434353738313831383135313831313036313139623335376665356230313630323030313531363030313630303136306638316230333139313631353631313963633537363131396434353635623630303130313631313939653536356236303630383136303230303336303430353139303830383235323830363031663031363031663139313636303230303138323031363034303532383031353631316130343537363032303832303138313830333838333339303139303530356235303930353036303030356238313531383131303135363131613561353738333531363030313834303139333835393138313130363131613234353766653562363032303031303135313630663831633630663831623832383238313531383131303631316133623537666535623630323030313031393036303031363030313630663831623033313931363930383136303030316139303533353036303031303136313161306135363562353039343933353035303530353035363562363036303830363033383834313031353631316162643537363034303830353136303031383038323532383138333031393039323532393036303230383230313831383033383833333930313930353035303930353038323834303136306638316238313630303038313531383131303631316161313537666535623630323030313031393036303031363030313630663831623033313931363930383136303030316139303533353036313138376635363562363030303630303135623830383638313631316163623537666535623034313536313161653035373630303139303931303139303631303130303032363131616332353635623831363030313031363034303531393038303832353238303630316630313630316631393136363032303031383230313630343035323830313536313162306535373630323038323031383138303338383333393031393035303562353039323530383438323031363033373031363066383162383336303030383135313831313036313162323735376665356236303230303130313930363030313630303136306638316230333139313639303831363030303161393035333530363030313930353035623831383131313631316239333537363130313030383138333033363130313030306138373831363131623563353766653562303438313631316236343537666535623036363066383162383338323831353138313130363131623734353766653562363032303031303139303630303136303031363066383162303331393136393038313630303031613930353335303630303130313631316234333536356235303530393339323530353035303536356236303630383036303430353139303530383335313830383235323630323038323031383138313031363032303837303135623831383331303135363131626364353738303531383335323630323039323833303139323031363131626235353635623530383535313834353138313031383535323932353039303530383038323031363032303836303135623831383331303135363131626661353738303531383335323630323039323833303139323031363131626532353635623530383635313932393039323031313539313930393130313630316630313630316631393136363034303532353039303530393239313530353035363562363036303831353136303030313431353631316333613537353036303430383035313630303038313532363032303831303139303931353236313034363935363562363030303830356238333531383131303135363131633664353738333831383135313831313036313163353335376665356236303230303236303230303130313531353138323031393135303830383036303031303139313530353036313163336535363562363036303832363034303531393038303832353238303630316630313630316631393136363032303031383230313630343035323830313536313163396135373630323038323031383138303338383333393031393035303562353036303030393235303930353036303230383130313562383535313833313031353631316135613537363036303836383438313531383131303631316362643537666535623630323030323630323030313031353139303530363030303630323038323031393035303631316364623833383238343531363131643033353635623837383538313531383131303631316365373537666535623630323030323630323030313031353135313833303139323530353035303832383036303031303139333530353036313163613635363562383238323832356236303230383131303631316432363537383135313833353236303230393238333031393239303931303139303630316631393031363131643037353635623930353138323531363032303932393039323033363130313030306136303030313930313830313939303931313639313136313739303532353035303530353635623830333536313134653738313631323865393536356238303531363131346537383136313238653935363562383033353631313465373831363132393030353635623830353136313134653738313631323930303536356238303335363131346537383136313239303935363562383035313631313465373831363132393039353635623630303038323630316638333031313236313164396135373630303038306664356238313335363131646164363131646138383236313238333135363562363132383061353635623931353038303832353236303230383330313630323038333031383538333833303131313135363131646339353736303030383066643562363131646434383338323834363132383863353635623530353035303932393135303530353635623630303038323630316638333031313236313164656535373630303038306664356238313531363131646663363131646138383236313238333135363562393135303830383235323630323038333031363032303833303138353833383330313131313536313165313835373630303038306664356236313164643438333832383436313238393835363562363030303630323038323834303331323135363131653335353736303030383066643562363030303631316534313834383436313164343735363562393439333530353035303530353635623630303036303230383238343033313231353631316535623537363030303830666435623630303036313165343138343834363131643532353635623630303036303230383238343033313231353631316537393537363030303830666435623630303036313165343138343834363131643638353635623630303036303230383238343033313231353631316539373537363030303830666435623630303036313165343138343834363131643765353635623630303036303230383238343033313231353631316562353537363030303830666435623831353136376666666666666666666666666666666638313131313536313165636335373630303038306664356236313165343138343832383530313631316464643536356236303030363032303832383430333132313536313165656135373630303038306664356238313335363766666666666666666666666666666666383131313135363131663031353736303030383066643562363131653431383438323835303136313164383935363562363030303830363030303830363030303830363063303837383930333132313536313166323635373630303038306664356236303030363131663332383938393631316437333536356239363530353036303230363131663433383938323861303136313164343735363562393535303530363034303837303133353637666666666666666666666666666666663831313131353631316636303537363030303830666435623631316636633839383238613031363131643839353635623934353035303630363036313166376438393832386130313631316437333536356239333530353036303830363131663865383938323861303136313164373335363562393235303530363061303631316639663839383238613031363131643733353635623931353035303932393535303932393535303932393535363562363030303830363030303830363030303830363030303630653038383861303331323135363131666337353736303030383066643562363030303631316664333861386136313164373335363562393735303530363032303631316665343861383238623031363131643733353635623936353035303630343036313166663538613832386230313631316434373536356239353530353036303630383830313335363766666666666666666666666666666666383131313135363132303132353736303030383066643562363132303165386138323862303136313164383935363562393435303530363038303631323032663861383238623031363131643437353635623933353035303630613036313230343038613832386230313631316434373536356239323530353036306330363132303531386138323862303136313164356435363562393135303530393239353938393139343937353039323935353035363562363030303830363030303830363030303830363030303830363130313030383938623033313231353631323037643537363030303830666435623630303036313230383938623862363131643733353635623938353035303630323036313230396138623832386330313631316437333536356239373530353036303430363132306162386238323863303136313164373335363562393635303530363036303631323062633862383238633031363131643437353635623935353035303630383038393031333536376666666666666666666666666666666638313131313536313230643935373630303038306664356236313230653538623832386330313631316438393536356239343530353036306130363132306636386238323863303136313164373335363562393335303530363063303631323130373862383238633031363131643733353635623932353035303630653036313231313838623832386330313631316437333536356239313530353039323935393835303932393539383930393339363530353635623631323133313831363132383636353635623832353235303530353635623631323133313631323134333832363132383636353635623631323863383536356236313231333136313231353438323631323837363536356236313238383335363562363132313331383136313238383335363562363132313331363132313534383236313238383335363562363030303631323137393832363132383539353635623631323138333831383536313238356435363562393335303631323139333831383536303230383630313631323839383536356236313231396338313631323864393536356239303933303139333932353035303530353635623630303036313231623138323631323835393536356236313231626238313835363130343639353635623933353036313231636238313835363032303836303136313238393835363562393239303932303139323931353035303536356236303030363132316532363033323833363132383564353635623766343537323732366637323361323036313734373436353664373037343639366536373230373436663230363136333633363537333733323036653666366532643831353237313332626333346239626133326237336131303361336332376239333462336234623731373630373131623630323038323031353236303430303139323931353035303536356236303030363132323336363033333833363132383564353635623766343537323732366637323361323036313734373436353664373037343639366536373230373436663230363136333633363537333733323036653666366532643831353237323332626333346239626133326237336131303336623962336139623262373332333262393137363036393162363032303832303135323630343030313932393135303530353635623630303036313232386236303262383336313238356435363562376635333635366536343635373232303665366637343230363136633663366637373635363432303734366632303634363537303663366637393230366536353737383135323661323036333666366537343732363136333734373332313630613831623630323038323031353236303430303139323931353035303536356236303030363132326438363031303833363132383564353635623666343936653633366637323732363536333734323036653666366536333635323136303830316238313532363032303031393239313530353035363562363030303631323330343630306238333631303436393536356236613666373636643433353234353431353434353238323936306138316238313532363030623031393239313530353035363562363030303631323332623630316238333631323835643536356237663436363136393663363536343230373436663230373236353633366637363635373232303733363936373665363137343735373236353030303030303030303038313532363032303031393239313530353035363562363030303631323336343630303938333631303436393536356236383666373636643433343134633463323832393630623831623831353236303039303139323931353035303536356236303030363132333839363031383833363132383564353635623766346333313464363537333733363136373635353336353665363436353732323036653666373432303733363537343231303030303030303030303030303030303831353236303230303139323931353035303536356236303030363132336332363033303833363132383564353635623766343336663665373437323631363337343230373237353665373436393664363532303238363436353730366336663739363536343239323036323739373436353831353236663633366636343635323036393733323036653666373432303733363136363635363038303162363032303832303135323630343030313932393135303530353635623630303036313234313436303239383336313238356435363562376634333666366537343732363136333734323036393665363937343230323836333732363536313734363936663665323932303633366636343635323036393733383135323638323036653666373432303733363136363635363062383162363032303832303135323630343030313932393135303530353635623630303036313234356636303337383336313238356435363562376634633331346436353733373336313637363535333635366536343635373232303666366536633739323036313633363336353733373336393632366336353230383135323766363936653230363536653734373237393730366636393665373432303633366636653734373236313633373432313030303030303030303030303030303030303630323038323031353236303430303139323931353035303536356236303030363132346265363033623833363132383564353635623766343537323732366637323361323036313734373436353664373037343639366536373230373436663230363136333633363537333733323036653666366532643831353237663635373836393733373436353665373432303666373636643431363337343639373636353433366636653734373236313633373432653030303030303030303036303230383230313532363034303031393239313530353035363562363030303631323531643630306338333631323835643536356236623239626133306261333261366230623733306233623262393630613131623831353236303230303139323931353035303536356236303030363132353435363032633833363132383564353635623766343336313665366536663734323036333631366336633230353335333534346635323435323036363732366636643230373736393734363836393665323036313831353236623130323961613230616132346131613161306136323631373630613131623630323038323031353236303430303139323931353035303536356236303030363132353933363034663833363132383564353635623766346636653663373932303734363836353230346333313464363537333733363136373635353336353665363436353732323037303732363536333666366437303831353237663639366336353230363937333230363136633663366637373635363432303734366632303633363136633663323036373635373434633331346436353733373336303230383230313532366536313637363535333635366536343635373232383265326532653239323136303838316236303430383230313532363036303031393239313530353035363562363132313331383136313238383635363562363030303631323631323832383736313231343835363562363030313832303139313530363132363232383238363631323133373536356236303134383230313931353036313236333238323835363132313632353635623630323038323031393135303631323634323832383436313231363235363562353036303230303139343933353035303530353035363562363030303631313837663832383436313231613635363562363030303631313465373832363132326637353635623630303036313134653738323631323335373536356236303230383130313631313465373832383436313231323835363562363034303831303136313236386338323835363132313238353635623631313837663630323038333031383436313231323835363562363034303831303136313236613738323835363132313238353635623631313837663630323038333031383436313231353935363562363036303831303136313236633238323836363132313238353635623631323663663630323038333031383536313231353935363562363131653431363034303833303138343631323135393536356236303830383130313631323665613832383736313231353935363562363132366637363032303833303138363631323566643536356236313237303436303430383330313835363132313539353635623631323731313630363038333031383436313231353935363562393539343530353035303530353035363562363032303830383235323831303136313138376638313834363132313665353635623630323038303832353238313031363130343636383136313231643535363562363032303830383235323831303136313034363638313631323232393536356236303230383038323532383130313631303436363831363132323765353635623630323038303832353238313031363130343636383136313232636235363562363032303830383235323831303136313034363638313631323331653536356236303230383038323532383130313631303436363831363132333763353635623630323038303832353238313031363130343636383136313233623535363562363032303830383235323831303136313034363638313631323430373536356236303230383038323532383130313631303436363831363132343532353635623630323038303832353238313031363130343636383136313234623135363562363034303830383235323831303136313237646238313631323531303536356239303530363131346537363032303833303138343631323132383536356236303230383038323532383130313631303436363831363132353338353635623630323038303832353238313031363130343636383136313235383635363562363034303531383138313031363766666666666666666666666666666666383131313832383231303137313536313238323935373630303038306664356236303430353239313930353035363562363030303637666666666666666666666666666666663832313131353631323834383537363030303830666435623530363032303630316639313930393130313630316631393136303139303536356235313930353635623930383135323630323030313930353635623630303036313034363638323631313932343536356231353135393035363562363030313630303136306638316230333139313639303536356239303536356236306666313639303536356238323831383333373530363030303931303135323536356236303030356238333831313031353631323862333537383138313031353138333832303135323630323030313631323839623536356238333831313131353631323863323537363030303834383430313532356235303530353035303536356236303030363130343636383236303030363130343636383236313238653335363562363031663031363031663139313639303536356236303630316239303536356236313238663238313631323836363536356238313134363132386664353736303030383066643562353035363562363132386632383136313238373135363562363132386632383136313238383335366665613336353632376137613732333135383230393236373239303535653230333162306639313263653833366561333266656638613233363237653835616165316337303433326662356235626637333237343663363537383730363537323639366436353665373436313663663536343733366636633633343330303035306630303430222c2273746f72616765223a7b22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030223a22307830303030303030303030303030303030303030303030303030303030303030304445614430303043222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303032223a2230316134222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303062223a2237353330222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303063223a223362396163613030222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303065223a223737333539343030222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303066223a223737333539343030227d7d2c22307830303030303030303030303030303030303030303030303030303030303030306465616430303031223a7b2262616c616e6365223a2230222c226e6f6e6365223a312c22726f6f74223a2235323437653837323833396465336538636635396436616566323636326137396161396439306363653761396666333963616266366131363234626535376630222c22636f646548617368223a2261623034343831353830313561383862373835383035363932326163376463333039643666613161316661643333636265326636626236313833653161373039222c22636f6465223a223630383036303430353233343830313536313030313035373630303038306664356235303630303433363130363130306366353736303030333536306530316338303633363762623432326531313631303038633537383036336163366332363636313136313030363635373830363361633663323636363134363130313765353738303633643762353535356531343631303139313537383036336439303961613533313436313031623135373830363364666361633737643134363130316334353736313030636635363562383036333637626234323265313436313031343535373830363336623862353765313134363130313538353738303633383037646532333531343631303136623537363130306366353635623830363330653764306666663134363130306434353738303633333965353033616231343631303066643537383036333364656335643835313436313031313235373830363334393637373038353134363130313332353738303633356637386464383631343631303064343537383036333632633531306133313436313031333235373562363030303830666435623631303065373631303065323336363030343631303335653536356236313031643735363562363034303531363130306634393139303631303439393536356236303430353138303931303339306633356236313031313036313031306233363630303436313033656535363562363130316632353635623030356236313031323536313031323033363630303436313033356535363562363130323136353635623630343035313631303066343931393036313034386235363562363130306537363130313430333636303034363130336265353635623631303233343536356236313031313036313031353333363630303436313033356535363562363130323564353635623631303131303631303136363336363030343631303338343536356236313032363035363562363130313235363130313739333636303034363130333565353635623631303261383536356236313031313036313031386333363630303436313033626535363562363130326336353635623631303161343631303139663336363030343631303335653536356236313032653235363562363034303531363130306634393139303631303461373536356236313031313036313031626633363630303436313033356535363562363130333039353635623631303065373631303164323336363030343631303335653536356236313033326335363562363030313630303136306130316230333136363030303930383135323630303136303230353236303430393032303534393035363562363030313630303136306130316230333930393231363630303039303831353236303230383138313532363034303830383332303933383335323932393035323230353535363562363030313630303136306130316230333930383131363630303039303831353236303032363032303532363034303930323035343136393035363562363030313630303136306130316230333832313636303030393038313532363032303831383135323630343038303833323038343834353239303931353239303230353435623932393135303530353635623530353635623630303136303031363061303162303339313832313636303030383138313532363030323630323039303831353236303430383038333230383035343936393039353136363030313630303136306130316230333139393638373136383131373930393535353933383235323630303339303532393139303931323038303534393039323136313739303535353635623630303136303031363061303162303339303831313636303030393038313532363030333630323035323630343039303230353431363930353635623630303136303031363061303162303339303931313636303030393038313532363030313630323035323630343039303230353535363562363034303830353136303366383333623930383130313630316631393136383230313930393235323831383135323930383036303030363032303834303138353363353039313930353035363562363030313630303136306130316230333136363030303930383135323630303136303230383139303532363034303930393132303830353439303931303139303535353635623630303036303630363130333339383336313032653235363562383035313630323039303931303132303933393235303530353035363562383033353631303235373831363130353230353635623830333536313032353738313631303533343536356236303030363032303832383430333132313536313033373035373630303038306664356236303030363130333763383438343631303334383536356239343933353035303530353035363562363030303830363034303833383530333132313536313033393735373630303038306664356236303030363130336133383538353631303334383536356239323530353036303230363130336234383538323836303136313033343835363562393135303530393235303932393035303536356236303030383036303430383338353033313231353631303364313537363030303830666435623630303036313033646438353835363130333438353635623932353035303630323036313033623438353832383630313631303335333536356236303030383036303030363036303834383630333132313536313034303335373630303038306664356236303030363130343066383638363631303334383536356239333530353036303230363130343230383638323837303136313033353335363562393235303530363034303631303433313836383238373031363130333533353635623931353035303932353039323530393235363562363130343434383136313034636335363562383235323530353035363562363130343434383136313034643735363562363030303631303435653832363130346266353635623631303436383831383536313034633335363562393335303631303437383831383536303230383630313631303465363536356236313034383138313631303531363536356239303933303139333932353035303530353635623630323038313031363130323537383238343631303433623536356236303230383130313631303235373832383436313034346135363562363032303830383235323831303136313034623838313834363130343533353635623933393235303530353035363562353139303536356239303831353236303230303139303536356236303030363130323537383236313034646135363562393035363562363030313630303136306130316230333136393035363562363030303562383338313130313536313035303135373831383130313531383338323031353236303230303136313034653935363562383338313131313536313035313035373630303038343834303135323562353035303530353035363562363031663031363031663139313639303536356236313035323938313631303463633536356238313134363130323564353736303030383066643562363130353239383136313034643735366665613336353632376137613732333135383230396361636135623835386234303538666461306232363435333833386265386338663932613934303264353663303865643336613538656161386161663137323663363537383730363537323639366436353665373436313663663536343733366636633633343330303035306630303430222c2273746f72616765223a7b22307830323463396233656165313135663839376630336462666131303862653339653162336539323363343431343338646263616137633930373435616533666537223a22307834323030303030303030303030303030303030303030303030303030303030303030303030303032222c22307830333533303631613838633035393266333264373436386265333266663665356539316534396133656133666662336334666265343137633336353031626132223a223066222c22307832306465336464333132393730663436613164353630663663373066306535626431306536333862396262333833363336386632383833386336303765613365223a223065222c22307832643732616633633162326232393536653666363934666237343135353664356361393532343337333937343337386364626563313661666138623834313634223a223062222c22307833323862386536383761306139363338393261373335663032333763623736336262626266386261306331646665326332323164656262333263346262643839223a223130222c22307833333035303661626661326263366532633062393138303461626363333932356264363561383131326231663164626538663732373832366636623833623661223a2234323030303030303030303030303030303030303030303030303030303030303030303030303032222c22307833613565613539313139306565623366386663646365643834336337386466303465633064666434326635353130333735323037353135363634666130613735223a223038222c22307834303561616433326531616462616338396262376631373665333338623866633665393934636132313063396262376264636132343962343635393432323530223a223035222c22307834376434373435653032623334333638396135653761633132316432613335326237613135633130333238613837353966643764346366303939393030326262223a223130222c22307834613562646564323130626238363266616532633064313862396432396266376638386230386137356464313539346231333639616263373838316533666531223a223133222c22307835363632383635636463656232356332653966613961336466343138393662313865653131316461643462326466353538666135343332643164656230393063223a22307834323030303030303030303030303030303030303030303030303030303030303030303030303031222c22307835396464346231383438386431326635316564613639373537613065643432613230313063313462353634333330636337346130363839356536306330373762223a223036222c22307836373937393561303139356131623736636465626237633531643734653035386165653932393139623863333338396166383665663234353335653861323863223a223032222c22307836613262366266666163613738383136306636373166613632643334373538623731376637356139306164356134363837353763353064363166333363343433223a223132222c22307837663465633536353664396237623733336163323965366537663536386633353866653036386236303933373430356635666664616334376334366432326534223a2234323030303030303030303030303030303030303030303030303030303030303030303030303030222c22307838336563366131663032353762383330623565303136343537633963663134333533393162663536636339386633363961353861353466653933373732343635223a223034222c22307838356161613437623664633436343935626238383234666164343538333736393732366665613336656664383331613335353536363930623833306138666265223a223038222c22307838383630313437366431313631366137316335626536373535356264316466663462316362663231353333643236363962373638623631353138636665316333223a223033222c22307838613831363662653566333061626562366339316565326630376565623062326562313462346435393533346431306131633134333936346264363137393139223a223133222c22307838613864633465353234326561386231616231643630363036646165373537653663326363613966393261326363656439663732633139393630626362343538223a223039222c22307839646362393738336261356364306235343734356636356634663931383532356534363165393138383863333334653533343263623338306163353538643533223a223061222c22307861313562633630633935356334303564323064393134396337303965323436306631633264396134393734393661376634363030346431373732633330353463223a223031222c22307861346530663434333265343464303237613762336639353339343066303936626361376139626439313032393763616432626137633730336332623739396433223a223131222c22307861386632643936313236633664306164363361646162616566376266356366343766313633666230633231386134373364323866363233313264313937626366223a223064222c22307861623939353262616636343738643863666237323533636538366136633533613762373534393538326337363231306231353831616536383262376535353666223a223062222c22307861636236633632333664646638633266316332636563353933616161323334653731336134353733313336383131343463356262313233333635336334643633223a2234323030303030303030303030303030303030303030303030303030303030303030303030303031222c22307861636438656632343432313062623638393865373363343862663832306564386563633835376133626162386437396331306534666139326231653963613635223a223037222c22307862323933313964313736663565363863343062326230643262393136613139383433353362383865333332666134323033333030393439316164353665303666223a22307834323030303030303030303030303030303030303030303030303030303030303030303030303030222c22307862393862373836333330393966613336656438623836383063346638303932363839653165303430383065623963626230373763613338613134643765333834223a223035222c22307862643831343736326137653335643563313632613735373064313462616136386264363232636162623161643833643430646437306638613838616136376330223a223063222c22307863306337633763396132613636353538363266656561336363376666313336323935383232393366636665306531303934656662323038393762623032613635223a223132222c22307863336132346230353031626432633133613765353766326462343336396563346332323334343735333966633037323461396435356163346130366562643464223a223032222c22307863363930353666313663626161336336313662383238653333336162376433613332333130373635353037663866353833353965393965626237613838356633223a223036222c22307863626334653566623032633364316465323361396631653031346234643265653561656165613935303564663565383535633932313062663437323439356166223a223033222c22307864333630346462393738663631333762306431383831366237376232636538313034383761336166303861393232653062313834393633626535663361646663223a223061222c22307864353661363035393565626566656265643766323264636565366332616363363162303663663863363865383463383836373738343033363564316666393262223a223063222c22307864366562636336346337333932373762313137636533353965343336353334623233346237366539313463383061643237366162663562353632303738393339223a223065222c22307865393062376263656236653764663534313866623738643865653534366539376338336130386262636363303161303634346435393963636432613763326530223a223031222c22307865623564393261613562313861663335633264306330643134613533383739326366316136366161303661623964616534396433323434366539303633636131223a223064222c22307865653630643035373962636666643938653636383634376435396665633166663836613766623334306365353732653834346632333461653733613639313866223a223034222c22307866326334393133326564316365653261376537356264653530643333326132663831663164303165353435366438613139643164663039626435363164626432223a223037222c22307866363062376636613331356563363861366163323430653639646361353336353262333836323766373039613263616132313764396531386166346437613630223a223066222c22307866383563633666666335313364633663663764313939656638376237613633636639646566653632323531633163323437636431326631656563376266663239223a223039222c22307866633131316430396136653266303935383430326362653136613561656633326339643864646239613464663732373131343064653537626665643635323561223a223131227d7d2c22307830303030303030303030303030303030303030303030303030303030303030306465616430303033223a7b2262616c616e6365223a2230222c226e6f6e6365223a312c22726f6f74223a2239633734613933306535666530666434323035326165363234383566633165393864633366393462346137323464623031643734396231633630393065343331222c22636f646548617368223a2265623638343138363461376262373838346165383561646536396230626231363461363261343664653831373439643962356566353731366132613862653063222c22636f6465223a22363038303630343035323334383031353631303031303537363030303830666435623530363030343336313036313030613935373630303033353630653031633830363339353739303864313131363130303731353738303633393537393038643131343631303131653537383036336261373562626438313436313031323835373830363362643336376366653134363130313330353738303633633263663639366631343631303133383537383036336335636439316364313436313031343035373830363365363838663536393134363130313632353736313030613935363562383036333164306533626363313436313030616535373830363332333263646565363134363130306363353738303633336661356630633831343631303065633537383036333539653032646437313436313030663435373830363336383166653730633134363130313039353735623630303038306664356236313030623636313031373535363562363034303531363130306333393139303631303933643536356236303430353138303931303339306633356236313030646636313030646133363630303436313036346435363562363130313931353635623630343035313631303063333931393036313038393335363562363130306236363130323138353635623631303066633631303233343536356236303430353136313030633339313930363130393266353635623631303131313631303262353536356236303430353136313030633339313930363130386131353635623631303132363631303263303536356230303562363130306236363130333032353635623631303062363631303330383536356236313030623636313033306535363562363130313533363130313465333636303034363130363832353635623631303331333536356236303430353136313030633339333932393139303631303861663536356236313031323636313031373033363630303436313036346435363562363130333433353635623630303036313031376636313035393935363562363130313837363130323334353635623630323030313531393135303530393035363562363030303830353436303430353136336266343066616331363065303162383135323630303136303031363061303162303339303931313639303633626634306661633139303631303163323930383539303630303430313631303864373536356236303230363034303531383038333033383138363830336231353830313536313031646135373630303038306664356235303561666131353830313536313031656535373364363030303830336533643630303066643562353035303530353036303430353133643630316631393630316638323031313638323031383036303430353235303631303231323931393038313031393036313036323735363562393239313530353035363562363030303631303232323631303539393536356236313032326136313032333435363562363034303031353139313530353039303536356236313032336336313035393935363562363130323434363130326235353635623135363130323661353736303430353136323436316263643630653531623831353236303034303136313032363139303631303931663536356236303430353138303931303339306664356236303031363030323534383135343831313036313032373935376665356239303630303035323630323036303030323039303630303330323031363034303531383036303630303136303430353239303831363030303832303135343831353236303230303136303031383230313534383135323630323030313630303238323031353438313532353035303930353039303536356236303031353436303032353431303135393035363562363130326338363130343134353635623630303136303031363061303162303331363333363030313630303136306130316230333136313436313032663835373630343035313632343631626364363065353162383135323630303430313631303236313930363130386566353635623631303330303631303435393536356235363562363030323534383135363562363030313534393035363562363030613831353635623630303138313831353438313130363130333230353766653562363030303931383235323630323039303931323036303033393039313032303138303534363030313832303135343630303239303932303135343930393235303833353635623333333231343631303336323537363034303531363234363162636436306535316238313532363030343031363130323631393036313039306635363562363030303630306136313033366638333631303462373536356238313631303337363537666535623034393035303631303338313631303462653536356236303031363030313630613031623033313636333262313439643136383236303430353138323633666666666666666631363630653031623831353236303034303136313033616339313930363130393364353635623630303036303430353138303833303338313630303038373830336231353830313536313033633635373630303038306664356235303561663131353830313536313033646135373364363030303830336533643630303066643562353035303630343035313766336266613130356538383438616264326564376162623736616565386132346638316266653536613163373238323364303733373937663536353038646439653932353036303030393135306131363130343130383236313034656335363562353035303536356236303030363130343534363034303531383036303430303136303430353238303630313938313532363032303031376634333631366536663665363936333631366335343732363136653733363136333734363936663665343336383631363936653030303030303030303030303030383135323530363130313931353635623930353039303536356236303031353436303032353431303631303437633537363034303531363234363162636436306535316238313532363030343031363130323631393036313038666635363562363030313630303235343831353438313130363130343862353766653562363030303931383235323630323038323230363030333930393130323031383138313535363030313830383230313833393035353630303239313832303139323930393235353830353439303931303139303535353635623630343830313531393035363562363030303631303435343630343035313830363034303031363034303532383036303062383135323630323030313661323362306239613162376237333962616236623262393630613931623831353235303631303139313536356238303531363032303931383230313230363034303830353136303630383130313832353239313832353234323932383230313932383335323433393038323031393038313532363030313830353438303832303138323535363030303931393039313532393135313766623130653264353237363132303733623236656563646664373137653661333230636634346234616661633262303733326439666362653262376661306366363630303339303933303239323833303135353931353137666231306532643532373631323037336232366565636466643731376536613332306366343462346166616332623037333264396663626532623766613063663738323031353539303531376662313065326435323736313230373362323665656364666437313765366133323063663434623461666163326230373332643966636265326237666130636638393039313031353535363562363034303531383036303630303136303430353238303630303038303139313638313532363032303031363030303831353236303230303136303030383135323530393035363562383035313631303231323831363130613038353635623630303038323630316638333031313236313035643935373630303038306664356238313335363130356563363130356537383236313039373235363562363130393462353635623931353038303832353236303230383330313630323038333031383538333833303131313135363130363038353736303030383066643562363130363133383338323834363130396336353635623530353035303932393135303530353635623830333536313032313238313631306131663536356236303030363032303832383430333132313536313036333935373630303038306664356236303030363130363435383438343631303562643536356239343933353035303530353035363562363030303630323038323834303331323135363130363566353736303030383066643562383133353637666666666666666666666666666666663831313131353631303637363537363030303830666435623631303634353834383238353031363130356338353635623630303036303230383238343033313231353631303639343537363030303830666435623630303036313036343538343834363130363163353635623631303661393831363130396137353635623832353235303530353635623631303661393831363130396232353635623631303661393831363130396237353635623630303036313036636338323631303939613536356236313036643638313835363130393965353635623933353036313036653638313835363032303836303136313039643235363562363130366566383136313039666535363562393039333031393339323530353035303536356236303030363130373036363034623833363130393965353635623766346636653663373932303734363836353230363336313665366636653639363336313663323037343732363136653733363136333734363936663665323036333831353237663638363136393665323036333631366532303634363537313735363537353635323037333631363636353734373932303731373536353735363532303734373236303230383230313532366133306237333962306231626133346237623733393937363061393162363034303832303135323630363030313932393135303530353635623630303036313037373936303232383336313039396535363562376634333631366536653666373432303634363537313735363537353635323036363732366636643230363136653230363536643730373437393230373137353635383135323631373536353630663031623630323038323031353236303430303139323931353035303536356236303030363130376264363033653833363130393965353635623766346636653663373932303435346634313733323036333631366532303635366537313735363537353635323037323666366336633735373032303734373236313831353237663665373336313633373436393666366537333230373436663230373436383635323037333631363636353734373932303731373536353735363532653030303036303230383230313532363034303031393239313530353035363562363030303631303831633630323538333631303939653536356237663531373536353735363532303639373332303635366437303734373932633230366536663230363536633635366436353665373432303734366632303730363538313532363431393561633831383564363064613162363032303832303135323630343030313932393135303530353635623830353136303630383330313930363130383637383438323631303662383536356235303630323038323031353136313038376136303230383530313832363130366238353635623530363034303832303135313631303838643630343038353031383236313036623835363562353035303530353035363562363032303831303136313032313238323834363130366130353635623630323038313031363130323132383238343631303661663536356236303630383130313631303862643832383636313036623835363562363130386361363032303833303138353631303662383536356236313036343536303430383330313834363130366238353635623630323038303832353238313031363130386538383138343631303663313536356239333932353035303530353635623630323038303832353238313031363130323132383136313036663935363562363032303830383235323831303136313032313238313631303736633536356236303230383038323532383130313631303231323831363130376230353635623630323038303832353238313031363130323132383136313038306635363562363036303831303136313032313238323834363130383536353635623630323038313031363130323132383238343631303662383536356236303430353138313831303136376666666666666666666666666666666638313131383238323130313731353631303936613537363030303830666435623630343035323931393035303536356236303030363766666666666666666666666666666666383231313135363130393839353736303030383066643562353036303230363031663931393039313031363031663139313630313930353635623531393035363562393038313532363032303031393035363562363030303631303231323832363130396261353635623135313539303536356239303536356236303031363030313630613031623033313639303536356238323831383333373530363030303931303135323536356236303030356238333831313031353631303965643537383138313031353138333832303135323630323030313631303964353536356238333831313131353631303838643537353035303630303039313031353235363562363031663031363031663139313639303536356236313061313138313631303961373536356238313134363130613163353736303030383066643562353035363562363130613131383136313039623735366665613336353632376137613732333135383230643133666135306138633232333933333964333263363739653562323765326162656338303936393361646532313362363935313263623065626134616335643663363537383730363537323639366436353665373436313663663536343733366636633633343330303035306630303430222c2273746f72616765223a7b22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030223a22307830303030303030303030303030303030303030303030303030303030303030304445614430303043227d7d2c22307830303030303030303030303030303030303030303030303030303030303030306465616430303034223a7b2262616c616e6365223a2230222c226e6f6e6365223a312c22726f6f74223a2231656565303963313061353835616133373061386537373239626364333331363966386237656565653265333036363434613531306563396531323133373131222c22636f646548617368223a2264333963356135623362373633376332306534376564386166643335326231313532353664366437613466346532633362396333316562386137313564636639222c22636f6465223a22363038303630343035323334383031353631303031303537363030303830666435623530363030343336313036313031303035373630303033353630653031633830363337636136656334623131363130303937353738303633633133396562313531313631303036363537383036336331333965623135313436313031636535373830363363373166366437393134363130316436353738303633636138396366323831343631303164653537383036336561663434633266313436313031663135373631303130303536356238303633376361366563346231343631303139363537383036333830393139363231313436313031396535373830363361383461343737663134363130316233353738303633623332633464386431343631303162623537363130313030353635623830363335303666323936613131363130306433353738303633353036663239366131343631303135333537383036333563316262613338313436313031356235373830363336366465653139633134363130313633353738303633366434366539383731343631303138333537363130313030353635623830363330356432646235343134363130313035353738303633313338333837613431343631303132333537383036333233326364656536313436313031326235373830363334363763326135353134363130313462353735623630303038306664356236313031306436313032303435363562363034303531363130313161393139303631323037303536356236303430353138303931303339306633356236313031306436313032306135363562363130313365363130313339333636303034363131373465353635623631303231303536356236303430353136313031316139313930363132303433353635623631303130643631303239373536356236313031306436313032396435363562363130313365363130326133353635623631303137363631303137313336363030343631313664323536356236313032623235363562363034303531363130313161393139303631323036323536356236313031373636313031393133363630303436313135646135363562363130336237353635623631303130643631303363623536356236313031623136313031616333363630303436313136316535363562363130336431353635623030356236313031623136313039616435363562363130313064363130316339333636303034363131376266353635623631306261663536356236313031306436313062636435363562363130316231363130626433353635623631303162313631303165633336363030343631313762663536356236313064623835363562363130313064363130316666333636303034363131376131353635623631313035363536356236303035353439303536356236303033353438313536356236303030383035343630343035313633626634306661633136306530316238313532363030313630303136306130316230333930393131363930363362663430666163313930363130323431393038353930363030343031363132306339353635623630323036303430353138303833303338313836383033623135383031353631303235393537363030303830666435623530356166613135383031353631303236643537336436303030383033653364363030306664356235303530353035303630343035313364363031663139363031663832303131363832303138303630343035323530363130323931393139303831303139303631313630303536356239323931353035303536356236303036353438313536356236303037353438313536356236303031353436303031363030313630613031623033313638313536356236303030363130326263363131323933353635623530363032303832303135313630613038313031353136303430383430313531303138343134363130326464353736303030393135303530363130336230353635623630303036313032653736313130613335363562363036303830383430313531363034303830383830313531393238383031353139303531363331383663383533623630653131623831353239333934353036303031363030313630613031623033383531363933363333306439306137363933363130333236393339323863393236303034303136313230376535363562363032303630343035313830383330333831383638303362313538303135363130333365353736303030383066643562353035616661313538303135363130333532353733643630303038303365336436303030666435623530353035303530363034303531336436303166313936303166383230313136383230313830363034303532353036313033373639313930383130313930363131363936353635623631303338353537363030303932353035303530363130336230353635623630303538343630303030313531383135343831313036313033393635376665356239303630303035323630323036303030323030313534363130336161383336313130353635363562313439323530353035303562393339323530353035303536356236303031353436303031363030313630613031623033393038313136393131363134393035363562363030343534383135363562363030303631303364623631313064633536356239303530363030303631303365373631313131353536356239303530363130336632333336313033623735363562363130343137353736303430353136323436316263643630653531623831353236303034303136313034306539303631323066613536356236303430353138303931303339306664356236303030383635313131363130343338353736303430353136323436316263643630653531623831353236303034303136313034306539303631323136613536356234323630303235343836303131313631303435623537363034303531363234363162636436306535316238313532363030343031363130343065393036313231386135363562343336303033353438353031313136313034376535373630343035313632343631626364363065353162383135323630303430313631303430653930363132316461353635623432383531313135363130343965353736303430353136323436316263643630653531623831353236303034303136313034306539303631323065613536356234333834313131353631303462653537363034303531363234363162636436306535316238313532363030343031363130343065393036313231636135363562363030343534383331303135363130346530353736303430353136323436316263643630653531623831353236303034303136313034306539303631323064613536356236303034353438333131313536313034663335373631303466333833363130646238353635623831363030313630303136306130316230333136363336383166653730633630343035313831363366666666666666663136363065303162383135323630303430313630323036303430353138303833303338313836383033623135383031353631303532633537363030303830666435623530356166613135383031353631303534303537336436303030383033653364363030306664356235303530353035303630343035313364363031663139363031663832303131363832303138303630343035323530363130353634393139303831303139303631313639363536356236313036383835373831363030313630303136306130316230333136363331643065336263633630343035313831363366666666666666663136363065303162383135323630303430313630323036303430353138303833303338313836383033623135383031353631303561313537363030303830666435623530356166613135383031353631303562353537336436303030383033653364363030306664356235303530353035303630343035313364363031663139363031663832303131363832303138303630343035323530363130356439393139303831303139303631313662343536356238353131313536313035663835373630343035313632343631626364363065353162383135323630303430313631303430653930363132313161353635623831363030313630303136306130316230333136363333666135663063383630343035313831363366666666666666663136363065303162383135323630303430313630323036303430353138303833303338313836383033623135383031353631303633313537363030303830666435623530356166613135383031353631303634353537336436303030383033653364363030306664356235303530353035303630343035313364363031663139363031663832303131363832303138303630343035323530363130363639393139303831303139303631313662343536356238343131313536313036383835373630343035313632343631626364363065353162383135323630303430313631303430653930363132316261353635623830363030313630303136306130316230333136363336383166653730633630343035313831363366666666666666663136363065303162383135323630303430313630323036303430353138303833303338313836383033623135383031353631303663313537363030303830666435623530356166613135383031353631303664353537336436303030383033653364363030306664356235303530353035303630343035313364363031663139363031663832303131363832303138303630343035323530363130366639393139303831303139303631313639363536356236313038316435373830363030313630303136306130316230333136363331643065336263633630343035313831363366666666666666663136363065303162383135323630303430313630323036303430353138303833303338313836383033623135383031353631303733363537363030303830666435623530356166613135383031353631303734613537336436303030383033653364363030306664356235303530353035303630343035313364363031663139363031663832303131363832303138303630343035323530363130373665393139303831303139303631313662343536356238353131313536313037386435373630343035313632343631626364363065353162383135323630303430313631303430653930363132313261353635623830363030313630303136306130316230333136363333666135663063383630343035313831363366666666666666663136363065303162383135323630303430313630323036303430353138303833303338313836383033623135383031353631303763363537363030303830666435623530356166613135383031353631303764613537336436303030383033653364363030306664356235303530353035303630343035313364363031663139363031663832303131363832303138303630343035323530363130376665393139303831303139303631313662343536356238343131313536313038316435373630343035313632343631626364363065353162383135323630303430313631303430653930363132313561353635623630303635343835313031353631303833663537363034303531363234363162636436306535316238313532363030343031363130343065393036313231306135363562363030373534383431303135363130383631353736303430353136323436316263643630653531623831353236303034303136313034306539303631323161613536356236303036383539303535363030373834393035353630303036313038373536313130613335363562393035303630303038363836363030303834363030313630303136306130316230333136363334306666333465663863363034303531383236336666666666666666313636306530316238313532363030343031363130386139393139303631323035313536356236303230363034303531383038333033383138363830336231353830313536313038633135373630303038306664356235303561666131353830313536313038643535373364363030303830336533643630303066643562353035303530353036303430353133643630316631393630316638323031313638323031383036303430353235303631303866393931393038313031393036313136623435363562386235313630303435343630343035313630323030313631303931333936393539343933393239313930363131666439353635623630343038303531363031663139383138343033303138313532393038323930353238303531363032303930393130313230363030353830353436303031383130313832353536303030393139303931353237663033366236333834623565636137393163363237363131353264306337396262303630346331303461356662366634656230373033663331353462623364623030313831393035353839353136303034383035343930393130313930353539313530376632353666646235646539626532663534356336326639623863343533613766383234363937386430653164643730393730636335333862333230336566356165393036313039396239303833393036313230373035363562363034303531383039313033393061313530353035303530353035303530353035363562363030303631303962373631313064633536356239303530363030303631303963333631313131353536356239303530363130396364363131326365353635623831363030313630303136306130316230333136363335396530326464373630343035313831363366666666666666663136363065303162383135323630303430313630363036303430353138303833303338313836383033623135383031353631306130363537363030303830666435623530356166613135383031353631306131613537336436303030383033653364363030306664356235303530353035303630343035313364363031663139363031663832303131363832303138303630343035323530363130613365393139303831303139303631313738333536356239303530383236303031363030313630613031623033313636333638316665373063363034303531383136336666666666666666313636306530316238313532363030343031363032303630343035313830383330333831383638303362313538303135363130613739353736303030383066643562353035616661313538303135363130613864353733643630303038303365336436303030666435623530353035303530363034303531336436303166313936303166383230313136383230313830363034303532353036313061623139313930383130313930363131363936353635623830363130623330353735303832363030313630303136306130316230333136363331643065336263633630343035313831363366666666666666663136363065303162383135323630303430313630323036303430353138303833303338313836383033623135383031353631306166303537363030303830666435623530356166613135383031353631306230343537336436303030383033653364363030306664356235303530353035303630343035313364363031663139363031663832303131363832303138303630343035323530363130623238393139303831303139303631313662343536356238313630323030313531313131353562363130623463353736303430353136323436316263643630653531623831353236303034303136313034306539303631323131613536356236313062353738313630303036313131346535363562383136303031363030313630613031623033313636333935373930386431363034303531383136336666666666666666313636306530316238313532363030343031363030303630343035313830383330333831363030303837383033623135383031353631306239323537363030303830666435623530356166313135383031353631306261363537336436303030383033653364363030306664356235303530353035303530353035303536356236303035383138313534383131303631306262633537666535623630303039313832353236303230393039313230303135343930353038313536356236303032353438313536356236303030363130626464363131306463353635623930353036303030363130626539363131313135353635623930353036313062663336313132636535363562383236303031363030313630613031623033313636333539653032646437363034303531383136336666666666666666313636306530316238313532363030343031363036303630343035313830383330333831383638303362313538303135363130633263353736303030383066643562353035616661313538303135363130633430353733643630303038303365336436303030666435623530353035303530363034303531336436303166313936303166383230313136383230313830363034303532353036313063363439313930383130313930363131373833353635623930353038313630303136303031363061303162303331363633363831666537306336303430353138313633666666666666666631363630653031623831353236303034303136303230363034303531383038333033383138363830336231353830313536313063396635373630303038306664356235303561666131353830313536313063623335373364363030303830336533643630303066643562353035303530353036303430353133643630316631393630316638323031313638323031383036303430353235303631306364373931393038313031393036313136393635363562383036313064353635373530383136303031363030313630613031623033313636333164306533626363363034303531383136336666666666666666313636306530316238313532363030343031363032303630343035313830383330333831383638303362313538303135363130643136353736303030383066643562353035616661313538303135363130643261353733643630303038303365336436303030666435623530353035303530363034303531336436303166313936303166383230313136383230313830363034303532353036313064346539313930383130313930363131366234353635623831363032303031353131313135356236313064373235373630343035313632343631626364363065353162383135323630303430313631303430653930363132313261353635623631306437643831363030313631313134653536356238323630303136303031363061303162303331363633393537393038643136303430353138313633666666666666666631363630653031623831353236303034303136303030363034303531383038333033383136303030383738303362313538303135363130623932353736303030383066643562363130646331333336313033623735363562363130646464353736303430353136323436316263643630653531623831353236303034303136313034306539303631323133613536356236303030363130646537363131306463353635623930353036303030363130646633363131313135353635623930353035623832363030343534313031353631313033303537363030303831363030313630303136306130316230333136363336383166653730633630343035313831363366666666666666663136363065303162383135323630303430313630323036303430353138303833303338313836383033623135383031353631306533623537363030303830666435623530356166613135383031353631306534663537336436303030383033653364363030306664356235303530353035303630343035313364363031663139363031663832303131363832303138303630343035323530363130653733393139303831303139303631313639363536356239303530363030303833363030313630303136306130316230333136363336383166653730633630343035313831363366666666666666663136363065303162383135323630303430313630323036303430353138303833303338313836383033623135383031353631306562303537363030303830666435623530356166613135383031353631306563343537336436303030383033653364363030306664356235303530353035303630343035313364363031663139363031663832303131363832303138303630343035323530363130656538393139303831303139303631313639363536356239303530383138303135363130656634353735303830356231353631306631313537363034303531363234363162636436306535316238313532363030343031363130343065393036313231346135363562383031353631306632343537363130663166363130396164353635623631313032393536356238313135363130663332353736313066316636313062643335363562383336303031363030313630613031623033313636333164306533626363363034303531383136336666666666666666313636306530316238313532363030343031363032303630343035313830383330333831383638303362313538303135363130663662353736303030383066643562353035616661313538303135363130663766353733643630303038303365336436303030666435623530353035303530363034303531336436303166313936303166383230313136383230313830363034303532353036313066613339313930383130313930363131366234353635623833363030313630303136306130316230333136363331643065336263633630343035313831363366666666666666663136363065303162383135323630303430313630323036303430353138303833303338313836383033623135383031353631306664633537363030303830666435623530356166613135383031353631306666303537336436303030383033653364363030306664356235303530353035303630343035313364363031663139363031663832303131363832303138303630343035323530363131303134393139303831303139303631313662343536356231313631313032313537363130663166363130396164353635623631313032393631306264333536356235303530363130646636353635623832363030343534313436313130353135373630343035313632343631626364363065353162383135323630303430313631303430653930363132313761353635623530353035303536356238303531363032303830383330313531363034303830383530313531363036303836303135313630383038373031353136306130383830313531393335313630303039373631313038363937393039363935393130313631316664393536356236303430353136303230383138333033303338313532393036303430353238303531393036303230303132303930353039313930353035363562363030303631313064373630343035313830363034303031363034303532383036303131383135323630323030313730353236663663366337353730346436353732366236633635353537343639366337333630373831623831353235303631303231303536356239303530393035363562363030303631313064373630343035313830363034303031363034303532383036303136383135323630323030313735346333313534366634633332353437323631366537333631363337343639366636653531373536353735363536303530316238313532353036313032313035363562363030303631313064373630343035313830363034303031363034303532383036303136383135323630323030313735353336313636363537343739353437323631366537333631363337343639366636653531373536353735363536303530316238313532353036313032313035363562363032303832303135313630343038333031353136303032353434323930383330313131313538303631313137303537353036313131373033333631303362373536356236313131386335373630343035313632343631626364363065353162383135323630303430313631303430653930363132313961353635623630303638323930353536303037383139303535383335313630303435343630343035313630303139313630303039313631313162613931383739313837393138613931383839313838393136303230303136313166643935363562363034303830353136303166313938313834303330313831353239313930353238303531363032303930393130313230363030353830353436303031383130313832353536303030393139303931353237663033366236333834623565636137393163363237363131353264306337396262303630346331303461356662366634656230373033663331353462623364623030313831393035353630303438303534383430313930353539303530383531353631313235333537376665323730386565396436613839366535663332663665646336316263383331343361316238653366626466326130333863333530333639643235316166623139383136303430353136313132343639313930363132303730353635623630343035313830393130333930613136313062613635363562376632333736346665303539666235323538616234373538336461623937313734383135363962346639363331623462636337636238636632633739643164356332383136303430353136313132383239313930363132303730353635623630343035313830393130333930613135303530353035303530353035303536356236303430353138303630633030313630343035323830363030303831353236303230303136303030383135323630323030313630303031353135383135323630323030313630303038303139313638313532363032303031363030303831353236303230303136303030383135323530393035363562363034303531383036303630303136303430353238303630303038303139313638313532363032303031363030303831353236303230303136303030383135323530393035363562383033353631303239313831363132326539353635623830353136313032393138313631323265393536356236303030383236303166383330313132363131333139353736303030383066643562383133353631313332633631313332373832363132323131353635623631323165613536356239313530383138313833353236303230383430313933353036303230383130313930353038333835363032303834303238323031313131353631313335313537363030303830666435623630303035623833383131303135363131333764353738313631313336373838383236313133666135363562383435323530363032303932383330313932393139303931303139303630303130313631313335343536356235303530353035303932393135303530353635623630303038323630316638333031313236313133393835373630303038306664356238313335363131336136363131333237383236313232313135363562383138313532363032303933383430313933393039323530383230313833363030303562383338313130313536313133376435373831333538363031363131336365383838323631313431303536356238343532353036303230393238333031393239313930393130313930363030313031363131336238353635623830333536313032393138313631323330303536356238303531363130323931383136313233303035363562383033353631303239313831363132333039353635623830353136313032393138313631323330393536356236303030383236303166383330313132363131343231353736303030383066643562383133353631313432663631313332373832363132323332353635623931353038303832353236303230383330313630323038333031383538333833303131313135363131343462353736303030383066643562363131343536383338323834363132323863353635623530353035303932393135303530353635623630303036303630383238343033313231353631313437313537363030303830666435623631313437623630363036313231656135363562393035303630303036313134383938343834363131343035353635623832353235303630323036313134396138343834383330313631313430353536356236303230383330313532353036303430363131346165383438323835303136313134303535363562363034303833303135323530393239313530353035363562363030303630633038323834303331323135363131346363353736303030383066643562363131346436363063303631323165613536356239303530363030303631313465343834383436313133666135363562383235323530363032303631313466353834383438333031363131336661353635623630323038333031353235303630343036313135303938343832383530313631313365343536356236303430383330313532353036303630363131353164383438323835303136313133666135363562363036303833303135323530363038303631313533313834383238353031363131336661353635623630383038333031353235303630613036313135343538343832383530313631313366613536356236306130383330313532353039323931353035303536356236303030363130313230383238343033313231353631313536343537363030303830666435623631313536653630383036313231656135363562393035303630303036313135376338343834363131336661353635623832353235303630323036313135386438343834383330313631313462613536356236303230383330313532353036306530363131356131383438323835303136313133666135363562363034303833303135323530363130313030383230313335363766666666666666666666666666666666383131313135363131356332353736303030383066643562363131356365383438323835303136313133303835363562363036303833303135323530393239313530353035363562363030303630323038323834303331323135363131356563353736303030383066643562363030303631313566383834383436313132663235363562393439333530353035303530353635623630303036303230383238343033313231353631313631323537363030303830666435623630303036313135663838343834363131326664353635623630303038303630303038303630383038353837303331323135363131363334353736303030383066643562383433353637666666666666666666666666666666663831313131353631313634623537363030303830666435623631313635373837383238383031363131333837353635623934353035303630323036313136363838373832383830313631313366613536356239333530353036303430363131363739383738323838303136313133666135363562393235303530363036303631313638613837383238383031363131336661353635623931353035303932393539313934353039323530353635623630303036303230383238343033313231353631313661383537363030303830666435623630303036313135663838343834363131336566353635623630303036303230383238343033313231353631313663363537363030303830666435623630303036313135663838343834363131343035353635623630303038303630303036303630383438363033313231353631313665373537363030303830666435623833333536376666666666666666666666666666666638313131313536313136666535373630303038306664356236313137306138363832383730313631313431303536356239333530353036303230363131373162383638323837303136313133666135363562393235303530363034303834303133353637666666666666666666666666666666663831313131353631313733383537363030303830666435623631313734343836383238373031363131353531353635623931353035303932353039323530393235363562363030303630323038323834303331323135363131373630353736303030383066643562383133353637666666666666666666666666666666663831313131353631313737373537363030303830666435623631313566383834383238353031363131343130353635623630303036303630383238343033313231353631313739353537363030303830666435623630303036313135663838343834363131343566353635623630303036306330383238343033313231353631313762333537363030303830666435623630303036313135663838343834363131346261353635623630303036303230383238343033313231353631313764313537363030303830666435623630303036313135663838343834363131336661353635623630303036313137653938333833363131386564353635623530353036303230303139303536356236303030363130336230383338333631313930373536356236313138303638313631323236643536356238323532353035303536356236303030363131383137383236313232363035363562363131383231383138353631323236343536356239333530363131383263383336313232356135363562383036303030356238333831313031353631313835613537383135313631313834343838383236313137646435363562393735303631313834663833363132323561353635623932353035303630303130313631313833303536356235303934393539343530353035303530353035363562363030303631313837303832363132323630353635623631313837613831383536313232363435363562393335303833363032303832303238353031363131383863383536313232356135363562383036303030356238353831313031353631313863363537383438343033383935323831353136313138613938353832363131376631353635623934353036313138623438333631323235613536356236303230396139303961303139393932353035303630303130313631313839303536356235303931393739363530353035303530353035303530353635623631313830363831363132323738353635623631313830363631313865383832363132323738353635623631323263383536356236313138303638313631323237643536356236313138303636313139303238323631323237643536356236313232376435363562363030303631313931323832363132323630353635623631313931633831383536313232363435363562393335303631313932633831383536303230383630313631323239383536356236313139333538313631323264393536356239303933303139333932353035303530353635623630303036313139346336303463383336313232363435363562376634333631366536653666373432303733373536323664363937343230363132303632363137343633363832303737363937343638323036313230373337343631383135323766373237343733343137343534373834393665363436353738323036633635373337333230373436383631366532303633373536643735366336313734363937363630323038323031353236623635346537353664343536633635366436353665373437333630613031623630343038323031353236303630303139323931353035303536356236303030363131396330363033343833363132323634353635623766343336313665366536663734323037333735363236643639373432303631323036323631373436333638323037373639373436383230363132303734363936643831353237333635373337343631366437303230363936653230373436383635323036363735373437353732363536303630316236303230383230313532363034303031393239313530353035363562363030303631316131363630333938333631323236343536356237663464363537333733363136373635323037333635366536343635373232303634366636353733323036653666373432303638363137363635323037303635373238313532376636643639373337333639366636653230373436663230363137303730363536653634323036313230363236313734363336383030303030303030303030303030363032303832303135323630343030313932393135303530353635623630303036313161373536303236383336313232363435363562376635343639366436353733373436313664373037333230366437353733373432303664366636653666373436663665363936333631366336633739323036393665383135323635363337323635363137333635363064303162363032303832303135323630343030313932393135303530353635623630303036313161626436303532383336313232363435363562376634643735373337343230373037323666363336353733373332303666366336343635373232303463333135343666346333323531373536353735363532303632383135323766363137343633363836353733323036363639373237333734323037343666323036353665363636663732363336353230346635363464323037343639366436353630323038323031353237313733373436313664373032303664366636653666373436663665363936333639373437393630373031623630343038323031353236303630303139323931353035303536356236303030363131623337363035323833363132323634353635623766346437353733373432303730373236663633363537333733323036663663363436353732323035333631363636353734373935313735363537353635323036323831353237663631373436333638363537333230363636393732373337343230373436663230363536653636366637323633363532303466353634643230373436393664363536303230383230313532373137333734363136643730323036643666366536663734366636653639363336393734373936303730316236303430383230313532363036303031393239313530353035363562363030303631316262313630336438333631323236343536356237663464363537333733363136373635323037333635366536343635373232303634366636353733323036653666373432303638363137363635323037303635373238313532376636643639373337333639366636653230373436663230363137303730363536653634323036363732366636643230373137353635373536353733303030303030363032303832303135323630343030313932393135303530353635623630303036313163313036303365383336313232363435363562376634333631366536653666373432303631373037303635366536343230363637323666366432303731373536353735363537333230373537303230373436663230383135323766363936653634363537383230363236353633363137353733363532303731373536353735363537333230363137323635323036353664373037343739303030303630323038323031353236303430303139323931353035303536356236303030363131633666363035343833363132323634353635623766346437353733373432303730373236663633363537333733323036663663363436353732323035333631363636353734373935313735363537353635323036323831353237663631373436333638363537333230363636393732373337343230373436663230363536653636366637323633363532303466353634643230363236633666363336303230383230313532373336623465373536643632363537323230366436663665366637343666366536393633363937343739363036303162363034303832303135323630363030313932393135303530353635623630303036313163656236303163383336313232363435363562376634333631366536653666373432303733373536323664363937343230363136653230363536643730373437393230363236313734363336383030303030303030383135323630323030313932393135303530353635623630303036313164323436303331383336313232363435363562376635353730323037343666323036393665363436353738323037373631373332303635373836333635363536343635363432303639366532303631373037303635383135323730366536343639366536373230363637323666366432303731373536353735363537333630373831623630323038323031353236303430303139323931353035303536356236303030363131643737363035303833363132323634353635623766343336313665366536663734323037333735363236643639373432303631323036323631373436333638323037373639373436383230363132303734363936643831353237663635373337343631366437303230366636633634363537323230373436383631366532303734363836353230373336353731373536353665363336353732323036303230383230313532366631613562393864623164356364613562646238383163313935633961356264393630383231623630343038323031353236303630303139323931353035303536356236303030363131646566363033633833363132323634353635623766346436353733373336313637363532303733363536653634363537323230363436663635373332303665366637343230363836313736363532303730363537323831353237663664363937333733363936663665323037343666323036313730373036353665363432303734363836393733323036323631373436333638303030303030303036303230383230313532363034303031393239313530353035363562363030303631316534653630323838333631323236343536356237663432366336663633366234653735366436323635373237333230366437353733373432303664366636653666373436663665363936333631366336633739323038313532363736393665363337323635363137333635363063303162363032303832303135323630343030313932393135303530353635623630303036313165393836303534383336313232363435363562376634643735373337343230373037323666363336353733373332303666366336343635373232303463333135343666346333323531373536353735363532303632383135323766363137343633363836353733323036363639373237333734323037343666323036353665363636663732363336353230346635363464323036323663366636333630323038323031353237333662346537353664363236353732323036643666366536663734366636653639363336393734373936303630316236303430383230313532363036303031393239313530353035363562363030303631316631343630333638333631323236343536356237663433363136653665366637343230373337353632366436393734323036313230363236313734363336383230373736393734363832303631323036323663366638313532373536333662346537353664363236353732323036393665323037343638363532303636373537343735373236353630353031623630323038323031353236303430303139323931353035303536356236303030363131663663363035323833363132323634353635623766343336313665366536663734323037333735363236643639373432303631323036323631373436333638323037373639373436383230363132303632366336663831353237663633366234653735366436323635373232303666366336343635373232303734363836313665323037343638363532303733363537313735363536653633363536303230383230313532373131633838316135623938646231643563646135626462383831633139356339613562643936303732316236303430383230313532363036303031393239313530353035363562363030303631316665353832383936313138663635363562363032303832303139313530363131666635383238383631313866363536356236303230383230313931353036313230303538323837363131386463353635623630303138323031393135303631323031353832383636313138663635363562363032303832303139313530363132303235383238353631313866363536356236303230383230313931353036313230333538323834363131386636353635623530363032303031393639353530353035303530353035303536356236303230383130313631303239313832383436313137666435363562363032303830383235323831303136313033623038313834363131383635353635623630323038313031363130323931383238343631313864333536356236303230383130313631303239313832383436313138656435363562363038303831303136313230386338323837363131386564353635623831383130333630323038333031353236313230396538313836363131393037353635623930353036313230616436303430383330313835363131386564353635623831383130333630363038333031353236313230626638313834363131383063353635623936393535303530353035303530353035363562363032303830383235323831303136313033623038313834363131393037353635623630323038303832353238313031363130323931383136313139336635363562363032303830383235323831303136313032393138313631313962333536356236303230383038323532383130313631303239313831363131613039353635623630323038303832353238313031363130323931383136313161363835363562363032303830383235323831303136313032393138313631316162303536356236303230383038323532383130313631303239313831363131623261353635623630323038303832353238313031363130323931383136313162613435363562363032303830383235323831303136313032393138313631316330333536356236303230383038323532383130313631303239313831363131633632353635623630323038303832353238313031363130323931383136313163646535363562363032303830383235323831303136313032393138313631316431373536356236303230383038323532383130313631303239313831363131643661353635623630323038303832353238313031363130323931383136313164653235363562363032303830383235323831303136313032393138313631316534313536356236303230383038323532383130313631303239313831363131653862353635623630323038303832353238313031363130323931383136313166303735363562363032303830383235323831303136313032393138313631316635663536356236303430353138313831303136376666666666666666666666666666666638313131383238323130313731353631323230393537363030303830666435623630343035323931393035303536356236303030363766666666666666666666666666666666383231313135363132323238353736303030383066643562353036303230393038313032303139303536356236303030363766666666666666666666666666666666383231313135363132323439353736303030383066643562353036303230363031663931393039313031363031663139313630313930353635623630323030313930353635623531393035363562393038313532363032303031393035363562363030303631303239313832363132323830353635623135313539303536356239303536356236303031363030313630613031623033313639303536356238323831383333373530363030303931303135323536356236303030356238333831313031353631323262333537383138313031353138333832303135323630323030313631323239623536356238333831313131353631323263323537363030303834383430313532356235303530353035303536356236303030363130323931383236303030363130323931383236313232653335363562363031663031363031663139313639303536356236306638316239303536356236313232663238313631323236643536356238313134363132326664353736303030383066643562353035363562363132326632383136313232373835363562363132326632383136313232376435366665613336353632376137613732333135383230346531386232653438633465303134656435663065346536303139393830633336646330643564316439383762613765313065373366303332663730363430653663363537383730363537323639366436353665373436313663663536343733366636633633343330303035306630303430222c2273746f72616765223a7b22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030223a22307830303030303030303030303030303030303030303030303030303030303030304445614430303043222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303031223a2236336663326164336430323161346437653634333233353239613535613934343263343434646130222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303032223a2230323538222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303033223a223265227d7d2c22307830303030303030303030303030303030303030303030303030303030303030306465616430303035223a7b2262616c616e6365223a2230222c226e6f6e6365223a312c22726f6f74223a2235366538316631373162636335356136666638333435653639326330663836653562343865303162393936636164633030313632326662356533363362343231222c22636f646548617368223a2232646466613235623638376438653031643536633930383261323134393665323737383338626235303635393031303530363465383033306231306637313062222c22636f6465223a2236303830363034303532333438303135363130303130353736303030383066643562353036303034333631303631303033363537363030303335363065303163383036333262313439643136313436313030333835373830363365653663383934623134363130303537353735626665356236313030353536303034383033363033363032303831313031353631303034653537363030303830666435623530333536313030373435363562303035623631303035353630303438303336303336303230383131303135363130303664353736303030383066643562353033353631303063383536356236313039643238313131363130306234353736303430353136323436316263643630653531623831353236303034303138303830363032303031383238313033383235323630326538313532363032303031383036313031316436303265393133393630343030313931353035303630343035313830393130333930666435623631303964313139383130313630303038303830383038303330383666313530353035303536356236313033623338313131363130313038353736303430353136323436316263643630653531623831353236303034303138303830363032303031383238313033383235323630326538313532363032303031383036313031316436303265393133393630343030313931353035303630343035313830393130333930666435623631303362323139383130313630303038303830383038303330383666313530353035303536666535353665363136323663363532303734366632303633366636653733373536643635323036313665323036313664366637353665373432303666363632303637363137333230373436383639373332303733366436313663366332656132363536323761376137323331353832303432386231303462356137353532363062646264323537656361386432313232663134316438383066373266663664336638383138346230353963616462303036343733366636633633343330303035306630303332227d2c22307830303030303030303030303030303030303030303030303030303030303030306465616430303036223a7b2262616c616e6365223a2230222c226e6f6e6365223a312c22726f6f74223a2239633734613933306535666530666434323035326165363234383566633165393864633366393462346137323464623031643734396231633630393065343331222c22636f646548617368223a2230623034386161323831663636353166366536666639613530373639616138343065383735326164313063313834613338666362366163343831666634663230222c22636f6465223a223630383036303430353233343830313536323030303031313537363030303830666435623530363030343336313036323030303035653537363030303335363065303163383036333233326364656536313436323030303036333537383036333533613834616132313436323030303039323537383036336332386666336162313436323030303062383537383036336537646263643964313436323030303064313537383036336538313939663061313436323030303065383537356236303030383066643562363230303030376136323030303037343336363030343632303031333061353635623632303030313065353635623630343035313632303030303839393139303632303031396162353635623630343035313830393130333930663335623632303030306139363230303030613333363630303436323030313334333536356236323030303139643536356236303430353136323030303038393931393036323030316137383536356236323030303063663632303030306339333636303034363230303134353535363562363230303031623835363562303035623632303030306366363230303030653233363630303436323030313361333536356236323030303262393536356236323030303066663632303030306639333636303034363230303133363435363562363230303035366335363562363034303531363230303030383939313930363230303139663835363562363030303830353436303430353136336266343066616331363065303162383135323630303136303031363061303162303339303931313639303633626634306661633139303632303030313431393038353930363030343031363230303161383835363562363032303630343035313830383330333831383638303362313538303135363230303031356135373630303038306664356235303561666131353830313536323030303136663537336436303030383033653364363030306664356235303530353035303630343035313364363031663139363031663832303131363832303138303630343035323530363230303031393539313930383130313930363230303132613735363562393035303562393139303530353635623630303136303230353236303030393038313532363034303930323035343630303136303031363061303162303331363831353635623632303030316334383538353632303030353663353635623135363230303031643035373632303030326232353635623632303030316464383438363835363230303036313135363562363230303032303535373630343035313632343631626364363065353162383135323630303430313632303030316663393036323030316164313536356236303430353138303931303339306664356236323030303231323832383638333632303030366432353635623632303030323331353736303430353136323436316263643630653531623831353236303034303136323030303166633930363230303161396235363562363030303534363030313630303136306130316230333136383538353632303030323461383536323030303731643536356236303430353136323030303235383930363230303065346635363562363230303032363739343933393239313930363230303139626235363562363034303531383039313033393036303030663038303135383031353632303030323834353733643630303038303365336436303030666435623530363030303836383135323630303136303230353236303430393032303830353436303031363030313630613031623033313931363630303136303031363061303162303339323930393231363931393039313137393035353562353035303530353035303536356236303030383538313532363030313630323039303831353236303430393138323930323035343832353136333539376430653466363065313162383135323932353136303031363030313630613031623033393039313136393238333932363362326661316339653932363030343830383430313933383239303033303138313836383033623135383031353632303030333039353736303030383066643562353035616661313538303135363230303033316535373364363030303830336533643630303066643562353035303530353036303430353133643630316631393630316638323031313638323031383036303430353235303632303030333434393139303831303139303632303031326338353635623632303030333633353736303430353136323436316263643630653531623831353236303034303136323030303166633930363230303161663535363562383036303031363030313630613031623033313636333964346361383834363034303531383136336666666666666666313636306530316238313532363030343031363032303630343035313830383330333831383638303362313538303135363230303033396435373630303038306664356235303561666131353830313536323030303362323537336436303030383033653364363030306664356235303530353035303630343035313364363031663139363031663832303131363832303138303630343035323530363230303033643839313930383130313930363230303132653935363562383531343632303030336639353736303430353136323436316263643630653531623831353236303034303136323030303166633930363230303161616435363562363230303034303638353837383636323030303631313536356236323030303432353537363034303531363234363162636436306535316238313532363030343031363230303031666339303632303031616431353635623632303030343335383338373630303130313834363230303036313135363562363230303034353435373630343035313632343631626364363065353162383135323630303430313632303030316663393036323030316162663536356238303630303136303031363061303162303331363633393538386563613236303430353138313633666666666666666631363630653031623831353236303034303136303230363034303531383038333033383138363830336231353830313536323030303438653537363030303830666435623530356166613135383031353632303030346133353733643630303038303365336436303030666435623530353035303530363034303531336436303166313936303166383230313136383230313830363034303532353036323030303463393931393038313031393036323030313265393536356238333134313536323030303465623537363034303531363234363162636436306535316238313532363030343031363230303031666339303632303031616533353635623630303036323030303466373632303030373362353635623833353136303230383530313531363034303531363333333136396236333630653031623831353239323933353036303031363030313630613031623033383431363932363333333136396236333932363230303035326639323930393136303034303136323030316230373536356236303030363034303531383038333033383136303030383738303362313538303135363230303035346135373630303038306664356235303561663131353830313536323030303535663537336436303030383033653364363030306664356235303530353035303530353035303530353035303530353635623630303038323831353236303031363032303532363034303831323035343630303136303031363061303162303331363830313538303135393036323030303630373537353038323831363030313630303136306130316230333136363339643463613838343630343035313831363366666666666666663136363065303162383135323630303430313630323036303430353138303833303338313836383033623135383031353632303030356361353736303030383066643562353035616661313538303135363230303035646635373364363030303830336533643630303066643562353035303530353036303430353133643630316631393630316638323031313638323031383036303430353235303632303030363035393139303831303139303632303031326539353635623134356239313530353035623932393135303530353635623630303038303632303030363165363230303037336235363562393035303830363030313630303136306130316230333136363362383539333230633836363034303531363032303031363230303036343239313930363230303139393435363562363034303531363032303831383330333033383135323930363034303532383638363630343035313834363366666666666666663136363065303162383135323630303430313632303030363733393339323931393036323030316130383536356236303230363034303531383038333033383138363830336231353830313536323030303638633537363030303830666435623530356166613135383031353632303030366131353733643630303038303365336436303030666435623530353035303530363034303531336436303166313936303166383230313136383230313830363034303532353036323030303663373931393038313031393036323030313263383536356239313530353035623933393235303530353035363562363030303830363230303036646636323030303737393536356239303530383036303031363030313630613031623033313636333636646565313963363230303036666238373632303030376262353635623836383636303430353138343633666666666666666631363630653031623831353236303034303136323030303637333933393239313930363230303161343035363562363030303630363036323030303732633833363230303037626235363562383035313630323039303931303132303933393235303530353035363562363030303632303030373734363034303531383036303430303136303430353238303630313438313532363032303031373332396261333062613332613162376236623662346261333662326237336132316234333062346237363036313162383135323530363230303031306535363562393035303930353635623630303036323030303737343630343035313830363034303031363034303532383036303139383135323630323030313766343336313665366636653639363336313663353437323631366537333631363337343639366636653433363836313639366530303030303030303030303030303831353235303632303030313065353635623630343038303531363030383830383235323631303132303832303139303932353236303630393138323931393038313630323030313562363036303831353236303230303139303630303139303033393038313632303030376437353735303530383335313930393135303632303030376663393036323030303933393536356238313630303038313531383131303632303030383061353766653562363032303032363032303031303138313930353235303632303030383234383336303430303135313632303030393339353635623831363030313831353138313130363230303038333235376665356236303230303236303230303130313831393035323530363230303038346338333630363030313531363230303039353035363562383136303032383135313831313036323030303835613537666535623630323030323630323030313031383139303532353036323030303837343833363038303031353136323030303937303536356238313630303338313531383131303632303030383832353766653562363032303032363032303031303138313930353235303632303030383963383336306130303135313632303030393530353635623831363030343831353138313130363230303038616135376665356236303230303236303230303130313831393035323530363230303038633438333630633030313531363230303039353035363562383136303035383135313831313036323030303864323537666535623630323030323630323030313031383139303532353036323030303865633833363065303031353136323030303933393536356238313630303638313531383131303632303030386661353766653562363032303032363032303031303138313930353235303632303030393135383336313031303030313531363230303039633135363562383136303037383135313831313036323030303932333537666535623630323030323630323030313031383139303532353036323030303663623831363230303061323735363562363036303632303030313935363230303039346138333632303030613530353635623632303030393730353635623630343038303531363030353630613231623833313836303134383230313532363033343831303139303931353236303630393036323030303663623831356236303630383038323531363030313134383031353632303030393961353735303630383038333630303038313531383131303632303030393930353766653562303136303230303135313630663831633130356231353632303030396138353735303831363230303031393535363562363230303036636236323030303962613834353136303830363230303062346335363562383436323030306339323536356236303430383035313630303138303832353238313833303139303932353236303630393138323931393036303230383230313831383033383833333930313930353035303930353038323632303030396636353736303031363066663162363230303039666335363562363030313630663831623562383136303030383135313831313036323030306130613537666535623630323030313031393036303031363030313630663831623033313931363930383136303030316139303533353039323931353035303536356236303630383036323030306133353833363230303064313335363562393035303632303030366362363230303061343938323531363063303632303030623463353635623832363230303063393235363562363034303830353136303230383038323532383138333031393039323532363036303931383239313930363032303832303138313830333838333339353035303530363032303831303138343930353239303530363030303562363032303831313031353632303030616238353738313831383135313831313036323030306139343537666535623031363032303031353136303031363030313630663831623033313931363135363230303061616635373632303030616238353635623630303130313632303030613764353635623630363038313630323030333630343035313930383038323532383036303166303136303166313931363630323030313832303136303430353238303135363230303061653935373630323038323031383138303338383333393031393035303562353039303530363030303562383135313831313031353632303030623433353738333531363030313834303139333835393138313130363230303062306235376665356236303230303130313531363066383163363066383162383238323831353138313130363230303062323335376665356236303230303130313930363030313630303136306638316230333139313639303831363030303161393035333530363030313031363230303061656635363562353039343933353035303530353035363562363036303830363033383834313031353632303030626139353736303430383035313630303138303832353238313833303139303932353239303630323038323031383138303338383333393031393035303530393035303832383430313630663831623831363030303831353138313130363230303062386335376665356236303230303130313930363030313630303136306638316230333139313639303831363030303161393035333530363230303036636235363562363030303630303135623830383638313632303030626238353766653562303431353632303030626366353736303031393039313031393036313031303030323632303030626165353635623831363030313031363034303531393038303832353238303630316630313630316631393136363032303031383230313630343035323830313536323030306266653537363032303832303138313830333838333339303139303530356235303932353038343832303136303337303136306638316238333630303038313531383131303632303030633138353766653562363032303031303139303630303136303031363066383162303331393136393038313630303031613930353335303630303139303530356238313831313136323030306338393537363130313030383138333033363130313030306138373831363230303063346635376665356230343831363230303063353835376665356230363630663831623833383238313531383131303632303030633639353766653562363032303031303139303630303136303031363066383162303331393136393038313630303031613930353335303630303130313632303030633334353635623530353039333932353035303530353635623630363038303630343035313930353038333531383038323532363032303832303138313831303136303230383730313562383138333130313536323030306363353537383035313833353236303230393238333031393230313632303030636162353635623530383535313834353138313031383535323932353039303530383038323031363032303836303135623831383331303135363230303063663435373830353138333532363032303932383330313932303136323030306364613536356235303836353139323930393230313135393139303931303136303166303136303166313931363630343035323530393035303932393135303530353635623630363038313531363030303134313536323030306433363537353036303430383035313630303038313532363032303831303139303931353236323030303139383536356236303030383035623833353138313130313536323030306436633537383338313831353138313130363230303064353135376665356236303230303236303230303130313531353138323031393135303830383036303031303139313530353036323030306433613536356236303630383236303430353139303830383235323830363031663031363031663139313636303230303138323031363034303532383031353632303030643961353736303230383230313831383033383833333930313930353035623530363030303932353039303530363032303831303135623835353138333130313536323030306234333537363036303836383438313531383131303632303030646266353766653562363032303032363032303031303135313930353036303030363032303832303139303530363230303064646638333832383435313632303030653039353635623837383538313531383131303632303030646563353766653562363032303032363032303031303135313531383330313932353035303530383238303630303130313933353035303632303030646136353635623832383238323562363032303831313036323030306532653537383135313833353236303230393238333031393239303931303139303630316631393031363230303065306435363562393035313832353136303230393239303932303336313031303030613630303031393031383031393930393131363931313631373930353235303530353035363562363130613162383036323030316335303833333930313930353635623830333536323030303630623831363230303163316635363562383035313632303030363062383136323030316331663536356236303030383236303166383330313132363230303065383935373630303038306664356238313335363230303065613036323030306539613832363230303162346535363562363230303162323635363562393135303831383138333532363032303834303139333530363032303831303139303530383338353630323038343032383230313131313536323030306563363537363030303830666435623630303035623833383131303135363230303065663635373831363230303065646638383832363230303066316135363562383435323530363032303932383330313932393139303931303139303630303130313632303030656339353635623530353035303530393239313530353035363562383033353632303030363062383136323030316333393536356238303531363230303036306238313632303031633339353635623830333536323030303630623831363230303163343435363562383035313632303030363062383136323030316334343536356236303030383236303166383330313132363230303066343635373630303038306664356238313335363230303066353736323030306539613832363230303162373035363562393135303830383235323630323038333031363032303833303138353833383330313131313536323030306637343537363030303830666435623632303030663831383338323834363230303162646135363562353035303530393239313530353035363562363030303631303132303832383430333132313536323030306639653537363030303830666435623632303030666162363130313230363230303162323635363562393035303630303036323030306662623834383436323030306631613536356238323532353036303230363230303066636538343834383330313632303030663161353635623630323038333031353235303630343036323030306665343834383238353031363230303066316135363562363034303833303135323530363036303632303030666661383438323835303136323030306535643536356236303630383330313532353036303830383230313335363766666666666666666666666666666666383131313135363230303130316235373630303038306664356236323030313032393834383238353031363230303066333435363562363038303833303135323530363061303632303031303366383438323835303136323030306535643536356236306130383330313532353036306330363230303130353538343832383530313632303030653564353635623630633038333031353235303630653036323030313036623834383238353031363230303066316135363562363065303833303135323530363130313030363230303130383238343832383530313632303030663030353635623631303130303833303135323530393239313530353035363562363030303630363038323834303331323135363230303130613235373630303038306664356236323030313061653630363036323030316232363536356239303530363030303632303031306265383438343632303030663161353635623832353235303630323036323030313064313834383438333031363230303066316135363562363032303833303135323530363034303632303031306537383438323835303136323030306631613536356236303430383330313532353039323931353035303536356236303030363063303832383430333132313536323030313130363537363030303830666435623632303031313132363038303632303031623236353635623930353036303030363230303131323238343834363230303066316135363562383235323530363032303632303031313335383438343833303136323030313038663536356236303230383330313532353036303830363230303131346238343832383530313632303030663161353635623630343038333031353235303630613038323031333536376666666666666666666666666666666638313131313536323030313136633537363030303830666435623632303031313761383438323835303136323030306537373536356236303630383330313532353039323931353035303536356236303030363063303832383430333132313536323030313139393537363030303830666435623632303031316135363063303632303031623236353635623930353036303030363230303131623538343834363230303066316135363562383235323530363032303632303031316338383438343833303136323030306631613536356236303230383330313532353036303430363230303131646538343832383530313632303030663030353635623630343038333031353235303630363036323030313166343834383238353031363230303066316135363562363036303833303135323530363038303632303031323061383438323835303136323030306631613536356236303830383330313532353036306130363230303132323038343832383530313632303030663161353635623630613038333031353235303932393135303530353635623630303036313031323038323834303331323135363230303132343035373630303038306664356236323030313234633630383036323030316232363536356239303530363030303632303031323563383438343632303030663161353635623832353235303630323036323030313236663834383438333031363230303131383635363562363032303833303135323530363065303632303031323835383438323835303136323030306631613536356236303430383330313532353036313031303038323031333536376666666666666666666666666666666638313131313536323030313136633537363030303830666435623630303036303230383238343033313231353632303031326261353736303030383066643562363030303632303030363037383438343632303030653661353635623630303036303230383238343033313231353632303031326462353736303030383066643562363030303632303030363037383438343632303030663064353635623630303036303230383238343033313231353632303031326663353736303030383066643562363030303632303030363037383438343632303030663237353635623630303036303230383238343033313231353632303031333164353736303030383066643562383133353637666666666666666666666666666666663831313131353632303031333335353736303030383066643562363230303036303738343832383530313632303030663334353635623630303036303230383238343033313231353632303031333536353736303030383066643562363030303632303030363037383438343632303030663161353635623630303038303630343038333835303331323135363230303133373835373630303038306664356236303030363230303133383638353835363230303066316135363562393235303530363032303632303031333939383538323836303136323030306631613536356239313530353039323530393239303530353635623630303038303630303038303630303036306130383638383033313231353632303031336263353736303030383066643562363030303632303031336361383838383632303030663161353635623935353035303630323036323030313364643838383238393031363230303066316135363562393435303530363034303836303133353637666666666666666666666666666666663831313131353632303031336662353736303030383066643562363230303134303938383832383930313632303031306633353635623933353035303630363036323030313431633838383238393031363230303066316135363562393235303530363038303836303133353637666666666666666666666666666666663831313131353632303031343361353736303030383066643562363230303134343838383832383930313632303031306633353635623931353035303932393535303932393539303933353035363562363030303830363030303830363030303630613038363838303331323135363230303134366535373630303038306664356236303030363230303134376338383838363230303066316135363562393535303530363032303632303031343866383838323839303136323030306631613536356239343530353036303430383630313335363766666666666666666666666666666666383131313135363230303134616435373630303038306664356236323030313462623838383238393031363230303130663335363562393335303530363036303836303133353637666666666666666666666666666666663831313131353632303031346439353736303030383066643562363230303134653738383832383930313632303030663861353635623932353035303630383038363031333536376666666666666666666666666666666638313131313536323030313530353537363030303830666435623632303031343438383838323839303136323030313232633536356236303030363230303135323138333833363230303135616135363562353035303630323030313930353635623632303031353334383136323030316261633536356238323532353035303536356236303030363230303135343738323632303031623966353635623632303031353533383138353632303031626133353635623933353036323030313536303833363230303162393935363562383036303030356238333831313031353632303031353934353738313531363230303135376238383832363230303135313335363562393735303632303031353838383336323030316239393536356239323530353036303031303136323030313536343536356235303934393539343530353035303530353035363562363230303135333438313632303031626239353635623632303031353334383136323030316262653536356236323030313533343632303031356334383236323030316262653536356236323030316262653536356236303030363230303135643738323632303031623966353635623632303031356533383138353632303031626133353635623933353036323030313566353831383536303230383630313632303031626536353635623632303031363030383136323030316331353536356239303933303139333932353035303530353635623632303031353334383136323030316263643536356236303030363230303136323436303235383336323030316261333536356237663530373236663736363936343635363432303734373236313665373336313633373436393666366532303634363137343631323036393733323036393665373638313532363433306236333462323137363064393162363032303832303135323630343030313932393135303530353635623630303036323030313636643630333938333632303031626133353635623766353037323666373636393634363536343230373037323635326437333734363137343635323037323666366637343230363436663635373332303665366637343831353237663230366436313734363336383230353337343631373436353534373236313665373336393734363936663665363537323265303030303030303030303030303036303230383230313532363034303031393239313530353035363562363030303632303031366365363033343833363230303162613335363562376635303732366637363639363436353634323037303666373337343264373337343631373436353230373236663666373432303639366536333663373537333639383135323733333762373130333833393337623762333130333462393930333462373362333062363334623231373630363131623630323038323031353236303430303139323931353035303536356236303030363230303137323636303333383336323030316261333536356237663530373236663736363936343635363432303730373236353264373337343631373436353230373236663666373432303639366536333663373537333639366638313532373233373130333833393337623762333130333462393930333462373362333062363334623231373630363931623630323038323031353236303430303139323931353035303536356236303030363230303137376436303330383336323030316261333536356237663533373436313734363532303734373236313665373336393734363936663665323036383631373332303665366637343230363236353635366532303730373238313532366633376262333262373130333333393330626162323361623633326237336131373630383131623630323038323031353236303430303139323931353035303536356236303030363230303137643136303330383336323030316261333536356237663533373436313734363532303734373236313665373336393734363936663665323037303732366636333635373337333230363836313733323036653666373438313532366631303331333262326237313033316237623662383336333262613332623231373630383131623630323038323031353236303430303139323931353035303536356238303531363036303833303139303632303031383239383438323632303031356161353635623530363032303832303135313632303031383365363032303835303138323632303031356161353635623530363034303832303135313632303031383533363034303835303138323632303031356161353635623530353035303530353635623830353136303030393036306330383430313930363230303138366638353832363230303135616135363562353036303230383330313531363230303138383436303230383630313832363230303138313635363562353036303430383330313531363230303138393936303830383630313832363230303135616135363562353036303630383330313531383438323033363061303836303135323632303031386233383238323632303031353361353635623935393435303530353035303530353635623830353136306330383330313930363230303138636638343832363230303135616135363562353036303230383230313531363230303138653436303230383530313832363230303135616135363562353036303430383230313531363230303138663936303430383530313832363230303135396635363562353036303630383230313531363230303139306536303630383530313832363230303135616135363562353036303830383230313531363230303139323336303830383530313832363230303135616135363562353036306130383230313531363230303138353336306130383530313832363230303135616135363562383035313630303039303631303132303834303139303632303031393466383538323632303031356161353635623530363032303833303135313632303031393634363032303836303138323632303031386263353635623530363034303833303135313632303031393739363065303836303138323632303031356161353635623530363036303833303135313834383230333631303130303836303135323632303031386233383238323632303031353361353635623630303036323030313961323832383436323030313562353536356235303630323030313931393035303536356236303230383130313632303030363062383238343632303031353239353635623630383038313031363230303139636238323837363230303135323935363562363230303139646136303230383330313836363230303135616135363562363230303139653936303430383330313835363230303135616135363562363230303138623336303630383330313834363230303135616135363562363032303831303136323030303630623832383436323030313539663536356236303630383038323532383130313632303031613162383138363632303031356361353635623930353036323030316132633630323038333031383536323030313561613536356238313831303336303430383330313532363230303138623338313834363230303138353935363562363036303830383235323831303136323030316135333831383636323030313563613536356239303530363230303161363436303230383330313835363230303135616135363562383138313033363034303833303135323632303031386233383138343632303031393338353635623630323038313031363230303036306238323834363230303136306135363562363032303830383235323831303136323030303663623831383436323030313563613536356236303230383038323532383130313632303030313935383136323030313631353536356236303230383038323532383130313632303030313935383136323030313635653536356236303230383038323532383130313632303030313935383136323030313662663536356236303230383038323532383130313632303030313935383136323030313731373536356236303230383038323532383130313632303030313935383136323030313736653536356236303230383038323532383130313632303030313935383136323030313763323536356236303830383130313632303031623137383238353632303031356161353635623632303030366362363032303833303138343632303031383136353635623630343035313831383130313637666666666666666666666666666666663831313138323832313031373135363230303162343635373630303038306664356236303430353239313930353035363562363030303637666666666666666666666666666666663832313131353632303031623636353736303030383066643562353036303230393038313032303139303536356236303030363766666666666666666666666666666666383231313135363230303162383835373630303038306664356235303630323036303166393139303931303136303166313931363031393035363562363032303031393035363562353139303536356239303831353236303230303139303536356236303030363230303031393538323632303031626331353635623135313539303536356239303536356236303031363030313630613031623033313639303536356236303030363230303031393538323632303031626163353635623832383138333337353036303030393130313532353635623630303035623833383131303135363230303163303335373831383130313531383338323031353236303230303136323030316265393536356238333831313131353632303031383533353735303530363030303931303135323536356236303166303136303166313931363930353635623632303031633261383136323030316261633536356238313134363230303163333635373630303038306664356235303536356236323030316332613831363230303162623935363562363230303163326138313632303031626265353666653630383036303430353233343830313536313030313035373630303038306664356235303630343035313631306131623338303338303631306131623833333938313031363034303831393035323631303032663931363130303939353635623630303238303534363030333934393039343535363030343833393035353630303539323930393235353630303635353630303136303031363061303162303331393931383231363630303136303031363061303162303339333930393331363932393039323137363066663630613031623139313639303931353536303037383035343930393131363333313739303535363130313334353635623830353136313030383838313631303131343536356239323931353035303536356238303531363130303838383136313031326235363562363030303830363030303830363038303835383730333132313536313030616635373630303038306664356236303030363130306262383738373631303037643536356239343530353036303230363130306363383738323838303136313030386535363562393335303530363034303631303064643837383238383031363130303865353635623932353035303630363036313030656538373832383830313631303038653536356239313530353039323935393139343530393235303536356236303030363130303838383236313031303835363562393035363562363030313630303136306130316230333136393035363562363130313164383136313030666135363562383131343631303132383537363030303830666435623530353635623631303131643831363130313035353635623631303864383830363130313433363030303339363030306633666536303830363034303532333438303135363130303130353736303030383066643562353036303034333631303631303066353537363030303335363065303163383036333964346361383834313136313030393735373830363364333037623734333131363130303636353738303633643330376237343331343631303163613537383036336530636463366632313436313031646435373830363365373434346236343134363130316630353738303633663866393636333731343631303166383537363130306635353635623830363339643463613838343134363130313964353738303633613234343331366131343631303161353537383036336232666131633965313436313031616435373830363363653265306363343134363130316332353736313030663535363562383036333632373165316537313136313030643335373830363336323731653165373134363130313464353738303633366239323265646331343631303136323537383036333830633236333039313436313031373535373830363339353838656361323134363130313838353736313030663535363562383036333233326364656536313436313030666135373830363333326432396135613134363130313233353738303633356330623263643331343631303133383537356236303030383066643562363130313064363130313038333636303034363130356563353635623631303230363536356236303430353136313031316139313930363130373261353635623630343035313830393130333930663335623631303133363631303133313336363030343631303638613536356236313032386635363562303035623631303134303631303239323536356236303430353136313031316139313930363130373534353635623631303135353631303261313536356236303430353136313031316139313930363130373632353635623631303133363631303137303336363030343631303632313536356236313032623135363562363130313336363130313833333636303034363130353239353635623631303262353536356236313031393036313032626335363562363034303531363130313161393139303631303734363536356236313031393036313032633235363562363130313336363130326338353635623631303162353631303264643536356236303430353136313031316139313930363130373338353635623631303139303631303266653536356236313031333636313031643833363630303436313035636535363562363130333034353635623631303133363631303165623336363030343631303462303536356236313033303935363562363130313930363130333066353635623631303133363631303133313336363030343631303565633536356236303032353436303430353136336266343066616331363065303162383135323630303039313630303136303031363061303162303331363930363362663430666163313930363130323337393038353930363030343031363130373730353635623630323036303430353138303833303338313836383033623135383031353631303234663537363030303830666435623530356166613135383031353631303236333537336436303030383033653364363030306664356235303530353035303630343035313364363031663139363031663832303131363832303138303630343035323530363130323837393139303831303139303631303438613536356239303530356239313930353035363562353035363562363030373534363030313630303136306130316230333136383135363562363030323534363030313630613031623930303436306666313638313536356235303530353635623530353035303530353035363562363030353534383135363562363030343534383135363562363030323830353436306666363061303162313931363630303136306131316231373930353535363562363030303630303238303830353436303031363061303162393030343630666631363930383131313135363130326638353766653562313439303530393035363562363030333534383135363562363030353535353635623530353035303530353635623630303635343831353635623830333536313033323038313631303836663536356239323931353035303536356238303531363130333230383136313038366635363562383033353631303332303831363130383833353635623830333536313033323038313631303838633536356236303030383236303166383330313132363130333538353736303030383066643562383133353631303336623631303336363832363130376166353635623631303738383536356239313530383038323532363032303833303136303230383330313835383338333031313131353631303338373537363030303830666435623631303339323833383238343631303832333536356235303530353039323931353035303536356236303030363130313230383238343033313231353631303361653537363030303830666435623631303362393631303132303631303738383536356239303530363030303631303363373834383436313033336335363562383235323530363032303631303364383834383438333031363130333363353635623630323038333031353235303630343036313033656338343832383530313631303333633536356236303430383330313532353036303630363130343030383438323835303136313033313535363562363036303833303135323530363038303832303133353637666666666666666666666666666666663831313131353631303432303537363030303830666435623631303432633834383238353031363130333437353635623630383038333031353235303630613036313034343038343832383530313631303331353536356236306130383330313532353036306330363130343534383438323835303136313033313535363562363063303833303135323530363065303631303436383834383238353031363130333363353635623630653038333031353235303631303130303631303437643834383238353031363130333331353635623631303130303833303135323530393239313530353035363562363030303630323038323834303331323135363130343963353736303030383066643562363030303631303461383834383436313033323635363562393439333530353035303530353635623630303038303630303038303630383038353837303331323135363130346336353736303030383066643562363030303631303464323837383736313033313535363562393435303530363032303631303465333837383238383031363130333135353635623933353035303630343036313034663438373832383830313631303333633536356239323530353036303630383530313335363766666666666666666666666666666666383131313135363130353131353736303030383066643562363130353164383738323838303136313033343735363562393135303530393239353931393435303932353035363562363030303830363030303830363030303630613038363838303331323135363130353431353736303030383066643562363030303631303534643838383836313033313535363562393535303530363032303631303535653838383238393031363130333363353635623934353035303630343036313035366638383832383930313631303333633536356239333530353036303630383630313335363766666666666666666666666666666666383131313135363130353863353736303030383066643562363130353938383838323839303136313033343735363562393235303530363038303836303133353637666666666666666666666666666666663831313131353631303562353537363030303830666435623631303563313838383238393031363130333437353635623931353035303932393535303932393539303933353035363562363030303630323038323834303331323135363130356530353736303030383066643562363030303631303461383834383436313033336335363562363030303630323038323834303331323135363130356665353736303030383066643562383133353637666666666666666666666666666666663831313131353631303631353537363030303830666435623631303461383834383238353031363130333437353635623630303038303630343038333835303331323135363130363334353736303030383066643562383233353637666666666666666666666666666666663831313131353631303634623537363030303830666435623631303635373835383238363031363130333437353635623932353035303630323038333031333536376666666666666666666666666666666638313131313536313036373435373630303038306664356236313036383038353832383630313631303334373536356239313530353039323530393239303530353635623630303036303230383238343033313231353631303639633537363030303830666435623831333536376666666666666666666666666666666638313131313536313036623335373630303038306664356236313034613838343832383530313631303339623536356236313036633838313631303765343536356238323532353035303536356236313036633838313631303765663536356236313036633838313631303766343536356236313036633838313631303830643536356236313036633838313631303831383536356236303030363130366664383236313037643735363562363130373037383138353631303764623536356239333530363130373137383138353630323038363031363130383266353635623631303732303831363130383562353635623930393330313933393235303530353035363562363032303831303136313033323038323834363130366266353635623630323038313031363130333230383238343631303663653536356236303230383130313631303332303832383436313036643735363562363032303831303136313033323038323834363130366530353635623630323038313031363130333230383238343631303665393536356236303230383038323532383130313631303738313831383436313036663235363562393339323530353035303536356236303430353138313831303136376666666666666666666666666666666638313131383238323130313731353631303761373537363030303830666435623630343035323931393035303536356236303030363766666666666666666666666666666666383231313135363130376336353736303030383066643562353036303230363031663931393039313031363031663139313630313930353635623531393035363562393038313532363032303031393035363562363030303631303238373832363130383031353635623135313539303536356239303536356238303631303238613831363130383635353635623630303136303031363061303162303331363930353635623630303036313032383738323631303765343536356236303030363130323837383236313037663735363562383238313833333735303630303039313031353235363562363030303562383338313130313536313038346135373831383130313531383338323031353236303230303136313038333235363562383338313131313536313033303935373530353036303030393130313532353635623630316630313630316631393136393035363562363030333831313036313032386635376665356236313038373838313631303765343536356238313134363130323866353736303030383066643562363130383738383136313037656635363562363130383738383136313037663435366665613336353632376137613732333135383230316161363334353634303434326233366237393137613633383261363066653738306438376233636665383639613064313035323362313438333963383139333663363537383730363537323639366436353665373436313663663536343733366636633633343330303035306630303430613336353632376137613732333135383230343233376633656364613637323831376239613534646430373136623831636234663537633634346639376136626632396364313039613631636265336335663663363537383730363537323639366436353665373436313663663536343733366636633633343330303035306630303430222c2273746f72616765223a7b22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030223a22307830303030303030303030303030303030303030303030303030303030303030304445614430303043227d7d2c22307830303030303030303030303030303030303030303030303030303030303030306465616430303037223a7b2262616c616e6365223a2230222c226e6f6e6365223a312c22726f6f74223a2261376532366566393063313232336139323165336264383665653037386632396635386637346232346632393766346632313464313362623732646138333664222c22636f646548617368223a2263366531323066626335326236643736323331626561346331323038383831306233663266373835636666623464366535316265393434316537393538313938222c22636f6465223a22363038303630343035323334383031353631303031303537363030303830666435623530363030343336313036313031323135373630303033353630653031633830363335636131653136353131363130306164353738303633643337363834666631313631303037313537383036336433373638346666313436313032353735373830363364623037383763623134363130323738353738303633646637633732363331343631303239383537383036336539313365343766313436313032616235373830363366643534623232383134363130326265353736313031323135363562383036333563613165313635313436313032303335373830363336333332376638393134363130323062353738303633393961653933303931343631303231653537383036333963306465353230313436313032333135373830363363336234353233343134363130323434353736313031323135363562383036333430666633346566313136313030663435373830363334306666333465663134363130313937353738303633343335393335366431343631303162373537383036333438343139616438313436313031636135373830363335613539633430643134363130316464353738303633356332326236643931343631303166303537363130313231353635623830363331303162313636633134363130313236353738303633313538393333616431343631303134663537383036333237323638346235313436313031363435373830363333306439306137363134363130313737353735623630303038306664356236313031333936313031333433363630303436313062353235363562363130326336353635623630343035313631303134363931393036313065616335363562363034303531383039313033393066333562363130313632363130313564333636303034363130643139353635623631303338353536356230303562363130313632363130313732333636303034363130633465353635623631303339643536356236313031386136313031383533363630303436313062626435363562363130336261353635623630343035313631303134363931393036313065626435363562363130316161363130316135333636303034363130623164353635623631303364343536356236303430353136313031343639313930363130656362353635623631303136323631303163353336363030343631306431393536356236313034613635363562363130316161363130316438333636303034363130623532353635623631303464653536356236313031616136313031656233363630303436313061653035363562363130346632353635623631303136323631303166653336363030343631306334653536356236313035623535363562363130316161363130356330353635623631303136323631303231393336363030343631306237303536356236313035633635363562363130316161363130323263333636303034363130643139353635623631303630643536356236313031363236313032336633363630303436313063383835363562363130363831353635623631303136323631303235323336363030343631306365333536356236313037303635363562363130323661363130323635333636303034363130623532353635623631303731653536356236303430353136313031343639323931393036313065643935363562363130323862363130323836333636303034363130633465353635623631303736343536356236303430353136313031343639313930363130663034353635623631303161613631303261363336363030343631306235323536356236313037366235363562363130316161363130326239333636303034363130623532353635623631303739303536356236313032366136313037393935363562363036303830363061303630303130313534363034303531393038303832353238303630323030323630323030313832303136303430353238303135363130326638353738313630323030313630323038323032383033383833333930313930353035623530363061303534363061313534393139323530393035623830313536313033376135373630303031393831303136303030383036313033316238353631303731653536356239313530393135303631303332393838383436313037363435363562363066663136363130333531353738313934353038303836383438313531383131303631303334303537666535623630323030323630323030313031383138313532353035303631303336653536356238303934353038313836383438313531383131303631303336313537666535623630323030323630323030313031383138313532353035303562353035303530363030303139303136313033303435363562353039303931353035303562393139303530353635623832353136303230383430313230363130333937383138343834363130363831353635623530353035303530353635623630363036313033613838323631303263363536356239303530363130336235383338333833363130363831353635623530353035303536356236303030383036313033633838353835383536313036306435363562393039353134393539343530353035303530353035363562363030303831353136303031313431353631303431313537383136303030383135313831313036313033656435376665356236303230303236303230303130313531363034303531363130343032393139303631306561303536356236303430353138303931303339303230393035303631303338303536356236303630383235313630343035313930383038323532383036303230303236303230303138323031363034303532383031353631303433653537383136303230303136303230383230323830333838333339303139303530356235303930353036303030356238333531383131303135363130343935353738333831383135313831313036313034353935376665356236303230303236303230303130313531363034303531363130343665393139303631306561303536356236303430353138303931303339303230383238323831353138313130363130343832353766653562363032303930383130323931393039313031303135323630303130313631303434343536356235303631303439663831363130376132353635623933393235303530353035363562363061303534363130346234383438343834363130333835353635623630613035343831313436313033393735373630343035313632343631626364363065353162383135323630303430313631303464353930363130656634353635623630343035313830393130333930666435623630303038313630613038313130363130346562353766653562303135343930353038313536356236303030383135313630303131343135363130353430353738313630303038313531383131303631303530623537666535623630323030323630323030313031353136303430353136303230303136313035323339313930363130653635353635623630343035313630323038313833303330333831353239303630343035323830353139303630323030313230393035303631303338303536356236303030356238323531383131303135363130356135353738323831383135313831313036313035353835376665356236303230303236303230303130313531363034303531363032303031363130353730393139303631306536353536356236303430353136303230383138333033303338313532393036303430353238303531393036303230303132303833383238313531383131303631303539323537666535623630323039303831303239313930393130313031353236303031303136313035343335363562353036313035616638323631303761323536356239323931353035303536356236306130393139303931353536306131353535363562363061303534393035363562383136306132363030303631303564343836363130373662353635623831353236303230303139303831353236303230303136303030323038313930353535303830363061303630303230313630303036313035663738363631303739303536356238313532363032303831303139313930393135323630343030313630303032303535353035303530353635623832353136303230383430313230363030303930383135623833353138313130313536313036373835373630303038343832383135313831313036313036333035376665356236303230303236303230303130313531393035303630303036313036343638373834363130373634353635623930353036306666383131363631303636313537363130363561383438333631303937373536356239333530363130363665353635623631303636623832383536313039373735363562393335303562353035303630303130313631303631393536356235303934393335303530353035303536356238323630303035623832353138313130313536313036666435373630303038303834383338313531383131303631303639643537666535623630323030323630323030313031353139303530363030303631303662333837383536313037363435363562393035303630666638313136363130366439353736313036633738353833363130393737353635623932353036313036643438333836383436313035633635363562363130366630353635623631303665333832383636313039373735363562393235303631303666303833383338373631303563363536356235303930393235303530363030313031363130363835353635623530363061303535353035303530353635623630363036313037313138323631303263363536356239303530363130336235383338333833363130333835353635623630303038303630613238313631303732643835363130373662353635623831353236303230303139303831353236303230303136303030323035343630613036303032303136303030363130373463383636313037393035363562383135323630323030313930383135323630323030313630303032303534393135303931353039313530393135363562316336303031313639303536356237663031313131313131313131313131313131313131313131313131313131313131313131313131313131313131313131313131313131313131313131313131313131363930353635623630303136306663316231373930353635623630613035343630613135343832353635623630303038313531363030313134313536313037636135373831363030303831353138313130363130376262353766653562363032303032363032303031303135313930353036313033383035363562363036303630303238333531383136313037643735376665356230363631303765333537353038313631303835353536356238323531363030313031363034303531393038303832353238303630323030323630323030313832303136303430353238303135363130383131353738313630323030313630323038323032383033383833333930313930353035623530393035303630303035623833353138313130313536313038353335373833383138313531383131303631303832633537666535623630323030323630323030313031353138323832383135313831313036313038343035376665356236303230393038313032393139303931303130313532363030313031363130383137353635623530356238323531363030303630303238323036363030313134313536313038393335373630303038313630613038313130363130383732353766653562303135343833383338313531383131303631303838303537666535623630323030323630323030313031383138313532353035303630303138323031393135303562363030313832313131353631303935383537363030313031363030303562363030323833303438313130313536313039303535373631303865363834383236303032303238313531383131303631303862663537666535623630323030323630323030313031353138353833363030323032363030313031383135313831313036313038643935376665356236303230303236303230303130313531363130393737353635623834383238313531383131303631303866323537666535623630323039303831303239313930393130313031353236303031303136313038613235363562353036303032383230343931353036303032383230363630303131343830313536313039323035373530383136303031313431353562313536313039353335373630303038313630613038313130363130393332353766653562303135343833383338313531383131303631303934303537666535623630323030323630323030313031383138313532353035303630303138323031393135303562363130383933353635623832363030303831353138313130363130393635353766653562363032303032363032303031303135313933353035303530353039313930353035363562363030303832383236303430353136303230303136313039386339323931393036313065376135363562363034303531363032303831383330333033383135323930363034303532383035313930363032303031323039303530393239313530353035363562363030303832363031663833303131323631303962623537363030303830666435623831333536313039636536313039633938323631306633393536356236313066313235363562393135303831383138333532363032303834303139333530363032303831303139303530383338353630323038343032383230313131313536313039663335373630303038306664356236303030356238333831313031353631306131663537383136313061303938383832363130613836353635623834353235303630323039323833303139323931393039313031393036303031303136313039663635363562353035303530353039323931353035303536356236303030383236303166383330313132363130613361353736303030383066643562383133353631306134383631303963393832363130663339353635623831383135323630323039333834303139333930393235303832303138333630303035623833383131303135363130613166353738313335383630313631306137303838383236313061393135363562383435323530363032303932383330313932393139303931303139303630303130313631306135613536356238303335363130356166383136313066646235363562363030303832363031663833303131323631306161323537363030303830666435623831333536313061623036313039633938323631306635613536356239313530383038323532363032303833303136303230383330313835383338333031313131353631306163633537363030303830666435623631306164373833383238343631306661333536356235303530353039323931353035303536356236303030363032303832383430333132313536313061663235373630303038306664356238313335363766666666666666666666666666666666383131313135363130623039353736303030383066643562363130623135383438323835303136313039616135363562393439333530353035303530353635623630303036303230383238343033313231353631306232663537363030303830666435623831333536376666666666666666666666666666666638313131313536313062343635373630303038306664356236313062313538343832383530313631306132393536356236303030363032303832383430333132313536313062363435373630303038306664356236303030363130623135383438343631306138363536356236303030383036303030363036303834383630333132313536313062383535373630303038306664356236303030363130623931383638363631306138363536356239333530353036303230363130626132383638323837303136313061383635363562393235303530363034303631306262333836383238373031363130613836353635623931353035303932353039323530393235363562363030303830363030303830363038303835383730333132313536313062643335373630303038306664356236303030363130626466383738373631306138363536356239343530353036303230383530313335363766666666666666666666666666666666383131313135363130626663353736303030383066643562363130633038383738323838303136313061393135363562393335303530363034303631306331393837383238383031363130613836353635623932353035303630363038353031333536376666666666666666666666666666666638313131313536313063333635373630303038306664356236313063343238373832383830313631303961613536356239313530353039323935393139343530393235303536356236303030383036303430383338353033313231353631306336313537363030303830666435623630303036313063366438353835363130613836353635623932353035303630323036313063376538353832383630313631306138363536356239313530353039323530393239303530353635623630303038303630303036303630383438363033313231353631306339643537363030303830666435623630303036313063613938363836363130613836353635623933353035303630323036313063626138363832383730313631306138363536356239323530353036303430383430313335363766666666666666666666666666666666383131313135363130636437353736303030383066643562363130626233383638323837303136313039616135363562363030303830363034303833383530333132313536313063663635373630303038306664356238323335363766666666666666666666666666666666383131313135363130643064353736303030383066643562363130633664383538323836303136313061393135363562363030303830363030303630363038343836303331323135363130643265353736303030383066643562383333353637666666666666666666666666666666663831313131353631306434353537363030303830666435623631306361393836383238373031363130613931353635623630303036313064356438333833363130646364353635623530353036303230303139303536356236303030363130643730383236313066383835363562363130643761383138353631306638633536356239333530363130643835383336313066383235363562383036303030356238333831313031353631306462333537383135313631306439643838383236313064353135363562393735303631306461383833363130663832353635623932353035303630303130313631306438393536356235303934393539343530353035303530353035363562363130646337383136313066393535363562383235323530353035363562363130646337383136313066396135363562363130646337363130646532383236313066396135363562363130663961353635623630303036313064663238323631306638383536356236313064666338313835363130333830353635623933353036313065306338313835363032303836303136313066616635363562393239303932303139323931353035303536356236303030363130653233363032343833363130663863353635623766353037323666373636393634363536343230363936653633366337353733363936663665323037303732366636663636323036393733323036393665373636313831353236333336333462323137363065313162363032303832303135323630343030313932393135303530353635623631306463373831363130663964353635623630303036313065373138323834363130646436353635623530363032303031393139303530353635623630303036313065383638323835363130646436353635623630323038323031393135303631306539363832383436313064643635363562353036303230303139323931353035303536356236303030363130343966383238343631306465373536356236303230383038323532383130313631303439663831383436313064363535363562363032303831303136313035616638323834363130646265353635623630323038313031363130356166383238343631306463643536356236303430383130313631306565373832383536313064636435363562363130343966363032303833303138343631306463643536356236303230383038323532383130313631303561663831363130653136353635623630323038313031363130356166383238343631306535633536356236303430353138313831303136376666666666666666666666666666666638313131383238323130313731353631306633313537363030303830666435623630343035323931393035303536356236303030363766666666666666666666666666666666383231313135363130663530353736303030383066643562353036303230393038313032303139303536356236303030363766666666666666666666666666666666383231313135363130663731353736303030383066643562353036303230363031663931393039313031363031663139313630313930353635623630323030313930353635623531393035363562393038313532363032303031393035363562313531353930353635623930353635623630666631363930353635623832383138333337353036303030393130313532353635623630303035623833383131303135363130666361353738313831303135313833383230313532363032303031363130666232353635623833383131313135363130333937353735303530363030303931303135323536356236313066653438313631306639613536356238313134363130666566353736303030383066643562353035366665613336353632376137613732333135383230363764313036643830393036663061643738343764633438633431376430383539383363363033316266303165613434653062613434626131353336386131333663363537383730363537323639366436353665373436313663663536343733366636633633343330303035306630303430222c2273746f72616765223a7b22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030223a2232393064656364393534386236326138643630333435613938383338366663383462613662633935343834303038663633363266393331363065663365353633222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303031223a2236333364633464376461373235363636306138393266386631363034613434623534333236343963633865633563623363656434633465366163393464643164222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303032223a2238393037343061386562303663653962653432326362386461356364616663326235386330613565323430333663353738646532613433336338323866663764222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303033223a2233623865633039653032366664633330353336356466633934653138396138316233386337353937623364393431633237396630343265383230366530626438222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303034223a2265636435306565653338653338366264363262653962656462393930373036393531623635666530353362643964386135323161663735336431333965326461222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303035223a2264656666663664333330626235343033663633623134663333623537383237343136306465336135306466346566656366306530646237336263646433646135222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303036223a2236313762646431316637633061313166343964623232663632393338376131326461373539366639643137303464373436353137376336336438386563376437222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303037223a2232393263323361396161316438626561376532343335653535356134613630653337396135613335663366343532626165363031323130373366623665656164222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303038223a2265316365613932656439396163646362303435613637323662326638373130376538613631363230613233326366346437643562353736366233393532653130222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303039223a2237616436366330613638633732636238396534666234333033383431393636653430363261373661623937343531653362396662353236613563656237663832222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303061223a2265303236636335613461656433633232613538636264336432616337353463393335326335343336663633383034326463613939303334653833363336353136222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303062223a2233643034636666643862343661383734656466356366616536333037376465383566383439613636303432363639376230366138323963373064643134303963222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303063223a2261643637366161333337613438356534373238613062323430643932623365663762336333373264303664313839333232626664356636316631653732303365222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303064223a2261326663613461343936353866396661623761613633323839633931623763376236633833326136643065363933333466663562306133343833643039646162222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303065223a2234656266643963643762636132353035663762656635396363316331326563633730386666663236616534616631396162653835326166653965323063383632222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303066223a2232646566313064313364643136396635353066353738626461333433643937313761313338353632653030393362333830613131323037383964353363663130222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303130223a2237373661333164623334613161306137636161663836326366666466666631373839323937666661646333383062643364333932383164333430616264336164222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303131223a2265326537363130623837613566646633613732656265323731323837643932336162393930656566616336346236653539643739663862376530386334366533222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303132223a2235303433363461356336383538626639386666663731346162356265396465313965643331613937363836306566626430653737326132656665323365326530222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303133223a2234663035663461636238336635623635313638643966656638396435366434643737623839343430313565366231656564383162303233386532643064626133222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303134223a2234346136643937346337356230373432336531643664333366343831393136666464343538333061656131316236333437653730306364386239663037363763222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303135223a2265646632363032393166373334646461633339366139353631323764646534633334633063666238643830353266383861633133393635386363663264353037222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303136223a2236303735633635376131303533353165376630666365353362633332303131333332346135323265386664353264633837386337363235353165303161343665222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303137223a2236636136613366373633613933393566376461313630313437323563613765653137653438313563306666383131396266333366323733646565313138333362222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303138223a2231633235656631306666656233633764303861613730376431373238366530623064336362636235306631626433623635323362363362613362353264643066222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303139223a2266666663343362643038323733636366313335666433636163626565663035353431386530396562373238643732376334643564356335353663646561376533222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303161223a2263356162383131313435366231663238663363376130613630346234353533636539303563623031396334363365653135393133376166383363333530623232222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303162223a2230666632373366636266346165306632626438386436636633313966663430303466386437646361373064346365643465373464326337343133393733396536222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303163223a2237666130366261313132343164646435656664633635643465333963396636393931623734666434623831623632323330383038323136633837366638323763222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303164223a2237653237356164663331336139393663376532393530636163363763616261303261356666393235656266393930366235383934396633653737616563356239222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303165223a2238663631363266613330386432623361313564633333636666616338356631336162333439313733313231363435616564663030663437313636333130386265222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303166223a2237386363616161623733333733353532663230376136333539396465353464376438643063313830356638366365376461313538313864303966346366663632222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303230223a2263663237376662383061383234373834363065383938383537306237313866316530383363656237366637653237316131613134393765353937356635336165222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303231223a2234626636666661326263313331323034353133323839373338353637613638666139663438323764616337666433623364316632653934373737643537663336222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303232223a2262343964363165386332633839346531323438363137366162386634643730363964363639326661363439353534313536373837326537656362646462373236222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303233223a2232303262313031343733396632396231643930356436333064646562383536306133326266323365363636633861313532336134613630303232376665663763222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303234223a2239653164316365356364636139636466343066613537383635343862353865623139646466643332333935623435383239383339313930393964626431353331222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303235223a2231333738346430316532666165393034646536326336666266393737363937396361376132373737616532363332656532373864313961636133306638393065222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303236223a2236386334373766383361313361303030616432623562336535303337356237633361653738326439383762613462356136353337366262623937343639666233222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303237223a2237316533373836346564663038373430643539323336326364323464306462303637626631346364336239376264326136386537383261646666623433363535222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303238223a2238386430636333356538616265376436353234353639626538616131613438626232333336323332366664666566653936313334386263393630393163393463222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303239223a2238353435663263316166646163653564383766303663653164343462633061323639316165613434313464306164363430626530643938373963383337346439222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303261223a2232333630633434383064363938373965616464376165353038343039663266306261383361326230666530646135376234303030386330363464326333393764222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303262223a2233313736336138653164656462313561643063386238386434333766656638333561656239353832393236343438313036363364633137353635353066323262222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303263223a2266613463396231313732616235616665626431353935373365313965323531363939373532343265313061326564333864666338303532333266623530613634222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303264223a2233353238353934643635653935323532333362333935383731373462636630626261353632643935623530623432393931346337653065386139616535386639222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303265223a2231373635393232373937626137323165383933376337343730613236643563326631343166336434313036343437646162313731666365643764363532613639222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303266223a2266303537336536363062613031636666323732613163366637393237643733633934633835643338306138343339393836373632366436343239643438626165222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303330223a2233313638326331396637313563643331376461316135353363653338346465363930326665323630333266656363376465303365343035303261663035646365222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303331223a2264353063363036326266636437626264306462643638643164646565616530393534383537323061316365333334653965356535313533303636663432323630222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303332223a2265306263666264303366613461363139613562633363616531353430346633396234623762663564646435313631656136343264313364323362616566353435222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303333223a2261636239386236666231613566646165306135373138643062383132653164653134313265343464383735313436616363316137663763333931366330303361222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303334223a2262623166663239316562326536313461363631643637333062353032626165326264653131323935386365653639383437383834356131626233373838613662222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303335223a2239613931663463313935343564366364393732306434373336353931666537306564636633653333636232653536663231666463313234346633643265663664222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303336223a2262646638326337643235326436396336346264366661386565363661643964333032343130313639383165343538306533633265646334383034623735323336222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303337223a2236336263336239653833343061623438613636383238643764396437643562653266356632383036363531393664323561333963346430313334356362393565222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303338223a2261393632653462643764316633313666383435373834653131356666313033353330643835663964666163643633386164343566626539333862613835633430222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303339223a2262336631303061306635303666366136633836633130663130376162343831316464633736663732353136666364666138613063396264373166663931326162222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303361223a2265376538646263333839383531353937306361366663653139303339636132326165323633386534346232343634316664363130343862393464633262653235222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303362223a2233316430663636616234333031393835363738306362323436636664333762306331393064313731313164356330313666313962613731356565363232646335222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303363223a2261636432663364666434336539613031626336346139633461633861633861326232396633323734313331613132303130623432623863383330653837396266222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303364223a2261323331333631616433333839666536663262363261343663663637376436633566376237393936346333306132633334616134666333333932663530316339222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303365223a2231343061623439636333626234346533343236353561353231313835393333623232333065633237346666353330346232636561643938373361383232383633222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303366223a2231306337313066393230356233306538613731363365646266636438356538666261663266336665343432663265653461663538306532386232646631313830222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303430223a2236663335343139643164613132363062633066333364353265386636643733666335643637326330646361313362623936306234616531616465633137393337222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303431223a2237323035303232386537366565613336656236336233383139636634323366333966373632316462633535636435616339356238303835656437326163313864222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303432223a2231623963303431383230363562316232343537373538333063643061376431366435623561633330303033613735626132646138326434313939386237356264222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303433223a2236366337343866613166613436323836613538346435653332656333653664663835643232376566393466386365356564663161303538636530616461336262222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303434223a2231323034643664333661636630633632643334623031386135373733616637303164666532656335353461326665623631666434663964316436633464666165222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303435223a2263316365356364393131366535396365383062616566323238616139313034643530613861666161383733323731336236343538373261636332613531666332222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303436223a2232373532386565616461373830396563393666613235323338363631616331363936313764323364613964376666323736626132393734326361663239616461222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303437223a2232383838626562333332343735663138613737376431663339303938666331323534313638316239363366363564656465633534323962666563653332313061222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303438223a2264346661396638363838376536383062383063663830623365663234373766326132626263323234626264316239376433653432313535663635616563303165222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303439223a2237356363336636306438656436373863663364343439346363636264343963373666643562343830653432393533336338306535653630643365653661303034222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303461223a2232326537643063303031363665353061636436626162663730393833333261653663363335376664643533396364386563383237653235666635353063306561222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303462223a2238373134333030613036646564316262633933666232316433366435633733323265306631353433366238363732373531626166376537636234616532613237222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303463223a2231363135376432626435386137623935663638363532323330363636333765636266663137353437333939623334313063626131336264366166336435343961222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303464223a2239306264346338303863623761353564656538643036633965616537643230343237646430326266306531306439346663313766343735313938383135633736222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303465223a2264643736303366363533333937306534653366343939343735646338363162313235363039626663333762353130333036616564323665656461383031646165222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303466223a2237323062373039653135356136356534343137346238616538626434343262373437353264653038306463393930393365336239633930636439363635393035222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303530223a2264663131313364353465326265313734656662643839373134643732333135353136636164303666343630393737303662653136323065663461383065313030222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303531223a2262303664323330396637366431316164393261363335333961323766383738303834383334633834343536303834616138303562653033353239336238323738222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303532223a2261313966353163336432663465663436336534633231343339333438373866376265663464393131373234396266323734623165626464633062306465333737222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303533223a2237636237333836313833616365356562356430336364643431383039366237333665646562333863323838396231333764393231663530393935633666383638222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303534223a2265626434643862316561346634323962333430623463336336353031356139383364353661643863386439326631343837353330636162316139643562303463222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303535223a2265633464623638323736636164663165363039343766383036356463636339666666616334666163383033643733363632373265386239616339343337343634222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303536223a2261383631613437613935303162353561663361356439353366356531646665643666346630303238346265373436363038663462663538396662396361326165222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303537223a2262653733633837366364356363346333643939393662633637336535303165336564636330363336633032653539343165636665613430646532303764353965222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303538223a2261353063353736653936326434633462383536666564386663393034663033663730616231623734353262396135653662313238646166336361376636393966222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303539223a2231643833386435623336663233313837366137343136353633326431623961363866383532333664323664316136646666356137393038626332636334653337222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303561223a2261313938386261643432633562623232336466353563373731313833333062313239333837366563336462616562363331376330643036343135333966626632222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303562223a2264323638636164633432396366633632623436613034343231306662336166323737306432363835396265313863646331613539363030396230393132326537222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303563223a2230646264333432373564313265343436386134323561383930316161386163373062323061333735313636623532353566353931353430623466663366343432222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303564223a2237313030393035366332636263393066343039373762303335353938333766323763336334306662356635376139656135333562323564626639383133313436222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303565223a2232376538333562393434336539303833356566363838363037343863616363653538656531313361383062396262643836393962613630313864393061643531222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303566223a2235386266616135363833323563626138316535636137633866613365626436386436623830346239366661343466366232653232373736653665306631343133222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303630223a2264313963623931636166333266343064383266646665336634323135323732346239393738313231656631353266616664323338656331613239306631323236222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303631223a2239306537623865656464393362363465346164343439626166376166373734303435633430306666313838383065343030323161393163636139323165643765222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303632223a2232636632383432346265326132326634626134376135303931303963326437633463643934623332326334663431313466663161383866306264316663656339222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303633223a2261333837623331373833333930353635336532356133373733663233616638373266643365623662336338613762343537613965303765356664373734623530222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303634223a2266353739303231633430643563373966636537616236646261333064316232316565303933313465643233353136303936376531383339303962643130303964222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303635223a2264396633653930393466663262663035333232353361363130663661333163643230373064333763633436616132646262323637323431653137396439613332222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303636223a2264663437393661336562646566333666383430333235323532653236346233656364336230636339363539356436306535393832333562393130303734353639222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303637223a2265663938623732363066663065356337646565386231366431663832393832353234343262333762386466336534643939343839356466386534353439373362222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303638223a2237633435343930373466616334626238303334333961613461656330653238393931363138353861366432323333353439343635613966313961346639383637222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303639223a2265666564326563383962613038343632626334313965373838303335646134333364326332386165303563376630383563346531663966643435373135356333222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303661223a2236643839613763306130623066373531313162306362616631343936643138366464303662333135626165656165376138363230323932343836396561663735222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303662223a2236656366343431376236376661363264656164393962626536343430356164353337646230373461346530313637363661646530313666393465663233653138222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303663223a2234616330353664313639336336396538326136613764323137363434346437393863396632386230323333333535393137333430326162643563383664393633222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303664223a2231313161336239343334316666626566393265336437666335666264626134653534326535623039333637363434363737373964336638313035663437306666222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303665223a2266623737643061303661316563333964336332373538643532623865323962633130353732333763636464363761646136646133346162393865346434356535222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303666223a2236386139613963356236636163653531326264623233326333356435633062306262396534613231643065363732343434653837353065303466376661316333222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303730223a2237613731656566656131353534646638613033643836366134313837353962396338366533623864633430323937623338613065383461346564666239643339222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303731223a2235643031613139616434336562383331386565303332626232613066623038623334656139333430336563393762653464356439353534306237386166626563222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303732223a2231386465303565663138623438666562363061616565393634333861373539616139336336653637653632633332313734396261356339633833383137313265222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303733223a2239663033373663653234386161656531663662376264303139656162666534316463663266323739313132366432303636633466313863376234666332656535222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303734223a2234646566613235336637363861666262613738643831303533333661343230313631616532393262663232386161303031306335656665623466303834646265222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303735223a2233316633666236323136336630346431616361356465353864616465366465313035303930356436653036393530356133383261376633333364376131326136222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303736223a2262613931386466653638346232386536323065353338613365653465376164363465323337646262303432653338393236333537363930623732373063333830222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303737223a2238613364336237393766386232336466386336383338373265393863663164666264383366396237343836666566353630366665386230633131643961386332222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303738223a2263303639653633623431303763346665373163643433316135336232333739373664633637333337396333356535393266363137633065633037343738336564222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303739223a2232383636666464323832346533336264373438346133643337346436306262623630643137303530343637626661646563643038633938613361313838616537222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303761223a2239303737616331306165636363343131333839366136393833303365656238393733393165313461393838373436363430333837636530353533343139393464222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303762223a2235353166393032656461373863306334653039646136633666653363356334613136363463393563653264393863333663303538643632666231343435336338222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303763223a2263383034383137363534643866663636626438343261356631366633313430333432363131633730316230393538363162306565616466383837636364633866222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303764223a2237373962326331653062393033393664613236316237396335376436343261353933653433353863396562643766346364383436333038656637343035373337222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303765223a2263353165313664623064346566633036383736306665333762376665393330363435633339366563303231396665626432386130313132656439383066333732222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303766223a2239313066613737363631326638306332363963323963616536393739326462643933363433353039643863363764333432356163363434363535626634336339222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303830223a2238353231623465653834313462343430313934303663623639363362346461633532653936646239623863373431343731393630323461643861616231666136222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303831223a2261623735663535366135353837363232356432356438656531633365333836306137613566663030303337636236386364303461333636346139626164646661222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303832223a2237353933626461633135653231316631306438353334333130363733636566303962356364663637666634366362396438346463356630326634643834386338222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303833223a2232393039386333353238636132383861336261356432306335306365326662333362623937376139343431626265366664346132306563333239373134633637222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303834223a2266323866303866633333613461306230613132303037396138633137663163393833343932333263316136343737316331333134353165656566353130336433222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303835223a2262613431653561353262373161656237346163333964623734633361383238616462363531616335666238366365653437303761383265356536303330333333222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303836223a2265643133323232633961633534306235666364633263353433396137373265363266633761356437653435306237303365613335366131353864646636653866222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303837223a2266313838393134373464343937633764353130656533663438323733666462616366313437323263636464316266653233396330646663326434623330343664222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303838223a2237343737326132333234356639386231663234376638653164653730336665613837626462323831393233386163366331396331353461363834363361373537222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303839223a2238663763646431303731383462656630663636613263383632353934313738666665643138363865396136326566383237663431346666353837343138616363222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303861223a2236643631613533353832376339646662613932366338383436643661616333316338336166333038373930326136323635313832663036373762663462643336222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303862223a2237653934623661356537663765333463653233373537313266393766366131333034626361646337366633306265386163633635353936643861653563653061222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303863223a2263616133393930616230616233646461313930646263376235666237343564323661373564633637396666643231323831656132663137303932316136653933222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303864223a2261383664313963623863313564373433663066366465366563356232313761623836396164666564383136333535623930316361333665333263323733356635222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303865223a2232363234313164353931366630333636313262333039313066393362663038623364386633656266346232656465316361383732396139373665373266393062222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303866223a2263646264303238623565303231663464373865636138376639326138316663323933373431623830663830353432613239633931653131626666346563326164222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303930223a2266613466656433306465303432333337313534623138373666616666393666663437363736386665366233336436306264373966346437396136373233363439222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303931223a2264313236373662656536633437353833383165303065396466633565666132366365336635326536653364653830386365663332396361616433623231313162222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303932223a2239333063336136343337393636663239336335616265343364363735353439346666656236316464626234333132323234313636386361653831656361366563222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303933223a2265353533656132386161653766353531653461613766363435663134663434333335326136306665623532316636656561316233336535373235363938396565222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303934223a2237613061623136343432343062323234623261663466626637356235623732663737366639303239663165623434326632326130303466326461363565396364222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303935223a2262343630346635626639643466643734363130663937306562633763333765376431366462656339383532386336633537303764333634313062333636383565222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303936223a2232366332616435333034343238353261626530393766636166383761646230393463356334633639623239613164663364623937346238373030333436656431222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303937223a2232326336303163396135363531613937366338366661356235373764633461383462373263346630363463356464323939653966643733663665666265316634222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303938223a2234393863316264313630663732353332653263613132313932376136366662303561376537383832346264323332303033616536376539623061303034616563222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303939223a2230326365316266623166656539393132306132316430623261323233373838346664383665633565313665616134343865646365653432636264643632316666222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303961223a2230376662363434633466646261303735653836316463373531303564386466613766653961363138633061616164373238636565303661653765366330633737222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303962223a2235633961646531353161396636333766306365336233656231316662306638333038326664323261613139353264306364323462613261373336373062626137222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303963223a2239643836363662666233313333343532356436366535366131303934386164643162643064643930643733313631353833643865313364386238666265656166222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303964223a2237613031633534373939353436333562326436373665306366313135316132303462303835373664343337623836303066313739663338656562336535653232222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303965223a2231616634343134653238363763303961333234613062306538346130646264343062626631356233656661363962353263613863613131623334306565303935222c22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303966223a2234623532396265383039393937613362623965366161633632393631316266653030633531303434663463623131326138623962346163376561623563313961227d7d2c22307830303030303030303030303030303030303030303030303030303030303030306465616430303038223a7b2262616c616e6365223a2230222c226e6f6e6365223a312c22726f6f74223a2239633734613933306535666530666434323035326165363234383566633165393864633366393462346137323464623031643734396231633630393065343331222c22636f646548617368223a2234323730316163316130356237663663623561366532643537313966343632666635653430313761626531303237356538663364343066616464313861616531222c22636f6465223a2236303830363034303532333438303135363130303130353736303030383066643562353036303034333631303631303038383537363030303335363065303163383036333863613563626239313136313030356235373830363338636135636262393134363130306538353738303633623332633464386431343631303066623537383036336238353933323063313436313031306535373830363363306263373239373134363130313265353736313030383835363562383036333035643264623534313436313030386435373830363332333263646565363134363130306162353738303633333331363962363331343631303063623537383036333763613665633462313436313030653035373562363030303830666435623631303039353631303134313536356236303430353136313030613239313930363130666165353635623630343035313830393130333930663335623631303062653631303062393336363030343631306234323536356236313031343835363562363034303531363130306132393139303631306638313536356236313030646536313030643933363630303436313062623335363562363130316366353635623030356236313030393536313032383935363562363130306465363130306636333636303034363130613339353635623631303238663536356236313030393536313031303933363630303436313062393535363562363130353465353635623631303132313631303131633336363030343631306163363536356236313035366335363562363034303531363130306132393139303631306661303536356236313030393536313031336333363630303436313062373735363562363130363733353635623630303235343562393035363562363030303830353436303430353136336266343066616331363065303162383135323630303136303031363061303162303339303931313639303633626634306661633139303631303137393930383539303630303430313631313030373536356236303230363034303531383038333033383138363830336231353830313536313031393135373630303038306664356235303561666131353830313536313031613535373364363030303830336533643630303066643562353035303530353036303430353133643630316631393630316638323031313638323031383036303430353235303631303163393931393038313031393036313061313335363562393239313530353035363562363030303631303164393631303662333536356239303530333336303031363030313630613031623033383231363134363130323063353736303430353136323436316263643630653531623831353236303034303136313032303339303631313032383536356236303430353138303931303339306664356236303032353438333130363130323264353736303430353136323436316263643630653531623831353236303034303136313032303339303631313034383536356236303030363130323338383336313036373335363562393035303630303238343831353438313130363130323437353766653562393036303030353236303230363030303230303135343831313436313032373035373630343035313632343631626364363065353162383135323630303430313631303230333930363131303638353635623833363130323763363030323832363130373563353635623530353035303630343030313531363030313535353035363562363030313534383135363562363030303631303239393631303665383536356239303530363030303631303261353631303732383536356239303530383136303031363030313630613031623033313636333763613665633462363034303531383136336666666666666666313636306530316238313532363030343031363032303630343035313830383330333831383638303362313538303135363130326530353736303030383066643562353035616661313538303135363130326634353733643630303038303365336436303030666435623530353035303530363034303531336436303166313936303166383230313136383230313830363034303532353036313033313839313930383130313930363130616138353635623834353136303031353430313131313536313033336335373630343035313632343631626364363065353162383135323630303430313631303230333930363131303538353635623630303038343531313136313033356435373630343035313632343631626364363065353162383135323630303430313631303230333930363131303338353635623630303135343833313131353631303337663537363034303531363234363162636436306535316238313532363030343031363130323033393036313130313835363562383335313833303136303031353431303631303339323537353035303631303534613536356236303630363030313534383431303135363130343166353736303030383436303031353430333930353038303836353130333630343035313930383038323532383036303230303236303230303138323031363034303532383031353631303364343537383136303230303136303230383230323830333838333339303139303530356235303931353036303030356238323531383131303135363130343138353738363831383330313831353138313130363130336631353766653562363032303032363032303031303135313833383238313531383131303631303430353537666535623630323039303831303239313930393130313031353236303031303136313033646135363562353035303631303432323536356235303833356236303430353136333561353963343064363065303162383135323630303039303630303136303031363061303162303338343136393036333561353963343064393036313034353139303835393036303034303136313066386635363562363032303630343035313830383330333831383638303362313538303135363130343639353736303030383066643562353035616661313538303135363130343764353733643630303038303365336436303030666435623530353035303530363034303531336436303166313936303166383230313136383230313830363034303532353036313034613139313930383130313930363130616138353635623832353136303031353436303430353136303230303136313034623839333932393139303631306634613536356236303430383035313630316631393831383430333031383135323930383239303532383035313630323039303931303132303630303238303534363030313831383130313833353536303030393239303932353237663430353738376661313261383233653066326237363331636334316233626138383238623333323163613831313131316661373563643361613362623561636530313832393035353834353138313534303139303535393135303766383030653662333066623161303165393033386633323461303439353232613032333139363465386465306161396538313562333566633030323965386435323930363130353364393038333930363130666165353635623630343035313830393130333930613135303530353035303562353035303536356236303032383138313534383131303631303535623537666535623630303039313832353236303230393039313230303135343930353038313536356236303030363130353736363130373835353635623832363032303031353139303530383036303430303135313833363034303031353130313834313436313035393835373630303039313530353036313036366335363562363030303631303561323631303732383536356239303530383036303031363030313630613031623033313636333330643930613736383336303030303135313838383736303430303135313838363036303031353136303430353138353633666666666666666631363630653031623831353236303034303136313035653239343933393239313930363130666263353635623630323036303430353138303833303338313836383033623135383031353631303566613537363030303830666435623530356166613135383031353631303630653537336436303030383033653364363030306664356235303530353035303630343035313364363031663139363031663832303131363832303138303630343035323530363130363332393139303831303139303631306138613536356236313036343135373630303039323530353035303631303636633536356236303032383436303030303135313831353438313130363130363532353766653562393036303030353236303230363030303230303135343631303636363833363130363733353635623134393235303530353035623933393235303530353035363562363030303831363030303031353138323630323030313531383336303430303135313630343035313630323030313631303639363933393239313930363130663461353635623630343035313630323038313833303330333831353239303630343035323830353139303630323030313230393035303931393035303536356236303030363130366533363034303531383036303430303136303430353238303630306438313532363032303031366332333339333062616232326233326239333462333334623262393630393931623831353235303631303134383536356239303530393035363562363030303631303665333630343035313830363034303031363034303532383036303139383135323630323030313766343336313665366636653639363336313663353437323631366537333631363337343639366636653433363836313639366530303030303030303030303030303831353235303631303134383536356236303030363130366533363034303531383036303430303136303430353238303630313138313532363032303031373035323666366336633735373034643635373236623663363535353734363936633733363037383162383135323530363130313438353635623831353438313833353538313831313131353631303738303537363030303833383135323630323039303230363130373830393138313031393038333031363130376139353635623530353035303536356236303430353138303630363030313630343035323830363030303830313931363831353236303230303136303030383135323630323030313630303038313532353039303536356236313031343539313930356238303832313131353631303763333537363030303831353536303031303136313037616635363562353039303536356238303531363130316339383136313131356435363562363030303832363031663833303131323631303765333537363030303830666435623831333536313037663636313037663138323631313039663536356236313130373835363562393135303831383138333532363032303834303139333530363032303831303139303530383338353630323038343032383230313131313536313038316235373630303038306664356236303030356238333831313031353631303834373537383136313038333138383832363130386363353635623834353235303630323039323833303139323931393039313031393036303031303136313038316535363562353035303530353039323931353035303536356236303030383236303166383330313132363130383632353736303030383066643562383133353631303837303631303766313832363131303966353635623931353038313831383335323630323038343031393335303630323038313031393035303833383536303230383430323832303131313135363130383935353736303030383066643562363030303562383338313130313536313038343735373831363130386162383838323631303863633536356238343532353036303230393238333031393239313930393130313930363030313031363130383938353635623830353136313031633938313631313137343536356238303335363130316339383136313131376435363562383035313631303163393831363131313764353635623630303038323630316638333031313236313038663335373630303038306664356238313335363130393031363130376631383236313130633035363562393135303830383235323630323038333031363032303833303138353833383330313131313536313039316435373630303038306664356236313039323838333832383436313131313735363562353035303530393239313530353035363562363030303630363038323834303331323135363130393433353736303030383066643562363130393464363036303631313037383536356239303530363030303631303935623834383436313038636335363562383235323530363032303631303936633834383438333031363130386363353635623630323038333031353235303630343036313039383038343832383530313631303863633536356236303430383330313532353039323931353035303536356236303030363063303832383430333132313536313039396535373630303038306664356236313039613836303830363131303738353635623930353036303030363130396236383438343631303863633536356238323532353036303230363130396337383438343833303136313039333135363562363032303833303135323530363038303631303964623834383238353031363130386363353635623630343038333031353235303630613038323031333536376666666666666666666666666666666638313131313536313039666235373630303038306664356236313061303738343832383530313631303764323536356236303630383330313532353039323931353035303536356236303030363032303832383430333132313536313061323535373630303038306664356236303030363130613331383438343631303763373536356239343933353035303530353035363562363030303830363034303833383530333132313536313061346335373630303038306664356238323335363766666666666666666666666666666666383131313135363130613633353736303030383066643562363130613666383538323836303136313038353135363562393235303530363032303631306138303835383238363031363130386363353635623931353035303932353039323930353035363562363030303630323038323834303331323135363130613963353736303030383066643562363030303631306133313834383436313038633135363562363030303630323038323834303331323135363130616261353736303030383066643562363030303631306133313834383436313038643735363562363030303830363030303630363038343836303331323135363130616462353736303030383066643562383333353637666666666666666666666666666666663831313131353631306166323537363030303830666435623631306166653836383238373031363130386532353635623933353035303630323036313062306638363832383730313631303863633536356239323530353036303430383430313335363766666666666666666666666666666666383131313135363130623263353736303030383066643562363130623338383638323837303136313039386335363562393135303530393235303932353039323536356236303030363032303832383430333132313536313062353435373630303038306664356238313335363766666666666666666666666666666666383131313135363130623662353736303030383066643562363130613331383438323835303136313038653235363562363030303630363038323834303331323135363130623839353736303030383066643562363030303631306133313834383436313039333135363562363030303630323038323834303331323135363130626137353736303030383066643562363030303631306133313834383436313038636335363562363030303830363038303833383530333132313536313062633635373630303038306664356236303030363130626432383538353631303863633536356239323530353036303230363130613830383538323836303136313039333135363562363030303631306265663833383336313063623635363562353035303630323030313930353635623631306330303831363131306662353635623832353235303530353635623630303036313063313138323631313065653536356236313063316238313835363131306632353635623933353036313063323638333631313065383536356238303630303035623833383131303135363130633534353738313531363130633365383838323631306265333536356239373530363130633439383336313130653835363562393235303530363030313031363130633261353635623530393439353934353035303530353035303536356236303030363130633661383236313130656535363562363130633734383138353631313066323536356239333530363130633766383336313130653835363562383036303030356238333831313031353631306335343537383135313631306339373838383236313062653335363562393735303631306361323833363131306538353635623932353035303630303130313631306338333536356236313063303038313631313130363536356236313063303038313631303134353536356236313063303036313063636238323631303134353536356236313031343535363562363030303631306364623832363131306565353635623631306365353831383536313130663235363562393335303631306366353831383536303230383630313631313132333536356236313063666538313631313135333536356239303933303139333932353035303530353635623630303036313064313536303334383336313130663235363562376635663733373436313732373437333431373435323666366637343439366536343635373832303639366536343635373832303639366536343639363336313734383135323733313935636338313939643564316435633939343831636464313835643139343831633962646264643630363231623630323038323031353236303430303139323931353035303536356236303030363130643662363033393833363131306632353635623766346636653663373932303436373236313735363435363635373236393636363936353732323036383631373332303730363537323664363937333733363936663831353237663665323037343666323036343635366336353734363532303733373436313734363532303632363137343633363836353733303030303030303030303030303036303230383230313532363034303031393239313530353035363562363030303631306463613630326438333631313066323536356237663433363136653665366637343230373337353632366436393734323036313665323036353664373037343739323037333734363137343635323036333666366438313532366330646164326538646163616463653834306334633265386336643630396231623630323038323031353236303430303139323931353035303536356236303030363130653139363032633833363131306632353635623766343336313665366536663734323036343635366336353734363532303632363137343633363836353733323036663735373437333639363436353230366636363831353236623230373636313663363936343230373236313665363736353630613031623630323038323031353236303430303139323931353035303536356236303030363130653637363036333833363131306632353635623766343336313665366536663734323036313730373036353665363432303664366637323635323037333734363137343635323036333666366436643639373436643831353237663635366537343733323037343638363136653230373436663734363136633230366537353664363236353732323036663636323037343732363136653733363136303230383230313532376636333734363936663665373332303639366532303433363136653666366536393633363136633534373236313665373336313633373436393666366534333638363034303832303135323632333062346237363065393162363036303832303135323630383030313932393135303530353635623630303036313065663836303366383336313130663235363562376634333631366336333735366336313734363536343230363236313734363336383230363836353631363436353732323036393733323036343639363636363635383135323766373236353665373432303734363836313665323036353738373036353633373436353634323036323631373436333638323036383635363136343635373230303630323038323031353236303430303139323931353035303536356236303030363130663536383238363631306362663536356236303230383230313931353036313066363638323835363130636266353635623630323038323031393135303631306637363832383436313063626635363562353036303230303139333932353035303530353635623630323038313031363130316339383238343631306266373536356236303230383038323532383130313631303636633831383436313063303635363562363032303831303136313031633938323834363130636164353635623630323038313031363130316339383238343631306362363536356236303830383130313631306663613832383736313063623635363562383138313033363032303833303135323631306664633831383636313063643035363562393035303631306665623630343038333031383536313063623635363562383138313033363036303833303135323631306666643831383436313063356635363562393639353530353035303530353035303536356236303230383038323532383130313631303636633831383436313063643035363562363032303830383235323831303136313031633938313631306430383536356236303230383038323532383130313631303163393831363130643565353635623630323038303832353238313031363130316339383136313064626435363562363032303830383235323831303136313031633938313631306530633536356236303230383038323532383130313631303163393831363130653561353635623630323038303832353238313031363130316339383136313065656235363562363034303531383138313031363766666666666666666666666666666666383131313832383231303137313536313130393735373630303038306664356236303430353239313930353035363562363030303637666666666666666666666666666666663832313131353631313062363537363030303830666435623530363032303930383130323031393035363562363030303637666666666666666666666666666666663832313131353631313064373537363030303830666435623530363032303630316639313930393130313630316631393136303139303536356236303230303139303536356235313930353635623930383135323630323030313930353635623630303036313031633938323631313130623536356231353135393035363562363030313630303136306130316230333136393035363562383238313833333735303630303039313031353235363562363030303562383338313130313536313131336535373831383130313531383338323031353236303230303136313131323635363562383338313131313536313131346435373630303038343834303135323562353035303530353035363562363031663031363031663139313639303536356236313131363638313631313066623536356238313134363131313731353736303030383066643562353035363562363131313636383136313131303635363562363131313636383136313031343535366665613336353632376137613732333135383230626137343430336161663137326631316437636462326330376563613935613537326437373063363231346166333466316463396165323536353765343336313663363537383730363537323639366436353665373436313663663536343733366636633633343330303035306630303430222c2273746f72616765223a7b22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030223a22307830303030303030303030303030303030303030303030303030303030303030304445614430303043227d7d2c22307830303030303030303030303030303030303030303030303030303030303030306465616430303039223a7b2262616c616e6365223a2230222c226e6f6e6365223a32322c22726f6f74223a2235366538316631373162636335356136666638333435653639326330663836653562343865303162393936636164633030313632326662356533363362343231222c22636f646548617368223a2263356432343630313836663732333363393237653764623264636337303363306535303062363533636138323237336237626661643830343564383561343730227d2c22307830303030303030303030303030303030303030303030303030303030303030306465616430303062223a7b2262616c616e6365223a2230222c226e6f6e6365223a312c22726f6f74223a2239633734613933306535666530666434323035326165363234383566633165393864633366393462346137323464623031643734396231633630393065343331222c22636f646548617368223a2265386337656131343331663239353030363739623133383262343435363739366663336263316239653238623837646238313834336666633331336235633161222c22636f6465223a22363038303630343035323334383031353631303031303537363030303830666435623530363030343336313036313030613935373630303033353630653031633830363338346537623939663131363130303731353738303633383465376239396631343631303131653537383036333935373930386431313436313031333335373830363362613735626264383134363130313362353738303633626433363763666531343631303134333537383036336332636636393666313436313031346235373830363363356364393163643134363130313533353736313030613935363562383036333164306533626363313436313030616535373830363332333263646565363134363130306363353738303633336661356630633831343631303065633537383036333539653032646437313436313030663435373830363336383166653730633134363130313039353735623630303038306664356236313030623636313031373535363562363034303531363130306333393139303631306161383536356236303430353138303931303339306633356236313030646636313030646133363630303436313037373235363562363130313931353635623630343035313631303063333931393036313039383435363562363130306236363130323138353635623631303066633631303233343536356236303430353136313030633339313930363130613961353635623631303131313631303262353536356236303430353136313030633339313930363130613163353635623631303133313631303132633336363030343631303730613536356236313032633035363562303035623631303133313631303363623536356236313030623636313034306435363562363130306236363130343133353635623631303062363631303431393536356236313031363636313031363133363630303436313037613735363562363130343165353635623630343035313631303063333933393239313930363130613261353635623630303036313031376636313035666635363562363130313837363130323334353635623630323030313531393135303530393035363562363030303830353436303430353136336266343066616331363065303162383135323630303136303031363061303162303339303931313639303633626634306661633139303631303163323930383539303630303430313631306135323536356236303230363034303531383038333033383138363830336231353830313536313031646135373630303038306664356235303561666131353830313536313031656535373364363030303830336533643630303066643562353035303530353036303430353133643630316631393630316638323031313638323031383036303430353235303631303231323931393038313031393036313036656335363562393239313530353035363562363030303631303232323631303566663536356236313032326136313032333435363562363034303031353139313530353039303536356236313032336336313035666635363562363130323434363130326235353635623135363130323661353736303430353136323436316263643630653531623831353236303034303136313032363139303631306138613536356236303430353138303931303339306664356236303031363030323534383135343831313036313032373935376665356239303630303035323630323036303030323039303630303330323031363034303531383036303630303136303430353239303831363030303832303135343831353236303230303136303031383230313534383135323630323030313630303238323031353438313532353035303930353039303536356236303031353436303032353431303135393035363562363030613633666666666666666638343136303436313032643236313034346535363562363030313630303136306130316230333136363332623134396431363832363034303531383236336666666666666666313636306530316238313532363030343031363130326664393139303631306161383536356236303030363034303531383038333033383136303030383738303362313538303135363130333137353736303030383066643562353035616631313538303135363130333262353733643630303038303365336436303030666435623530353035303530363030303333393035303630363036313033373838323838383838383838383038303630316630313630323038303931303430323630323030313630343035313930383130313630343035323830393339323931393038313831353236303230303138333833383038323834333736303030393230313931393039313532353036313034383139323530353035303536356239303530376637663839376364303732663034316536386261353762653866306565633762383933336230623131333632326564386566383536383537363466366537393836383238383838383838383630343035313631303362313935393439333932393139303631303939323536356236303430353138303931303339306131363130336332383136313034623435363562353035303530353035303530353035363562363130336433363130353631353635623630303136303031363061303162303331363333363030313630303136306130316230333136313436313034303335373630343035313632343631626364363065353162383135323630303430313631303236313930363130613761353635623631303430623631303561313536356235363562363030323534383135363562363030313534393035363562363030613831353635623630303138313831353438313130363130343262353766653562363030303931383235323630323039303931323036303033393039313032303138303534363030313832303135343630303239303932303135343930393235303833353635623630303036313034376336303430353138303630343030313630343035323830363030623831353236303230303136613233623062396131623762373339626162366232623936306139316238313532353036313031393135363562393035303930353635623630363038343834383438343630343035313630323030313631303439613934393339323931393036313039643835363562363034303531363032303831383330333033383135323930363034303532393035303562393439333530353035303530353635623830353136303230393138323031323036303430383035313630363038313031383235323931383235323432393238323031393238333532343339303832303139303831353236303031383035343830383230313832353536303030393139303931353239313531376662313065326435323736313230373362323665656364666437313765366133323063663434623461666163326230373332643966636265326237666130636636363030333930393330323932383330313535393135313766623130653264353237363132303733623236656563646664373137653661333230636634346234616661633262303733326439666362653262376661306366373832303135353930353137666231306532643532373631323037336232366565636466643731376536613332306366343462346166616332623037333264396663626532623766613063663839303931303135353536356236303030363130343763363034303531383036303430303136303430353238303630313938313532363032303031376634333631366536663665363936333631366335343732363136653733363136333734363936663665343336383631363936653030303030303030303030303030383135323530363130313931353635623630303135343630303235343130363130356334353736303430353136323436316263643630653531623831353236303034303136313032363139303631306136613536356236303031363030323534383135343831313036313035643335376665356236303030393138323532363032303832323036303033393039313032303138313831353536303031383038323031383339303535363030323931383230313932393039323535383035343930393130313930353535363562363034303531383036303630303136303430353238303630303038303139313638313532363032303031363030303831353236303230303136303030383135323530393035363562383033353631303231323831363130623763353635623830353136313032313238313631306237633536356236303030383038333630316638343031313236313036346235373630303038306664356235303831333536376666666666666666666666666666666638313131313536313036363335373630303038306664356236303230383330313931353038333630303138323032383330313131313536313036376235373630303038306664356239323530393239303530353635623630303038323630316638333031313236313036393335373630303038306664356238313335363130366136363130366131383236313061646435363562363130616236353635623931353038303832353236303230383330313630323038333031383538333833303131313135363130366332353736303030383066643562363130366364383338323834363130623361353635623530353035303932393135303530353635623830333536313032313238313631306239333536356238303335363130323132383136313062396335363562363030303630323038323834303331323135363130366665353736303030383066643562363030303631303461633834383436313036326535363562363030303830363030303830363036303835383730333132313536313037323035373630303038306664356236303030363130373263383738373631303632333536356239343530353036303230363130373364383738323838303136313036653135363562393335303530363034303835303133353637666666666666666666666666666666663831313131353631303735613537363030303830666435623631303736363837383238383031363130363339353635623935393839343937353039353530353035303530353635623630303036303230383238343033313231353631303738343537363030303830666435623831333536376666666666666666666666666666666638313131313536313037396235373630303038306664356236313034616338343832383530313631303638323536356236303030363032303832383430333132313536313037623935373630303038306664356236303030363130346163383438343631303664363536356236313037636538313631306231323536356238323532353035303536356236313037636538313631306231643536356236313037636538313631306232323536356236303030363130376632383338353631306230393536356239333530363130376666383338353834363130623361353635623631303830383833363130623732353635623930393330313933393235303530353035363562363030303631303831643832363130623035353635623631303832373831383536313062303935363562393335303631303833373831383536303230383630313631306234363536356236313038303838313631306237323536356236303030363130383464363032323833363130623039353635623766343336313665366536663734323036343635373137353635373536353230363637323666366432303631366532303635366437303734373932303731373536353831353236313735363536306630316236303230383230313532363034303031393239313530353035363562363030303631303839313630346238333631306230393536356237663466366536633739323037343638363532303633363136653666366536393633363136633230373437323631366537333631363337343639366636653230363338313532376636383631363936653230363336313665323036343635373137353635373536353230346333313264336534633332323037313735363537353635323037343732363032303832303135323661333062373339623062316261333462376237333939373630613931623630343038323031353236303630303139323931353035303536356236303030363130393034363032353833363130623039353635623766353137353635373536353230363937333230363536643730373437393263323036653666323036353663363536643635366537343230373436663230373036353831353236343139356163383138356436306461316236303230383230313532363034303031393239313530353035363562383035313630363038333031393036313039346638343832363130376464353635623530363032303832303135313631303936323630323038353031383236313037646435363562353036303430383230313531363130393735363034303835303138323631303764643536356235303530353035303536356236313037636538313631306233313536356236303230383130313631303231323832383436313037633535363562363038303831303136313039613038323838363130376335353635623631303961643630323038333031383736313037633535363562363130396261363034303833303138363631303937623536356238313831303336303630383330313532363130396364383138343836363130376536353635623937393635303530353035303530353035303536356236303830383130313631303965363832383736313037633535363562363130396633363032303833303138363631303763353536356236313061303036303430383330313835363130393762353635623831383130333630363038333031353236313061313238313834363130383132353635623936393535303530353035303530353035363562363032303831303136313032313238323834363130376434353635623630363038313031363130613338383238363631303764643536356236313061343536303230383330313835363130376464353635623631303461633630343038333031383436313037646435363562363032303830383235323831303136313061363338313834363130383132353635623933393235303530353035363562363032303830383235323831303136313032313238313631303834303536356236303230383038323532383130313631303231323831363130383834353635623630323038303832353238313031363130323132383136313038663735363562363036303831303136313032313238323834363130393365353635623630323038313031363130323132383238343631303764643536356236303430353138313831303136376666666666666666666666666666666638313131383238323130313731353631306164353537363030303830666435623630343035323931393035303536356236303030363766666666666666666666666666666666383231313135363130616634353736303030383066643562353036303230363031663931393039313031363031663139313630313930353635623531393035363562393038313532363032303031393035363562363030303631303231323832363130623235353635623135313539303536356239303536356236303031363030313630613031623033313639303536356236336666666666666666313639303536356238323831383333373530363030303931303135323536356236303030356238333831313031353631306236313537383138313031353138333832303135323630323030313631306234393536356238333831313131353631303937353537353035303630303039313031353235363562363031663031363031663139313639303536356236313062383538313631306231323536356238313134363130623930353736303030383066643562353035363562363130623835383136313062323235363562363130623835383136313062333135366665613336353632376137613732333135383230663439356138393237646266666330316466616135323838623433353064653130623961383632356433646363353765326133633831666639306266623637643663363537383730363537323639366436353665373436313663663536343733366636633633343330303035306630303430222c2273746f72616765223a7b22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030223a22307830303030303030303030303030303030303030303030303030303030303030304445614430303043227d7d2c22307830303030303030303030303030303030303030303030303030303030303030306465616430303063223a7b2262616c616e6365223a2230222c226e6f6e6365223a312c22726f6f74223a2238383533636463346535623230633563336334376338373266386439356235613232353232383735343539666430356261306433666465636633383764393633222c22636f646548617368223a2237336439656435336631656663363136666662303937373361393735383666643335333464326161326431623331336463633462383261646535353964366565222c22636f6465223a2236303830363034303532333438303135363130303130353736303030383066643562353036303034333631303631303033363537363030303335363065303163383036333962326561346264313436313030336235373830363362663430666163313134363130306565353735623630303038306664356236313030656336303034383033363033363034303831313031353631303035313537363030303830666435623831303139303630323038313031383133353634303130303030303030303831313131353631303036633537363030303830666435623832303138333630323038323031313131353631303037653537363030303830666435623830333539303630323030313931383436303031383330323834303131313634303130303030303030303833313131373135363130306130353736303030383066643562393139303830383036303166303136303230383039313034303236303230303136303430353139303831303136303430353238303933393239313930383138313532363032303031383338333830383238343337363030303932303139313930393135323530393239353530353035303930333536303031363030313630613031623033313639313530363130316230393035303536356230303562363130313934363030343830333630333630323038313130313536313031303435373630303038306664356238313031393036303230383130313831333536343031303030303030303038313131313536313031316635373630303038306664356238323031383336303230383230313131313536313031333135373630303038306664356238303335393036303230303139313834363030313833303238343031313136343031303030303030303038333131313731353631303135333537363030303830666435623931393038303830363031663031363032303830393130343032363032303031363034303531393038313031363034303532383039333932393139303831383135323630323030313833383338303832383433373630303039323031393139303931353235303932393535303631303235383934353035303530353035303536356236303430383035313630303136303031363061303162303339303932313638323532353139303831393030333630323030313930663335623830363030303830383436303430353136303230303138303832383035313930363032303031393038303833383335623630323038333130363130316537353738303531383235323630316631393930393230313931363032303931383230313931303136313031633835363562363030313833363032303033363130313030306130333830313938323531313638313834353131363830383231373835353235303530353035303530353039303530303139313530353036303430353136303230383138333033303338313532393036303430353238303531393036303230303132303831353236303230303139303831353236303230303136303030323036303030363130313030306138313534383136303031363030313630613031623033303231393136393038333630303136303031363061303162303331363032313739303535353035303530353635623630303038303630303038333630343035313630323030313830383238303531393036303230303139303830383338333562363032303833313036313032393035373830353138323532363031663139393039323031393136303230393138323031393130313631303237313536356235313831353136303230393338343033363130313030306136303030313930313830313939303932313639313136313739303532363034303830353139323930393430313832383130333630316631393031383335323834353238313531393138313031393139303931323038363532383530313935393039353532393239303932303136303030323035343630303136303031363061303162303331363935393435303530353035303530353666656132363536323761376137323331353832303933643064636262313830356164316338313637353066613666383061626635643133653765353764663938623666313631376437623538323834396133656136343733366636633633343330303035306630303332222c2273746f72616765223a7b22307830373437613964663032393338383762333663313130393266386163306135623837343937323865383031383031346162353066303435653830306132346633223a22307830303030303030303030303030303030303030303030303030303030303030304465416430303030222c22307831386434306339383637636135653833353830383166646234393431613335653165636634363861313834303031623836303238623531323062633331613735223a22307830303030303030303030303030303030303030303030303030303030303030304445616430303038222c22307832636661616664643034326538316132623264366663373936373236366665373966313332653030313136383436336439373831613034326164346437653832223a22307830303030303030303030303030303030303030303030303030303030303030306465414430303045222c22307833303830326164343961623630306430333864316262373038376362383434363333376361656335393866346536343763656539333339653936353966653464223a22307830303030303030303030303030303030303030303030303030303030303030304445614430303062222c22307836383833316535616235313834663730356330616634323532346438643039613339613364386437306462653764653733343938366539643866636630323962223a22307830303030303030303030303030303030303030303030303030303030303030304445414430303031222c22307837333838643733623438326430373261633631666435313831653564343532313237306430383062383431366231353132333261666432663562346539633934223a22307834323030303030303030303030303030303030303030303030303030303030303030303030303032222c22307838653238356264383435653538653565336666663939313333616633363964303632613266633632363765353735636662303130386662326636323163323139223a22307830303030303030303030303030303030303030303030303030303030303030306445616430303035222c22307862326364363438316432313763373236313464643839356537353464663666613263366164643438303066336661663233626532323632356263613432313862223a22307830303030303030303030303030303030303030303030303030303030303030304465416430303036222c22307863323437366161373631646536636233366262323232626633373436653532396339393030393065353266636239613863623435616434366134306130636138223a22307830303030303030303030303030303030303030303030303030303030303030304465414430303034222c22307864383435323838363564343432323933316166353565393063616634383663663439336465353864383935396637376165653566313862623639313036323932223a22307830303030303030303030303030303030303030303030303030303030303030304445616430303033227d7d2c22307830303030303030303030303030303030303030303030303030303030303030306465616430303065223a7b2262616c616e6365223a2230222c226e6f6e6365223a312c22726f6f74223a2235366538316631373162636335356136666638333435653639326330663836653562343865303162393936636164633030313632326662356533363362343231222c22636f646548617368223a2234333865656339386136613437313930303036633431363531333464343832333263633463336437646635323831626233313065666539303834366537616632222c22636f6465223a223630383036303430353233343830313536313030313035373630303038306664356235303630303433363130363130303336353736303030333536306530316338303633323332636465653631343631303033623537383036336134346562353961313436313030666435373562363030303830666435623631303065313630303438303336303336303230383131303135363130303531353736303030383066643562383130313930363032303831303138313335363430313030303030303030383131313135363130303663353736303030383066643562383230313833363032303832303131313135363130303765353736303030383066643562383033353930363032303031393138343630303138333032383430313131363430313030303030303030383331313137313536313030613035373630303038306664356239313930383038303630316630313630323038303931303430323630323030313630343035313930383130313630343035323830393339323931393038313831353236303230303138333833383038323834333736303030393230313931393039313532353039323935353036313031623739343530353035303530353035363562363034303830353136303031363030313630613031623033393039323136383235323531393038313930303336303230303139306633356236313031613336303034383033363033363032303831313031353631303131333537363030303830666435623831303139303630323038313031383133353634303130303030303030303831313131353631303132653537363030303830666435623832303138333630323038323031313131353631303134303537363030303830666435623830333539303630323030313931383436303031383330323834303131313634303130303030303030303833313131373135363130313632353736303030383066643562393139303830383036303166303136303230383039313034303236303230303136303430353139303831303136303430353238303933393239313930383138313532363032303031383338333830383238343337363030303932303139313930393135323530393239353530363130323933393435303530353035303530353635623630343038303531393131353135383235323531393038313930303336303230303139306633356236303030383035343630343035313633626634306661633136306530316238313532363032303630303438323031383138313532383535313630323438343031353238353531363030313630303136306130316230333930393431363933363362663430666163313933383739333833393236303434393039323031393139303835303139303830383338333862356238333831313031353631303231373537383138313031353138333832303135323630323030313631303166663536356235303530353035303930353039303831303139303630316631363830313536313032343435373830383230333830353136303031383336303230303336313031303030613033313931363831353236303230303139313530356235303932353035303530363032303630343035313830383330333831383638303362313538303135363130323631353736303030383066643562353035616661313538303135363130323735353733643630303038303365336436303030666435623530353035303530363034303531336436303230383131303135363130323862353736303030383066643562353035313932393135303530353635623530363030313930353666656132363536323761376137323331353832303164346661376564373232633666346464643565613336396662663463623433316661343064333431636239653635306564383536373038666162316431396536343733366636633633343330303035306630303332227d2c22307834323030303030303030303030303030303030303030303030303030303030303030303030303030223a7b2262616c616e6365223a2230222c226e6f6e6365223a312c22726f6f74223a2235323338646638646666646563333333636361613866616130396537393236646138643466623039333535313532633334343839626439653262646431633561222c22636f646548617368223a2265356163393139313339343961383332613939323933333233623331363635636136626430303762636130333135346436346531323336616562613062313937222c22636f6465223a22363038303630343035323334383031353631303031303537363030303830666435623530363030343336313036313030326235373630303033353630653031633830363363616661383164633134363130303330353735623630303038306664356236313030643636303034383033363033363032303831313031353631303034363537363030303830666435623831303139303630323038313031383133353634303130303030303030303831313131353631303036313537363030303830666435623832303138333630323038323031313131353631303037333537363030303830666435623830333539303630323030313931383436303031383330323834303131313634303130303030303030303833313131373135363130303935353736303030383066643562393139303830383036303166303136303230383039313034303236303230303136303430353139303831303136303430353238303933393239313930383138313532363032303031383338333830383238343337363030303932303139313930393135323530393239353530363130306438393435303530353035303530353635623030356236303030383035343630303138313031393039313535376634376236356336633961646639633961316634643636316365613030653361306265343962373762393064396235613032333437643535636266623763336635393036313031306436313031613835363562383336303430353138303834383135323630323030313833363030313630303136306130316230333136363030313630303136306130316230333136383135323630323030313830363032303031383238313033383235323833383138313531383135323630323030313931353038303531393036303230303139303830383338333630303035623833383131303135363130313639353738313831303135313833383230313532363032303031363130313531353635623530353035303530393035303930383130313930363031663136383031353631303139363537383038323033383035313630303138333630323030333631303130303061303331393136383135323630323030313931353035623530393435303530353035303530363034303531383039313033393061313530353635623630343038303531366136663736366434333431346334633435353232383239363061383162383135323930353139303831393030333630306230313831323036303031353438313833353236303030393236303031363030313630613031623033393039313136393038333930383036323037613132303831333638313836383835616631383036313031663435373364383266643562353035313934353035303530353035303930353666656132363536323761376137323331353832306231346431393332653530663563353432303930646165316364366563323561376265623234633364323332353965653364383562363665343466323861313936343733366636633633343330303035306630303332222c2273746f72616765223a7b22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303031223a22307830303030303030303030303030303030303030303030303030303030303030304465416430303030227d7d2c22307834323030303030303030303030303030303030303030303030303030303030303030303030303031223a7b2262616c616e6365223a2230222c226e6f6e6365223a312c22726f6f74223a2264363330386237333366323730623164343162616437646137343662643437646538643139343733646263393737386537336563333162343762336137376334222c22636f646548617368223a2230356638336232353530343535333661333930623938313133643338306561356230626438616439393262663663383431376433386136373664333563356535222c22636f6465223a22363038303630343035323334383031353631303031303537363030303830666435623530363030343336313036313030326235373630303033353630653031633830363364323033343130363134363130303330353735623630303038306664356236313030333836313030346535363562363034303531363130303435393139303631303132323536356236303430353138303931303339306633356236303030383036303030393035343930363130313030306139303034363030313630303136306130316230333136363030313630303136306130316230333136363364323033343130363630343035313831363366666666666666663136363065303162383135323630303430313630323036303430353138303833303338313630303038373830336231353830313536313030396635373630303038306664356235303561663131353830313536313030623335373364363030303830336533643630303066643562353035303530353036303430353133643630316631393630316638323031313638323031383036303430353235303631303064373931393038313031393036313030656435363562393035303930353635623830353136313030653738313631303134313536356239323931353035303536356236303030363032303832383430333132313536313030666635373630303038306664356236303030363130313062383438343631303064633536356239343933353035303530353035363562363130313163383136313031333035363562383235323530353035363562363032303831303136313030653738323834363130313133353635623630303036303031363030313630613031623033383231363631303065373536356236313031346138313631303133303536356238313134363130313535353736303030383066643562353035366665613336353632376137613732333135383230383236636561336432653966653064343734643363653665323165613366623637633733383937613765363939346539643937643262666632653032363435623663363537383730363537323639366436353665373436313663663536343733366636633633343330303035306630303430222c2273746f72616765223a7b22307830303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030223a22307830303030303030303030303030303030303030303030303030303030303030304465416430303030227d7d2c22307834323030303030303030303030303030303030303030303030303030303030303030303030303032223a7b2262616c616e6365223a2230222c226e6f6e6365223a312c22726f6f74223a2235366538316631373162636335356136666638333435653639326330663836653562343865303162393936636164633030313632326662356533363362343231222c22636f646548617368223a2234303434653965616463646631356332613035333038383239333935663962643962653464313365626333643238646436363335646638613330343430376136222c22636f6465223a2236303830363034303532333438303135363130303130353736303030383066643562353036303034333631303631303037643537363030303335363065303163383036333430306164613735313136313030356235373830363334303061646137353134363130306433353738303633623135343061303131343631303065363537383036336264633762353466313436313031303635373830363364353333383837613134363130313065353736313030376435363562383036333038666436333232313436313030383235373830363331336166343033353134363130303937353738303633333336346537366331343631303061613537356236303030383066643562363130303935363130303930333636303034363130356236353635623631303132313536356230303562363130303935363130306135333636303034363130353930353635623631303139383536356236313030626436313030623833363630303436313036306535363562363130316566353635623630343035313631303063613931393036313037366435363562363034303531383039313033393066333562363130303935363130306531333636303034363130356236353635623631303262343536356236313030663936313030663433363630303436313035393035363562363130333162353635623630343035313631303063613931393036313037356635363562363130303935363130333736353635623631303039353631303131633336363030343631303566303536356236313033633735363562363030303631303132643630313136313031656635363562393035303630303036313031333936313034306535363562393035303831383131343631303136333537363034303531363234363162636436306535316238313532363030343031363130313561393036313037393635363562363034303531383039313033393066643562363030303833363130313731353736303030363130313734353635623630303135623630666631363930353036313031393136303031363030313630363031623033313936303630383739303162313638323631303462343536356235303530353035303530353635623630303036313031613436303131363130316566353635623930353036303030363130316230363130343065353635623930353038313831313436313031643135373630343035313632343631626364363065353162383135323630303430313631303135613930363130373936353635623631303165613630313136303031363030313630363031623033313936303630383639303162313636313034623435363562353035303530353635623630303038303630343035313631303166653930363130373365353635623630343035313830393130333930323039303530363036303333363030313630303136306130316230333136383238353630343035313630323430313631303232363931393036313037366435363562363034303830353136303166313938313834303330313831353239313831353236303230383230313830353136303031363030313630653031623033313636303031363030313630653031623033313939303934313639333930393331373930393235323930353136313032363439313930363130373262353635623630303036303430353138303833303338313630303038363561663139313530353033643830363030303831313436313032613135373630343035313931353036303166313936303366336430313136383230313630343035323364383235323364363030303630323038343031336536313032613635363562363036303931353035623530363032303031353139353934353035303530353035303536356236303030363130326330363031303631303165663536356239303530383031353631303263653537353036313033313735363562363130326461363031303630303136313034623435363562363130326633363031313630303136303031363036303162303331393630363038363930316231363631303462343536356236303030383236313033303135373630303036313033303435363562363030313562363066663136393035303631303331343630313238323631303462343536356235303530356235303530353635623630303038303631303332383630313236313031656635363562363030313134393035303630303036313033343636303031363030313630363031623033313936303630383639303162313636313031656635363562363030313134393035303630303036313033353736303130363130316566353635623135313539303530383238303631303336343537353038313562383036313033366435373530383031353562393539343530353035303530353035363562363030303631303338323630313136313031656635363562393035303630303036313033386536313034306535363562393035303831383131343631303361663537363034303531363234363162636436306535316238313532363030343031363130313561393036313037393635363562363130336262363031323630303136313034623435363562363130333137363031313630303036313034623435363562363030303631303364333630313136313031656635363562393035303630303036313033646636313034306535363562393035303831383131343631303430303537363034303531363234363162636436306535316238313532363030343031363130313561393036313037393635363562363030303833363130333031353736303030363130333034353635623630303038303630343035313631303431643930363130373534353635623630343038303531393138323930303338323230363030343833353236303234383330313832353236303230383330313830353136303031363030313630653031623033313636303031363030313630653031623033313938333136313739303532393035313930393235303630363039313333393136313034363239313930363130373262353635623630303036303430353138303833303338313630303038363561663139313530353033643830363030303831313436313034396635373630343035313931353036303166313936303366336430313136383230313630343035323364383235323364363030303630323038343031336536313034613435363562363036303931353035623530363032303031353136303630316239333530353035303530393035363562363030303630343035313631303463323930363130373439353635623630343035313930383139303033383132303931353033333930383239303631303465313930383639303836393036303234303136313037376235363562363034303830353136303166313938313834303330313831353239313831353236303230383230313830353136303031363030313630653031623033313636303031363030313630653031623033313939303934313639333930393331373930393235323930353136313035316639313930363130373262353635623630303036303430353138303833303338313630303038363561663139313530353033643830363030303831313436313035356335373630343035313931353036303166313936303366336430313136383230313630343035323364383235323364363030303630323038343031336536313035363135363562363036303931353035623530353035303530353035303536356238303335363130353734383136313038303335363562393239313530353035363562383033353631303537343831363130383161353635623830333536313035373438313631303832333536356236303030363032303832383430333132313536313035613235373630303038306664356236303030363130356165383438343631303536393536356239343933353035303530353035363562363030303830363034303833383530333132313536313035633935373630303038306664356236303030363130356435383538353631303536393536356239323530353036303230363130356536383538323836303136313035376135363562393135303530393235303932393035303536356236303030363032303832383430333132313536313036303235373630303038306664356236303030363130356165383438343631303537613536356236303030363032303832383430333132313536313036323035373630303038306664356236303030363130356165383438343631303538353536356236313036333538313631303763333536356238323532353035303536356236313036333538313631303763383536356236303030363130363466383236313037613635363562363130363539383138353631303761613536356239333530363130363639383138353630323038363031363130376437353635623932393039323031393239313530353035363562363030303631303638303630323238333631303761663536356237663466366536633739323036663737366536353732323036333631366532303633363136633663323037343638363937333230363637353665363337343639366638313532363133373137363066313162363032303832303135323630343030313932393135303530353635623630303036313036633436303061383336313037616135363562363936663736366435333463346634313434323832393630623031623831353236303061303139323931353035303536356236303030363130366561363030623833363130376161353635623661366637363664353335333534346635323435323832393630613831623831353236303062303139323931353035303536356236303030363130373131363030623833363130376161353635623661366637363664343334313463346334353532323832393630613831623831353236303062303139323931353035303536356236303030363130373337383238343631303634343536356239333932353035303530353635623630303036313035373438323631303662373536356236303030363130353734383236313036646435363562363030303631303537343832363130373034353635623630323038313031363130353734383238343631303632633536356236303230383130313631303537343832383436313036336235363562363034303831303136313037383938323835363130363362353635623631303733373630323038333031383436313036336235363562363032303830383235323831303136313035373438313631303637333536356235313930353635623931393035303536356239303831353236303230303139303536356236303030363130353734383236313037636235363562313531353930353635623930353635623630303136303031363061303162303331363930353635623630303035623833383131303135363130376632353738313831303135313833383230313532363032303031363130376461353635623833383131313135363130333134353735303530363030303931303135323536356236313038306338313631303762383536356238313134363130383137353736303030383066643562353035363562363130383063383136313037633335363562363130383063383136313037633835366665613336353632376137613732333135383230663636613936643132353330363038646133323632643935393433323538626366356562646263326463633365343331613566376262393737653665326637643663363537383730363537323639366436353665373436313663663536343733366636633633343330303035306630303430227d7d7d"
@@ -1,43 +1,71 @@
 package vm
 
 import (
-	"bytes"
-	"encoding/binary"
 	"errors"
 	"fmt"
+	"math/big"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 )
 
-type stateManagerFunction func(*EVM, *Contract, []byte) ([]byte, error)
+// stateManagerFunction implements a state manager method. It is called with the
+// decoded method arguments and returns the values of the method outputs.
+type stateManagerFunction func(*EVM, *Contract, []interface{}) ([]interface{}, error)
+
+type stateManagerMethod struct {
+	abi.Method
+	run stateManagerFunction
+}
 
 var funcs = map[string]stateManagerFunction{
-	"getStorage(address,bytes32)":                   getStorage,
-	"setStorage(address,bytes32,bytes32)":           setStorage,
-	"getOvmContractNonce(address)":                  getOvmContractNonce,
-	"incrementOvmContractNonce(address)":            incrementOvmContractNonce,
-	"getCodeContractBytecode(address)":              getCodeContractBytecode,
-	"getCodeContractHash(address)":                  getCodeContractHash,
-	"getCodeContractAddressFromOvmAddress(address)": getCodeContractAddress,
-	"associateCodeContract(address,address)":        associateCodeContract,
-	"registerCreatedContract(address)":              registerCreatedContract,
-}
-var methodIds map[[4]byte]stateManagerFunction
+	"getStorage":                           getStorage,
+	"setStorage":                           setStorage,
+	"getOvmContractNonce":                  getOvmContractNonce,
+	"incrementOvmContractNonce":            incrementOvmContractNonce,
+	"getCodeContractBytecode":              getCodeContractBytecode,
+	"getCodeContractHash":                  getCodeContractHash,
+	"getCodeContractAddressFromOvmAddress": getCodeContractAddress,
+	"associateCodeContract":                associateCodeContract,
+	"registerCreatedContract":              registerCreatedContract,
+}
+var methodIds map[[4]byte]stateManagerMethod
+
+// revertReason is the ABI of the Error(string) revert data.
+var revertReason abi.Arguments
 
 func init() {
-	methodIds = make(map[[4]byte]stateManagerFunction, len(funcs))
-	for methodSignature, f := range funcs {
-		methodIds[methodSignatureToMethodID(methodSignature)] = f
+	stateManagerAbi, err := abi.JSON(strings.NewReader(RawStateManagerAbi))
+	if err != nil {
+		panic(fmt.Sprintf("invalid state manager ABI: %v", err))
+	}
+	methodIds = make(map[[4]byte]stateManagerMethod, len(funcs))
+	for name, f := range funcs {
+		method, ok := stateManagerAbi.Methods[name]
+		if !ok {
+			panic(fmt.Sprintf("state manager method %s missing from ABI", name))
+		}
+		var methodID [4]byte
+		copy(methodID[:], method.ID())
+		methodIds[methodID] = stateManagerMethod{Method: method, run: f}
 	}
+	stringType, _ := abi.NewType("string", "", nil)
+	revertReason = abi.Arguments{{Type: stringType}}
 }
 
-func methodSignatureToMethodID(methodSignature string) [4]byte {
-	var methodID [4]byte
-	copy(methodID[:], crypto.Keccak256([]byte(methodSignature)))
-	return methodID
+// revertStateManagerCall returns the Error(string) revert data for a state
+// manager call that could not be executed, along with the revert error.
+func revertStateManagerCall(reason string) ([]byte, error) {
+	log.Warn("Reverting state manager call", "reason", reason)
+	data, err := revertReason.Pack(reason)
+	if err != nil {
+		return nil, err
+	}
+	return append(crypto.Keccak256([]byte("Error(string)"))[:4], data...), errExecutionReverted
 }
 
 func callStateManager(input []byte, evm *EVM, contract *Contract) (ret []byte, err error) {
@@ -45,92 +73,120 @@ func callStateManager(input []byte, evm *EVM, contract *Contract) (ret []byte, e
 	if len(input) == 0 {
 		return nil, nil
 	}
+	if len(input) < len(methodID) {
+		return revertStateManagerCall(fmt.Sprintf("state manager call too short: %#x", input))
+	}
 	copy(methodID[:], input[:4])
 
-	if method, ok := methodIds[methodID]; ok {
-		return method(evm, contract, input)
+	method, ok := methodIds[methodID]
+	if !ok {
+		return revertStateManagerCall(fmt.Sprintf("state manager call not found: %#x", methodID))
 	}
-
-	return nil, fmt.Errorf("state manager call not found: %s", methodID)
+	args, err := method.Inputs.UnpackValues(input[4:])
+	if err != nil {
+		return revertStateManagerCall(fmt.Sprintf("invalid %s call: %v", method.Name, err))
+	}
+	outputs, err := method.run(evm, contract, args)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(outputs...)
 }
 
-func setStorage(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
-	address := common.BytesToAddress(input[4:36])
-	key := common.BytesToHash(input[36:68])
-	val := common.BytesToHash(input[68:100])
-	log.Debug("[State Mgr] Setting storage.", "Contract address", address.Hex(), "key", hexutil.Encode(key.Bytes()), "val", hexutil.Encode(val.Bytes()))
+func setStorage(evm *EVM, contract *Contract, args []interface{}) ([]interface{}, error) {
+	address := args[0].(common.Address)
+	key := common.Hash(args[1].([32]byte))
+	val := common.Hash(args[2].([32]byte))
+	log.Debug("[State Mgr] Setting storage.", "Contract address", address, "key", key, "val", val)
 	evm.StateDB.SetState(address, key, val)
 	return nil, nil
 }
 
-func getStorage(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
-	address := common.BytesToAddress(input[4:36])
-	key := common.BytesToHash(input[36:68])
+func getStorage(evm *EVM, contract *Contract, args []interface{}) ([]interface{}, error) {
+	address := args[0].(common.Address)
+	key := common.Hash(args[1].([32]byte))
 	val := evm.StateDB.GetState(address, key)
-	log.Debug("[State Mgr] Getting storage.", "Contract address", hexutil.Encode(address.Bytes()), "key", hexutil.Encode(key.Bytes()), "val", hexutil.Encode(val.Bytes()))
-	return val.Bytes(), nil
+	log.Debug("[State Mgr] Getting storage.", "Contract address", address, "key", key, "val", val)
+	return []interface{}{[32]byte(val)}, nil
 }
 
-func getCodeContractBytecode(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
-	address := common.BytesToAddress(input[4:36])
+// codeContractMappingSlot is the storage slot of the state manager holding the
+// mapping from OVM contract addresses to the addresses of their code contracts.
+var codeContractMappingSlot = common.BigToHash(big.NewInt(2))
+
+// codeContractKey returns the state manager storage key holding the code contract
+// address of an OVM contract, laid out as a Solidity mapping.
+func codeContractKey(ovmAddress common.Address) common.Hash {
+	return crypto.Keccak256Hash(common.LeftPadBytes(ovmAddress.Bytes(), 32), codeContractMappingSlot.Bytes())
+}
+
+// codeContractAddress returns the address holding the code of an OVM contract.
+// Contracts which were never associated with a code contract hold their own code.
+func codeContractAddress(evm *EVM, ovmAddress common.Address) common.Address {
+	if codeAddress := evm.StateDB.GetState(evm.ovmContracts.StateManager, codeContractKey(ovmAddress)); codeAddress != (common.Hash{}) {
+		return common.BytesToAddress(codeAddress.Bytes())
+	}
+	return ovmAddress
+}
+
+func getCodeContractBytecode(evm *EVM, contract *Contract, args []interface{}) ([]interface{}, error) {
+	address := codeContractAddress(evm, args[0].(common.Address))
 	code := evm.StateDB.GetCode(address)
-	log.Debug("[State Mgr] Getting Bytecode.", "Contract address", hexutil.Encode(address.Bytes()), "Code", hexutil.Encode(code))
-	return simpleAbiEncode(code), nil
+	log.Debug("[State Mgr] Getting Bytecode.", "Contract address", address, "Code", hexutil.Bytes(code))
+	return []interface{}{code}, nil
 }
 
-func getCodeContractHash(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
-	address := common.BytesToAddress(input[4:36])
+func getCodeContractHash(evm *EVM, contract *Contract, args []interface{}) ([]interface{}, error) {
+	address := codeContractAddress(evm, args[0].(common.Address))
 	codeHash := evm.StateDB.GetCodeHash(address)
-	log.Debug("[State Mgr] Getting Code Hash.", "Contract address:", hexutil.Encode(address.Bytes()), "Code hash", hexutil.Encode(codeHash.Bytes()))
-	return codeHash.Bytes(), nil
+	log.Debug("[State Mgr] Getting Code Hash.", "Contract address:", address, "Code hash", codeHash)
+	return []interface{}{[32]byte(codeHash)}, nil
 }
 
-func associateCodeContract(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
-	log.Debug("[State Mgr] Associating code contract")
-	return []byte{}, nil
+func associateCodeContract(evm *EVM, contract *Contract, args []interface{}) ([]interface{}, error) {
+	ovmAddress := args[0].(common.Address)
+	codeAddress := args[1].(common.Address)
+	log.Debug("[State Mgr] Associating code contract.", "OVM address", ovmAddress, "Code contract address", codeAddress)
+	evm.StateDB.SetState(evm.ovmContracts.StateManager, codeContractKey(ovmAddress), common.BytesToHash(codeAddress.Bytes()))
+	return nil, nil
 }
 
-func registerCreatedContract(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
-	log.Debug("[State Mgr] Registering created contract")
-	return []byte{}, nil
+// registerCreatedContract makes sure that a newly created contract has an entry in
+// the code contract mapping, associating it with itself if the Execution Manager
+// did not associate it with a code contract.
+func registerCreatedContract(evm *EVM, contract *Contract, args []interface{}) ([]interface{}, error) {
+	ovmAddress := args[0].(common.Address)
+	log.Debug("[State Mgr] Registering created contract.", "OVM address", ovmAddress)
+	key := codeContractKey(ovmAddress)
+	if evm.StateDB.GetState(evm.ovmContracts.StateManager, key) == (common.Hash{}) {
+		evm.StateDB.SetState(evm.ovmContracts.StateManager, key, common.BytesToHash(ovmAddress.Bytes()))
+	}
+	return nil, nil
 }
 
-func getCodeContractAddress(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
-	address := input[4:36]
-	// Ensure 0x0000...deadXXXX is not called as they are banned addresses (the address space used for the OVM contracts)
-	bannedAddresses := []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 222, 173}
-	if bytes.Equal(input[16:34], bannedAddresses) {
-		log.Error("[State Mgr] forbidden 0x...DEAD address access!", "Address", hexutil.Encode(address))
-		return nil, errors.New("forbidden 0x...DEAD address access")
+func getCodeContractAddress(evm *EVM, contract *Contract, args []interface{}) ([]interface{}, error) {
+	address := args[0].(common.Address)
+	// Ensure the system contracts are not called as their addresses are banned
+	if evm.ovmContracts.IsSystemAddress(address) {
+		log.Error("[State Mgr] forbidden system address access!", "Address", address)
+		return nil, errors.New("forbidden system address access")
 	}
-	log.Debug("[State Mgr] Getting code contract.", "address", hexutil.Encode(address))
-	return address, nil
+	codeAddress := codeContractAddress(evm, address)
+	log.Debug("[State Mgr] Getting code contract.", "address", address, "Code contract address", codeAddress)
+	return []interface{}{codeAddress}, nil
 }
 
-func getOvmContractNonce(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
-	address := common.BytesToAddress(input[4:36])
-	b := make([]byte, 8)
-	binary.BigEndian.PutUint64(b, evm.StateDB.GetNonce(address))
-	val := append(make([]byte, 24), b[:]...)
-	log.Debug("[State Mgr] Getting nonce.", "Contract address", hexutil.Encode(address.Bytes()), "Nonce", evm.StateDB.GetNonce(address))
-	return val, nil
+func getOvmContractNonce(evm *EVM, contract *Contract, args []interface{}) ([]interface{}, error) {
+	address := args[0].(common.Address)
+	nonce := evm.StateDB.GetNonce(address)
+	log.Debug("[State Mgr] Getting nonce.", "Contract address", address, "Nonce", nonce)
+	return []interface{}{new(big.Int).SetUint64(nonce)}, nil
 }
 
-func incrementOvmContractNonce(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
-	address := common.BytesToAddress(input[4:36])
+func incrementOvmContractNonce(evm *EVM, contract *Contract, args []interface{}) ([]interface{}, error) {
+	address := args[0].(common.Address)
 	oldNonce := evm.StateDB.GetNonce(address)
 	evm.StateDB.SetNonce(address, oldNonce+1)
-	log.Debug("[State Mgr] Incrementing nonce.", " Contract address", hexutil.Encode(address.Bytes()), "Nonce", oldNonce+1)
+	log.Debug("[State Mgr] Incrementing nonce.", " Contract address", address, "Nonce", oldNonce+1)
 	return nil, nil
 }
-
-func simpleAbiEncode(bytes []byte) []byte {
-	encodedCode := make([]byte, WORD_SIZE)
-	binary.BigEndian.PutUint64(encodedCode[WORD_SIZE-8:], uint64(len(bytes)))
-	padding := make([]byte, len(bytes)%WORD_SIZE)
-	codeWithLength := append(append(encodedCode, bytes...), padding...)
-	offset := make([]byte, WORD_SIZE)
-	// Hardcode a 2 because we will only return dynamic bytes with a single element
-	binary.BigEndian.PutUint64(offset[WORD_SIZE-8:], uint64(2))
-	return append([]byte{0, 0}, append(offset, codeWithLength...)...)
-}
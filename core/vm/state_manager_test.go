@@ -0,0 +1,142 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package vm
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func newStateManagerEVM() *EVM {
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()))
+	config := *params.TestChainConfig
+	config.OVM = &params.OVMConfig{}
+	return NewEVM(Context{BlockNumber: big.NewInt(0)}, statedb, &config, Config{})
+}
+
+func TestStateManagerMalformedInput(t *testing.T) {
+	stateManagerAbi, _ := abi.JSON(strings.NewReader(RawStateManagerAbi))
+	setStorage, _ := stateManagerAbi.Pack("setStorage", common.HexToAddress("0x1234"), [32]byte{1}, [32]byte{2})
+
+	tests := []struct {
+		input  []byte
+		reason string
+	}{
+		{[]byte{0x01, 0x02}, "state manager call too short: 0x0102"},
+		{[]byte{0x01, 0x02, 0x03, 0x04}, "state manager call not found: 0x01020304"},
+		{setStorage[:68], "invalid setStorage call: abi: cannot marshal in to go type: length insufficient 64 require 96"},
+	}
+	for i, tt := range tests {
+		evm := newStateManagerEVM()
+		ret, err := callStateManager(tt.input, evm, nil)
+		if err != errExecutionReverted {
+			t.Fatalf("test %d: error mismatch: have %v, want %v", i, err, errExecutionReverted)
+		}
+		if !bytes.Equal(ret[:4], []byte{0x08, 0xc3, 0x79, 0xa0}) {
+			t.Fatalf("test %d: revert data is not an Error(string): %x", i, ret)
+		}
+		var reason string
+		if err := revertReason.Unpack(&reason, ret[4:]); err != nil {
+			t.Fatalf("test %d: failed to decode revert reason: %v", i, err)
+		}
+		if reason != tt.reason {
+			t.Errorf("test %d: revert reason mismatch: have %q, want %q", i, reason, tt.reason)
+		}
+	}
+}
+
+func TestStateManagerEncoding(t *testing.T) {
+	stateManagerAbi, _ := abi.JSON(strings.NewReader(RawStateManagerAbi))
+	evm := newStateManagerEVM()
+	address := common.HexToAddress("0x1234")
+
+	// Code lengths around a word boundary exercise the padding of dynamic outputs.
+	for _, size := range []int{0, 1, 31, 32, 33, 64} {
+		code := bytes.Repeat([]byte{0xaa}, size)
+		evm.StateDB.SetCode(address, code)
+
+		input, _ := stateManagerAbi.Pack("getCodeContractBytecode", address)
+		ret, err := callStateManager(input, evm, nil)
+		if err != nil {
+			t.Fatalf("size %d: call failed: %v", size, err)
+		}
+		if len(ret)%32 != 0 {
+			t.Errorf("size %d: output is not word aligned: %d bytes", size, len(ret))
+		}
+		var decoded []byte
+		if err := stateManagerAbi.Unpack(&decoded, "getCodeContractBytecode", ret); err != nil {
+			t.Fatalf("size %d: failed to decode output: %v", size, err)
+		}
+		if !bytes.Equal(decoded, code) {
+			t.Errorf("size %d: bytecode mismatch: have %x, want %x", size, decoded, code)
+		}
+	}
+	evm.StateDB.SetNonce(address, 5)
+	input, _ := stateManagerAbi.Pack("getOvmContractNonce", address)
+	ret, err := callStateManager(input, evm, nil)
+	if err != nil {
+		t.Fatalf("nonce call failed: %v", err)
+	}
+	var nonce *big.Int
+	if err := stateManagerAbi.Unpack(&nonce, "getOvmContractNonce", ret); err != nil {
+		t.Fatalf("failed to decode nonce: %v", err)
+	}
+	if nonce.Uint64() != 5 {
+		t.Errorf("nonce mismatch: have %d, want %d", nonce, 5)
+	}
+}
+
+// Tests that the state manager is intercepted at the address given by the chain
+// configuration, which also decides the addresses that are off limits.
+func TestStateManagerCustomContracts(t *testing.T) {
+	stateManagerAbi, _ := abi.JSON(strings.NewReader(RawStateManagerAbi))
+	contracts := *params.OVMContractsV0
+	contracts.StateManager = common.HexToAddress("0x00000000000000000000000000000000beef0001")
+	contracts.SystemAddressPrefix = common.FromHex("0x00000000000000000000000000000000beef")
+
+	config := *params.TestChainConfig
+	config.OVM = &params.OVMConfig{Contracts: &contracts}
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()))
+	statedb.SetCode(contracts.StateManager, []byte{byte(STOP)})
+	evm := NewEVM(Context{BlockNumber: big.NewInt(0), CanTransfer: func(StateDB, common.Address, *big.Int) bool { return true }, Transfer: func(StateDB, common.Address, common.Address, *big.Int) {}}, statedb, &config, Config{})
+
+	tests := []struct {
+		address common.Address
+		fail    bool
+	}{
+		{common.HexToAddress("0x00000000000000000000000000000000dead9999"), false},
+		{common.HexToAddress("0x00000000000000000000000000000000beef9999"), true},
+	}
+	for i, tt := range tests {
+		input, _ := stateManagerAbi.Pack("getCodeContractAddressFromOvmAddress", tt.address)
+		ret, _, err := evm.Call(AccountRef(common.Address{}), contracts.StateManager, input, 100000, new(big.Int))
+		if (err != nil) != tt.fail {
+			t.Fatalf("test %d: error mismatch: have %v, want failure %v", i, err, tt.fail)
+		}
+		if !tt.fail && common.BytesToAddress(ret) != tt.address {
+			t.Errorf("test %d: code contract mismatch: have %x, want %x", i, ret, tt.address)
+		}
+	}
+}
@@ -7,6 +7,7 @@ HOSTNAME=${HOSTNAME:-0.0.0.0}
 PORT=${PORT:-8545}
 NETWORK_ID=${NETWORK_ID:-420}
 VOLUME_PATH=${VOLUME_PATH:-/mnt/l2geth}
+OVM_STATE_DUMP=${OVM_STATE_DUMP:-core/vm/ovm_state_dump.json}
 
 TARGET_GAS_LIMIT=${TARGET_GAS_LIMIT:-8000000}
 
@@ -22,6 +23,7 @@ if [ -n "$REBUILD" ]; then
 else
     echo "Starting Geth..."
     ./build/bin/geth --dev \
+      --dev.ovmstatedump $OVM_STATE_DUMP \
       --datadir $VOLUME_PATH \
       --rpc \
       --rpcaddr $HOSTNAME \
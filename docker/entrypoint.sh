@@ -5,6 +5,7 @@ HOSTNAME=${HOSTNAME:-0.0.0.0}
 PORT=${PORT:-8545}
 NETWORK_ID=${NETWORK_ID:-420}
 VOLUME_PATH=${VOLUME_PATH:-/mnt/l2geth}
+OVM_STATE_DUMP=${OVM_STATE_DUMP:-/etc/l2geth/ovm_state_dump.json}
 
 CLEAR_DATA_FILE_PATH="${VOLUME_PATH}/.clear_data_key_${CLEAR_DATA_KEY}"
 TARGET_GAS_LIMIT=${TARGET_GAS_LIMIT:-8000000}
@@ -26,6 +27,7 @@ fi
 echo "Starting Geth..."
 ## Command to kick off geth
 geth --dev \
+  --dev.ovmstatedump $OVM_STATE_DUMP \
   --datadir $VOLUME_PATH \
   --rpc \
   --rpcaddr $HOSTNAME \
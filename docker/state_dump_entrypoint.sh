@@ -4,12 +4,14 @@ HOSTNAME=${HOSTNAME:-0.0.0.0}
 PORT=${PORT:-8545}
 NETWORK_ID=${NETWORK_ID:-420}
 VOLUME_PATH=${VOLUME_PATH:-/mnt/l2geth}
+OVM_STATE_DUMP=${OVM_STATE_DUMP:-/etc/l2geth/ovm_state_dump.json}
 
 TARGET_GAS_LIMIT=${TARGET_GAS_LIMIT:-8000000}
 
 echo "Starting Geth in debug mode"
 ## Command to kick off geth
 geth --dev \
+  --dev.ovmstatedump $OVM_STATE_DUMP \
   --datadir $VOLUME_PATH \
   --rpc \
   --rpcaddr $HOSTNAME \
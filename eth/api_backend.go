@@ -18,9 +18,8 @@ package eth
 
 import (
 	"context"
-	"encoding/hex"
+	"crypto/ecdsa"
 	"errors"
-	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts"
@@ -47,12 +46,16 @@ type EthAPIBackend struct {
 	gpo           *gasprice.Oracle
 }
 
+// RollupTransactionSender returns the account configured to send rollup transactions,
+// or nil if rollup transaction submissions are disabled.
 func (b *EthAPIBackend) RollupTransactionSender() *common.Address {
-	// TODO: Fill out RollupTransactionsSender Address when we know it / get from env var
-	bites, _ := hex.DecodeString("6a399F0A626A505e2F6C2b5Da181d98D722dC86D")
-	addr := common.BytesToAddress(bites)
-	fmt.Printf("\n\n\nRollup Sender Address bytes: %x\nsender address: %x\n\n", string(bites), string(addr.Bytes()))
-	return &addr
+	return b.eth.config.Rollup.RollupTxSender
+}
+
+// RollupTransactionSenderKey returns the key of the rollup transaction sender if it was
+// loaded from a key file, or nil if the sender is managed by the account manager.
+func (b *EthAPIBackend) RollupTransactionSenderKey() *ecdsa.PrivateKey {
+	return b.eth.config.Rollup.RollupTxSenderKey
 }
 
 // ChainConfig returns the active chain configuration.
@@ -237,11 +240,19 @@ func (b *EthAPIBackend) SendTx(ctx context.Context, signedTx *types.Transaction)
 }
 
 func (b *EthAPIBackend) SendTxs(ctx context.Context, signedTxs []*types.Transaction) []error {
-	return b.eth.txPool.AddLocals(signedTxs)
+	return b.eth.txPool.AddLocalsAtomic(signedTxs)
+}
+
+func (b *EthAPIBackend) L1Context() types.L1Context {
+	return b.eth.blockchain.CurrentL1Context()
+}
+
+func (b *EthAPIBackend) SetL1Context(l1Context types.L1Context) {
+	b.eth.blockchain.SetCurrentL1Context(l1Context)
 }
 
-func (b *EthAPIBackend) SetTimestamp(timestamp int64) {
-	b.eth.blockchain.SetCurrentTimestamp(timestamp)
+func (b *EthAPIBackend) CheckSubmission(txs []*types.Transaction) error {
+	return b.eth.miner.CheckSubmission(txs)
 }
 
 func (b *EthAPIBackend) GetPoolTransactions() (types.Transactions, error) {
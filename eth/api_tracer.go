@@ -729,6 +729,15 @@ func (api *PrivateDebugAPI) traceTx(ctx context.Context, message core.Message, v
 		err    error
 	)
 	switch {
+	case config != nil && config.Tracer != nil && *config.Tracer == tracers.OvmCallTracerName:
+		chainConfig := api.eth.blockchain.Config()
+		if !chainConfig.IsOVM() {
+			return nil, fmt.Errorf("%s requires an OVM chain", tracers.OvmCallTracerName)
+		}
+		if tracer, err = tracers.NewOvmCallTracer(chainConfig.OVM.SystemContractsAt(vmctx.BlockNumber)); err != nil {
+			return nil, err
+		}
+
 	case config != nil && config.Tracer != nil:
 		// Define a meaningful timeout of a single transaction trace
 		timeout := defaultTraceTimeout
@@ -775,6 +784,9 @@ func (api *PrivateDebugAPI) traceTx(ctx context.Context, message core.Message, v
 	case *tracers.Tracer:
 		return tracer.GetResult()
 
+	case *tracers.OvmCallTracer:
+		return tracer.GetResult()
+
 	default:
 		panic(fmt.Sprintf("bad tracer type %T", tracer))
 	}
@@ -24,7 +24,6 @@ import (
 	"runtime"
 	"sync"
 	"sync/atomic"
-	"time"
 
 	"github.com/ethereum/go-ethereum/rollup"
 
@@ -93,6 +92,11 @@ type Ethereum struct {
 	// Transaction Ingestion Service
 	txIngestion *rollup.TxIngestion
 
+	// L1 transition batch submitter, nil if no L1 endpoint is configured
+	batchSubmitter *rollup.L1TransitionBatchSubmitter
+	batchCodec     rollup.BatchCodec
+	verifier       *rollup.Verifier
+
 	miner     *miner.Miner
 	gasPrice  *big.Int
 	etherbase common.Address
@@ -126,6 +130,9 @@ func New(ctx *node.ServiceContext, config *Config) (*Ethereum, error) {
 	if !config.SyncMode.IsValid() {
 		return nil, fmt.Errorf("invalid sync mode %d", config.SyncMode)
 	}
+	if err := config.Rollup.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid rollup config: %v", err)
+	}
 	if config.Miner.GasPrice == nil || config.Miner.GasPrice.Cmp(common.Big0) <= 0 {
 		log.Warn("Sanitizing invalid miner gas price", "provided", config.Miner.GasPrice, "updated", DefaultConfig.Miner.GasPrice)
 		config.Miner.GasPrice = new(big.Int).Set(DefaultConfig.Miner.GasPrice)
@@ -166,7 +173,7 @@ func New(ctx *node.ServiceContext, config *Config) (*Ethereum, error) {
 	if bcVersion != nil {
 		dbVer = fmt.Sprintf("%d", *bcVersion)
 	}
-	log.Info("Initialising Ethereum protocol", "versions", ProtocolVersions, "network", config.NetworkId, "dbversion", dbVer)
+	log.Info("Initialising Ethereum protocol", "versions", protocolVersions(chainConfig), "network", config.NetworkId, "dbversion", dbVer)
 
 	if !config.SkipBcVersionCheck {
 		if bcVersion != nil && *bcVersion > core.BlockChainVersion {
@@ -206,7 +213,13 @@ func New(ctx *node.ServiceContext, config *Config) (*Ethereum, error) {
 		config.TxPool.Journal = ctx.ResolvePath(config.TxPool.Journal)
 	}
 	eth.txPool = core.NewTxPool(config.TxPool, chainConfig, eth.blockchain)
-	eth.txIngestion = rollup.NewTxIngestion(config.Rollup, chainConfig, eth.txPool)
+	ingestionSource, err := rollup.NewIngestionSource(&config.Rollup, chainDb)
+	if err != nil {
+		return nil, err
+	}
+	if eth.txIngestion, err = rollup.NewTxIngestion(config.Rollup, chainConfig, eth.blockchain, eth.txPool, chainDb, ingestionSource); err != nil {
+		return nil, err
+	}
 
 	// Permit the downloader to use the trie cache allowance during fast sync
 	cacheLimit := cacheConfig.TrieCleanLimit + cacheConfig.TrieDirtyLimit
@@ -214,15 +227,60 @@ func New(ctx *node.ServiceContext, config *Config) (*Ethereum, error) {
 	if checkpoint == nil {
 		checkpoint = params.TrustedCheckpoints[genesisHash]
 	}
-	blockSubmitter := rollup.NewBlockSubmitter()
-	rollupBlockBuilder, e := rollup.NewTransitionBatchBuilder(chainDb, eth.blockchain, blockSubmitter, 5*time.Minute, 100_000_000_000, 200)
-	if e != nil {
-		return nil, e
+	var rollupBlockBuilder rollup.RollupTransitionBatchBuilder = rollup.NewDummyBatchBuilder()
+	if config.Rollup.IsBatchBuilderEnabled() {
+		if eth.batchCodec, err = rollup.BatchCodecByName(config.Rollup.BatchBuilderEncoding); err != nil {
+			return nil, err
+		}
+		var blockSubmitter interface{} = rollup.NewBlockSubmitter()
+		if config.Rollup.IsBatchSubmitterEnabled() {
+			if config.Rollup.BatchSubmitterL1Backend == nil {
+				return nil, fmt.Errorf("no L1 backend available for transition batch submission to %s", config.Rollup.BatchSubmitterL1Endpoint)
+			}
+			eth.batchSubmitter, err = rollup.NewL1TransitionBatchSubmitter(config.Rollup.BatchSubmitterL1Backend, config.Rollup.BatchSubmitterContractAddress, config.Rollup.BatchSubmitterKey, config.Rollup.BatchSubmitterL1ChainID, eth.batchCodec, config.Rollup.BatchSubmitterConfirmations, config.Rollup.BatchSubmitterPollInterval, config.Rollup.BatchSubmitterResubmitTimeout)
+			if err != nil {
+				return nil, err
+			}
+			blockSubmitter = eth.batchSubmitter
+		} else {
+			log.Warn("No L1 endpoint configured, transition batches will not be submitted")
+		}
+		if rollupBlockBuilder, err = rollup.NewTransitionBatchBuilder(chainDb, rollup.NewChainBlockStore(eth.blockchain), blockSubmitter, eth.batchCodec, config.Rollup.BatchBuilderMaxBatchAge, config.Rollup.BatchBuilderMaxBatchGas, config.Rollup.BatchBuilderMaxBatchTransactions, config.Rollup.BatchBuilderBackoff()); err != nil {
+			return nil, err
+		}
+	} else {
+		log.Info("Transition batch builder disabled")
+	}
+	if config.Rollup.IsVerifierEnabled() {
+		var source rollup.TransitionBatchSource
+		if config.Rollup.VerifierFixture != "" {
+			if source, err = rollup.NewFileBatchSource(config.Rollup.VerifierFixture); err != nil {
+				return nil, err
+			}
+		} else {
+			if config.Rollup.VerifierL1Backend == nil {
+				return nil, fmt.Errorf("no L1 backend available for verification against %s", config.Rollup.VerifierL1Endpoint)
+			}
+			source = rollup.NewL1BatchSource(config.Rollup.VerifierL1Backend, config.Rollup.VerifierContractAddress, config.Rollup.VerifierStartBlock, config.Rollup.VerifierConfirmations)
+		}
+		if eth.verifier, err = rollup.NewVerifier(chainDb, eth.blockchain, source, config.Rollup.VerifierPollInterval); err != nil {
+			return nil, err
+		}
 	}
 
 	if eth.protocolManager, err = NewProtocolManager(chainConfig, checkpoint, config.SyncMode, config.NetworkId, eth.eventMux, eth.txPool, eth.engine, eth.blockchain, chainDb, cacheLimit, config.Whitelist, rollupBlockBuilder); err != nil {
 		return nil, err
 	}
+	// Mined blocks must fit in a transition batch, whose transitions are never split.
+	if config.Rollup.IsBatchBuilderEnabled() {
+		if config.Miner.MaxTransactions == 0 || config.Miner.MaxTransactions > config.Rollup.BatchBuilderMaxBatchTransactions {
+			config.Miner.MaxTransactions = config.Rollup.BatchBuilderMaxBatchTransactions
+		}
+		codec, maxGas, maxTxs := eth.batchCodec, config.Rollup.BatchBuilderMaxBatchGas, config.Rollup.BatchBuilderMaxBatchTransactions
+		config.Miner.NewBlockBound = func(header *types.Header) miner.BlockBound {
+			return rollup.NewBlockBatch(header, codec, maxGas, maxTxs)
+		}
+	}
 	eth.miner = miner.New(eth, &config.Miner, chainConfig, eth.EventMux(), eth.engine, eth.isLocalBlock)
 	eth.miner.SetExtra(makeExtraData(config.Miner.ExtraData))
 
@@ -346,6 +404,11 @@ func (s *Ethereum) APIs() []rpc.API {
 			Version:   "1.0",
 			Service:   s.netRPCService,
 			Public:    true,
+		}, {
+			Namespace: "rollup",
+			Version:   "1.0",
+			Service:   rollup.NewPublicRollupAPI(s.chainDb, s.blockchain, s.protocolManager.rollupBatchBuilder, s.batchCodec, s.verifier, s.txIngestion),
+			Public:    true,
 		},
 	}...)
 }
@@ -510,7 +573,7 @@ func (s *Ethereum) EventMux() *event.TypeMux           { return s.eventMux }
 func (s *Ethereum) Engine() consensus.Engine           { return s.engine }
 func (s *Ethereum) ChainDb() ethdb.Database            { return s.chainDb }
 func (s *Ethereum) IsListening() bool                  { return true } // Always listening
-func (s *Ethereum) EthVersion() int                    { return int(ProtocolVersions[0]) }
+func (s *Ethereum) EthVersion() int                    { return int(protocolVersions(s.blockchain.Config())[0]) }
 func (s *Ethereum) NetVersion() uint64                 { return s.networkID }
 func (s *Ethereum) Downloader() *downloader.Downloader { return s.protocolManager.downloader }
 func (s *Ethereum) Synced() bool                       { return atomic.LoadUint32(&s.protocolManager.acceptTxs) == 1 }
@@ -519,8 +582,9 @@ func (s *Ethereum) ArchiveMode() bool                  { return s.config.NoPruni
 // Protocols implements node.Service, returning all the currently configured
 // network protocols to start.
 func (s *Ethereum) Protocols() []p2p.Protocol {
-	protos := make([]p2p.Protocol, len(ProtocolVersions))
-	for i, vsn := range ProtocolVersions {
+	versions := protocolVersions(s.blockchain.Config())
+	protos := make([]p2p.Protocol, len(versions))
+	for i, vsn := range versions {
 		protos[i] = s.protocolManager.makeProtocol(vsn)
 		protos[i].Attributes = []enr.Entry{s.currentEthEntry()}
 	}
@@ -560,10 +624,17 @@ func (s *Ethereum) Start(srvr *p2p.Server) error {
 // Stop implements node.Service, terminating all internal goroutines used by the
 // Ethereum protocol.
 func (s *Ethereum) Stop() error {
+	if s.verifier != nil {
+		s.verifier.Stop()
+	}
 	s.bloomIndexer.Close()
 	s.blockchain.Stop()
 	s.engine.Close()
 	s.protocolManager.Stop()
+	if s.batchSubmitter != nil {
+		s.batchSubmitter.Stop()
+	}
+	s.txIngestion.Stop()
 	if s.lesServer != nil {
 		s.lesServer.Stop()
 	}
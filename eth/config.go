@@ -70,6 +70,23 @@ var DefaultConfig = Config{
 		TxIngestionPollInterval: 100 * time.Millisecond,
 		TxIngestionDBUser:       "test",
 		TxIngestionDBPassword:   "test",
+
+		TxIngestionL1Confirmations: 6,
+
+		BatchBuilderEnable:               true,
+		BatchBuilderMaxBatchAge:          5 * time.Minute,
+		BatchBuilderMaxBatchGas:          100_000_000_000,
+		BatchBuilderMaxBatchTransactions: 200,
+		BatchBuilderEncoding:             "zlib",
+		BatchBuilderMinBackoff:           time.Second,
+		BatchBuilderMaxBackoff:           5 * time.Minute,
+
+		BatchSubmitterConfirmations:   6,
+		BatchSubmitterPollInterval:    time.Second,
+		BatchSubmitterResubmitTimeout: 3 * time.Minute,
+
+		VerifierConfirmations: 6,
+		VerifierPollInterval:  time.Second,
 	},
 }
 
@@ -32,6 +32,7 @@ import (
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/forkid"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/downloader"
 	"github.com/ethereum/go-ethereum/eth/fetcher"
@@ -77,6 +78,7 @@ type ProtocolManager struct {
 
 	txpool     txPool
 	blockchain *core.BlockChain
+	chaindb    ethdb.Database
 	maxPeers   int
 
 	rollupBatchBuilder rollup.RollupTransitionBatchBuilder
@@ -113,6 +115,7 @@ func NewProtocolManager(config *params.ChainConfig, checkpoint *params.TrustedCh
 		eventMux:           mux,
 		txpool:             txpool,
 		blockchain:         blockchain,
+		chaindb:            chaindb,
 		peers:              newPeerSet(),
 		whitelist:          whitelist,
 		newPeerCh:          make(chan *peer),
@@ -306,6 +309,10 @@ func (pm *ProtocolManager) handle(p *peer) error {
 	}
 	p.Log().Debug("Ethereum peer connected", "name", p.Name())
 
+	// Blocks and transactions of OVM chains are only exchanged along with their metadata
+	if pm.blockchain.Config().IsOVM() && p.version != eth64ovm {
+		return errResp(ErrProtocolVersionMismatch, "%d (OVM chains require %d)", p.version, eth64ovm)
+	}
 	// Execute the Ethereum handshake
 	var (
 		genesis = pm.blockchain.Genesis()
@@ -550,7 +557,13 @@ func (pm *ProtocolManager) handleMsg(p *peer) error {
 				return errResp(ErrDecode, "msg %v: %v", msg, err)
 			}
 			// Retrieve the requested block body, stopping if enough was found
-			if data := pm.blockchain.GetBodyRLP(hash); len(data) != 0 {
+			var data rlp.RawValue
+			if p.version == eth64ovm {
+				data = pm.getBodyWithMetasRLP(hash)
+			} else {
+				data = pm.blockchain.GetBodyRLP(hash)
+			}
+			if len(data) != 0 {
 				bodies = append(bodies, data)
 				bytes += len(data)
 			}
@@ -563,6 +576,13 @@ func (pm *ProtocolManager) handleMsg(p *peer) error {
 		if err := msg.Decode(&request); err != nil {
 			return errResp(ErrDecode, "msg %v: %v", msg, err)
 		}
+		if p.version == eth64ovm {
+			for i, body := range request {
+				if err := setTxMetas(body.Transactions, body.Metas); err != nil {
+					return errResp(ErrDecode, "msg %v: body %d: %v", msg, i, err)
+				}
+			}
+		}
 		// Deliver them all to the downloader for queuing
 		transactions := make([][]*types.Transaction, len(request))
 		uncles := make([][]*types.Header, len(request))
@@ -694,6 +714,11 @@ func (pm *ProtocolManager) handleMsg(p *peer) error {
 		if err := msg.Decode(&request); err != nil {
 			return errResp(ErrDecode, "%v: %v", msg, err)
 		}
+		if p.version == eth64ovm {
+			if err := setTxMetas(request.Block.Transactions(), request.Metas); err != nil {
+				return errResp(ErrDecode, "%v: %v", msg, err)
+			}
+		}
 		if hash := types.CalcUncleHash(request.Block.Uncles()); hash != request.Block.UncleHash() {
 			log.Warn("Propagated block has invalid uncles", "have", hash, "exp", request.Block.UncleHash())
 			break // TODO(karalabe): return error eventually, but wait a few releases
@@ -738,7 +763,16 @@ func (pm *ProtocolManager) handleMsg(p *peer) error {
 		}
 		// Transactions can be processed, parse all of them and deliver to the pool
 		var txs []*types.Transaction
-		if err := msg.Decode(&txs); err != nil {
+		if p.version == eth64ovm {
+			var request ovmTxsData
+			if err := msg.Decode(&request); err != nil {
+				return errResp(ErrDecode, "msg %v: %v", msg, err)
+			}
+			if err := setTxMetas(request.Transactions, request.Metas); err != nil {
+				return errResp(ErrDecode, "msg %v: %v", msg, err)
+			}
+			txs = request.Transactions
+		} else if err := msg.Decode(&txs); err != nil {
 			return errResp(ErrDecode, "msg %v: %v", msg, err)
 		}
 		for i, tx := range txs {
@@ -748,6 +782,13 @@ func (pm *ProtocolManager) handleMsg(p *peer) error {
 			}
 			p.MarkTransaction(tx.Hash())
 		}
+		if p.version == eth64ovm {
+			filtered := sequencerTxs(txs)
+			if discarded := len(txs) - len(filtered); discarded > 0 {
+				p.Log().Debug("Discarded transactions with L1-to-L2 metadata", "count", discarded)
+			}
+			txs = filtered
+		}
 		pm.txpool.AddRemotes(txs)
 
 	default:
@@ -756,6 +797,28 @@ func (pm *ProtocolManager) handleMsg(p *peer) error {
 	return nil
 }
 
+// getBodyWithMetasRLP retrieves a block body in RLP encoding, extended with the
+// TransactionMeta of its transactions as served to eth/64-ovm peers. Transactions
+// without stored metadata are sent with their default one.
+func (pm *ProtocolManager) getBodyWithMetasRLP(hash common.Hash) rlp.RawValue {
+	body := pm.blockchain.GetBody(hash)
+	if body == nil {
+		return nil
+	}
+	metas := make([][]byte, len(body.Transactions))
+	for i, tx := range body.Transactions {
+		if metas[i] = rawdb.ReadTransactionMetaRaw(pm.chaindb, tx.Hash()); metas[i] == nil {
+			metas[i] = types.TxMetaEncode(tx.GetMeta())
+		}
+	}
+	data, err := rlp.EncodeToBytes(&blockBody{Transactions: body.Transactions, Uncles: body.Uncles, Metas: metas})
+	if err != nil {
+		log.Error("Failed to encode block body", "hash", hash, "err", err)
+		return nil
+	}
+	return data
+}
+
 // BroadcastBlock will either propagate a block to a subset of it's peers, or
 // will only announce it's availability (depending what's requested).
 func (pm *ProtocolManager) BroadcastBlock(block *types.Block, propagate bool) {
@@ -17,6 +17,7 @@
 package eth
 
 import (
+	"bytes"
 	"fmt"
 	"math"
 	"math/big"
@@ -29,6 +30,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/forkid"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -41,8 +43,9 @@ import (
 )
 
 // Tests that block headers can be retrieved from a remote chain based on user queries.
-func TestGetBlockHeaders63(t *testing.T) { testGetBlockHeaders(t, 63) }
-func TestGetBlockHeaders64(t *testing.T) { testGetBlockHeaders(t, 64) }
+func TestGetBlockHeaders63(t *testing.T)    { testGetBlockHeaders(t, 63) }
+func TestGetBlockHeaders64(t *testing.T)    { testGetBlockHeaders(t, 64) }
+func TestGetBlockHeaders64OVM(t *testing.T) { testGetBlockHeaders(t, eth64ovm) }
 
 func testGetBlockHeaders(t *testing.T, protocol int) {
 	pm, _ := newTestProtocolManagerMust(t, downloader.FullSync, downloader.MaxHashFetch+15, nil, nil)
@@ -271,13 +274,38 @@ func testGetBlockBodies(t *testing.T, protocol int) {
 	}
 }
 
+// Tests that block bodies are served to eth/64-ovm peers along with the stored
+// metadata of their transactions.
+func TestGetBlockBodyMetas64OVM(t *testing.T) {
+	tx := newTestMetaTransaction(testBankKey, 0)
+	pm, _ := newTestProtocolManagerMust(t, downloader.FullSync, 2, func(i int, block *core.BlockGen) {
+		if i == 0 {
+			block.AddTx(tx)
+		}
+	}, nil)
+	peer, _ := newTestPeer("peer", eth64ovm, pm, true)
+	defer peer.close()
+
+	var (
+		first  = pm.blockchain.GetBlockByNumber(1)
+		second = pm.blockchain.GetBlockByNumber(2)
+	)
+	bodies := []*blockBody{
+		{Transactions: first.Transactions(), Uncles: first.Uncles(), Metas: [][]byte{types.TxMetaEncode(tx.GetMeta())}},
+		{Transactions: second.Transactions(), Uncles: second.Uncles()},
+	}
+	p2p.Send(peer.app, GetBlockBodiesMsg, []common.Hash{first.Hash(), {}, second.Hash()})
+	if err := p2p.ExpectMsg(peer.app, BlockBodiesMsg, bodies); err != nil {
+		t.Errorf("bodies mismatch: %v", err)
+	}
+}
+
 // Tests that the node state database can be retrieved based on hashes.
-func TestGetNodeData63(t *testing.T) { testGetNodeData(t, 63) }
-func TestGetNodeData64(t *testing.T) { testGetNodeData(t, 64) }
+func TestGetNodeData63(t *testing.T)    { testGetNodeData(t, 63) }
+func TestGetNodeData64(t *testing.T)    { testGetNodeData(t, 64) }
+func TestGetNodeData64OVM(t *testing.T) { testGetNodeData(t, eth64ovm) }
 
 func testGetNodeData(t *testing.T, protocol int) {
-	t.Skip("OVM breaks this test with error: `account does not exist`")
-
 	// Define three accounts to simulate transactions with
 	acc1Key, _ := crypto.HexToECDSA("8a1f9a8f95be41cd7ccb6168179afb4504aefe388d1e14474d32c45c72ce7b7a")
 	acc2Key, _ := crypto.HexToECDSA("49a7b37aa6f6645917e7b807e9d1c00d4fa71f18343b0d4122a4d2df64dd6fee")
@@ -371,12 +399,11 @@ func testGetNodeData(t *testing.T, protocol int) {
 }
 
 // Tests that the transaction receipts can be retrieved based on hashes.
-func TestGetReceipt63(t *testing.T) { testGetReceipt(t, 63) }
-func TestGetReceipt64(t *testing.T) { testGetReceipt(t, 64) }
+func TestGetReceipt63(t *testing.T)    { testGetReceipt(t, 63) }
+func TestGetReceipt64(t *testing.T)    { testGetReceipt(t, 64) }
+func TestGetReceipt64OVM(t *testing.T) { testGetReceipt(t, eth64ovm) }
 
 func testGetReceipt(t *testing.T, protocol int) {
-	t.Skip("OVM breaks this test with error: `account does not exist`")
-
 	// Define three accounts to simulate transactions with
 	acc1Key, _ := crypto.HexToECDSA("8a1f9a8f95be41cd7ccb6168179afb4504aefe388d1e14474d32c45c72ce7b7a")
 	acc2Key, _ := crypto.HexToECDSA("49a7b37aa6f6645917e7b807e9d1c00d4fa71f18343b0d4122a4d2df64dd6fee")
@@ -643,6 +670,160 @@ outer:
 	}
 }
 
+// Tests that blocks are propagated to eth/64-ovm peers along with the metadata of
+// their transactions.
+func TestBroadcastBlockMetas64OVM(t *testing.T) {
+	pm, db := newTestProtocolManagerMust(t, downloader.FullSync, 0, nil, nil)
+	defer pm.Stop()
+
+	peer, _ := newTestPeer("peer", eth64ovm, pm, true)
+	defer peer.close()
+
+	tx := newTestMetaTransaction(testBankKey, 0)
+	chain, _ := core.GenerateChain(pm.blockchain.Config(), pm.blockchain.Genesis(), ethash.NewFaker(), db, 1, func(i int, gen *core.BlockGen) {
+		gen.AddTx(tx)
+	})
+	pm.BroadcastBlock(chain[0], true /*propagate*/)
+
+	td := new(big.Int).Add(pm.blockchain.GetTdByHash(pm.blockchain.Genesis().Hash()), chain[0].Difficulty())
+	request := &newBlockData{Block: chain[0], TD: td, Metas: [][]byte{types.TxMetaEncode(tx.GetMeta())}}
+	if err := p2p.ExpectMsg(peer.app, NewBlockMsg, request); err != nil {
+		t.Fatalf("block propagation mismatch: %v", err)
+	}
+}
+
+// Tests that blocks propagated by eth/64-ovm peers are imported with the metadata
+// of their transactions, and that peers omitting it are dropped.
+func TestRecvBlockMetas64OVM(t *testing.T) {
+	pm, db := newTestProtocolManagerMust(t, downloader.FullSync, 0, nil, nil)
+	defer pm.Stop()
+
+	tx := newTestMetaTransaction(testBankKey, 0)
+	chain, _ := core.GenerateChain(pm.blockchain.Config(), pm.blockchain.Genesis(), ethash.NewFaker(), db, 1, func(i int, gen *core.BlockGen) {
+		gen.AddTx(tx)
+	})
+	td := new(big.Int).Add(pm.blockchain.GetTdByHash(pm.blockchain.Genesis().Hash()), chain[0].Difficulty())
+
+	// A block without the metadata of its transactions is rejected
+	source, errc := newTestPeer("source", eth64ovm, pm, true)
+	defer source.close()
+
+	if err := p2p.Send(source.app, NewBlockMsg, &newBlockData{Block: chain[0], TD: td}); err != nil {
+		t.Fatalf("failed to propagate block: %v", err)
+	}
+	select {
+	case err := <-errc:
+		if err == nil {
+			t.Errorf("peer dropped without error")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("peer not dropped within 2 seconds")
+	}
+	// A block with it is imported along with the metadata
+	source, _ = newTestPeer("source", eth64ovm, pm, true)
+	defer source.close()
+
+	if err := p2p.Send(source.app, NewBlockMsg, &newBlockData{Block: chain[0], TD: td, Metas: [][]byte{types.TxMetaEncode(tx.GetMeta())}}); err != nil {
+		t.Fatalf("failed to propagate block: %v", err)
+	}
+	for start := time.Now(); pm.blockchain.CurrentBlock().Hash() != chain[0].Hash(); time.Sleep(10 * time.Millisecond) {
+		if time.Since(start) > 2*time.Second {
+			t.Fatalf("block not imported within 2 seconds")
+		}
+	}
+	meta := rawdb.ReadTransactionMeta(db, tx.Hash())
+	if meta == nil {
+		t.Fatalf("transaction meta not stored")
+	}
+	if have, want := types.TxMetaEncode(meta), types.TxMetaEncode(tx.GetMeta()); !bytes.Equal(have, want) {
+		t.Errorf("stored tx meta mismatch: have %x, want %x", have, want)
+	}
+}
+
+// Tests that OVM nodes only speak eth/64-ovm, dropping eth/64 peers before importing
+// any block from them.
+func TestOVMRejectsUpstreamPeers(t *testing.T) {
+	var (
+		engine = ethash.NewFaker()
+		db     = rawdb.NewMemoryDatabase()
+		config = *params.TestChainConfig
+	)
+	config.OVM = &params.OVMConfig{}
+	gspec := &core.Genesis{Config: &config}
+	genesis := gspec.MustCommit(db)
+
+	blockchain, err := core.NewBlockChain(db, nil, &config, engine, vm.Config{}, nil)
+	if err != nil {
+		t.Fatalf("failed to create new blockchain: %v", err)
+	}
+	pm, err := NewProtocolManager(&config, nil, downloader.FullSync, DefaultConfig.NetworkId, new(event.TypeMux), new(testTxPool), engine, blockchain, db, 1, nil, rollup.NewDummyBatchBuilder())
+	if err != nil {
+		t.Fatalf("failed to start test protocol manager: %v", err)
+	}
+	pm.Start(1000)
+	defer pm.Stop()
+
+	if versions := protocolVersions(&config); len(versions) != 1 || versions[0] != eth64ovm {
+		t.Fatalf("protocol versions mismatch: have %v, want [%d]", versions, eth64ovm)
+	}
+	if versions := protocolVersions(params.TestChainConfig); versions[0] != eth64 {
+		t.Fatalf("non-OVM primary protocol version mismatch: have %d, want %d", versions[0], eth64)
+	}
+	chain, _ := core.GenerateChain(&config, genesis, ethash.NewFaker(), db, 1, nil)
+	td := new(big.Int).Add(genesis.Difficulty(), chain[0].Difficulty())
+
+	// An eth/64 peer is dropped and its block never imported
+	source, errc := newTestPeer("source", eth64, pm, false)
+	defer source.close()
+
+	go func() {
+		for {
+			msg, err := source.app.ReadMsg()
+			if err != nil {
+				return
+			}
+			msg.Discard()
+		}
+	}()
+	go func() {
+		status := &statusData{
+			ProtocolVersion: eth64,
+			NetworkID:       DefaultConfig.NetworkId,
+			TD:              genesis.Difficulty(),
+			Head:            genesis.Hash(),
+			Genesis:         genesis.Hash(),
+			ForkID:          forkid.NewID(blockchain),
+		}
+		if err := p2p.Send(source.app, StatusMsg, status); err == nil {
+			p2p.Send(source.app, NewBlockMsg, []interface{}{chain[0], td})
+		}
+	}()
+	select {
+	case err := <-errc:
+		if err == nil {
+			t.Errorf("peer dropped without error")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("peer not dropped within 2 seconds")
+	}
+	time.Sleep(100 * time.Millisecond)
+	if head := blockchain.CurrentBlock(); head.Hash() != genesis.Hash() {
+		t.Fatalf("block %d imported from eth/64 peer", head.NumberU64())
+	}
+	// The same block propagated by an eth/64-ovm peer is imported
+	source, _ = newTestPeer("source", eth64ovm, pm, true)
+	defer source.close()
+
+	if err := p2p.Send(source.app, NewBlockMsg, &newBlockData{Block: chain[0], TD: td, Metas: [][]byte{}}); err != nil {
+		t.Fatalf("failed to propagate block: %v", err)
+	}
+	for start := time.Now(); blockchain.CurrentBlock().Hash() != chain[0].Hash(); time.Sleep(10 * time.Millisecond) {
+		if time.Since(start) > 2*time.Second {
+			t.Fatalf("block not imported within 2 seconds")
+		}
+	}
+}
+
 // Tests that a propagated malformed block (uncles or transactions don't match
 // with the hashes in the header) gets discarded and not broadcast forward.
 func TestBroadcastMalformedBlock(t *testing.T) {
@@ -29,6 +29,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/forkid"
@@ -139,6 +140,18 @@ func newTestTransaction(from *ecdsa.PrivateKey, nonce uint64, datasize int) *typ
 	return tx
 }
 
+// newTestMetaTransaction create a new dummy transaction with non-default metadata,
+// as enqueued from L1.
+func newTestMetaTransaction(from *ecdsa.PrivateKey, nonce uint64) *types.Transaction {
+	var (
+		sender = common.HexToAddress("0x1111111111111111111111111111111111111111")
+		txID   = hexutil.Uint64(nonce + 100)
+	)
+	tx := types.NewTransaction(nonce, common.Address{}, big.NewInt(0), 100000, big.NewInt(0), nil, &sender, &txID, types.QueueOriginL1ToL2, types.SighashEIP155)
+	tx, _ = types.SignTx(tx, types.HomesteadSigner{}, from)
+	return tx
+}
+
 // testPeer is a simulated peer to allow testing direct network calls.
 type testPeer struct {
 	net p2p.MsgReadWriter // Network layer reader/writer to simulate remote messaging
@@ -193,7 +206,7 @@ func (p *testPeer) handshake(t *testing.T, td *big.Int, head common.Hash, genesi
 			CurrentBlock:    head,
 			GenesisBlock:    genesis,
 		}
-	case p.version == eth64:
+	case p.version == eth64 || p.version == eth64ovm:
 		msg = &statusData{
 			ProtocolVersion: uint32(p.version),
 			NetworkID:       DefaultConfig.NetworkId,
@@ -204,6 +204,9 @@ func (p *peer) SendTransactions(txs types.Transactions) error {
 	for p.knownTxs.Cardinality() >= maxKnownTxs {
 		p.knownTxs.Pop()
 	}
+	if p.version == eth64ovm {
+		return p2p.Send(p.rw, TxMsg, &ovmTxsData{Transactions: txs, Metas: encodeTxMetas(txs)})
+	}
 	return p2p.Send(p.rw, TxMsg, txs)
 }
 
@@ -265,7 +268,11 @@ func (p *peer) SendNewBlock(block *types.Block, td *big.Int) error {
 	for p.knownBlocks.Cardinality() >= maxKnownBlocks {
 		p.knownBlocks.Pop()
 	}
-	return p2p.Send(p.rw, NewBlockMsg, []interface{}{block, td})
+	request := &newBlockData{Block: block, TD: td}
+	if p.version == eth64ovm {
+		request.Metas = encodeTxMetas(block.Transactions())
+	}
+	return p2p.Send(p.rw, NewBlockMsg, request)
 }
 
 // AsyncSendNewBlock queues an entire block for propagation to a remote peer. If
@@ -372,7 +379,7 @@ func (p *peer) Handshake(network uint64, td *big.Int, head common.Hash, genesis
 				CurrentBlock:    head,
 				GenesisBlock:    genesis,
 			})
-		case p.version == eth64:
+		case p.version == eth64 || p.version == eth64ovm:
 			errc <- p2p.Send(p.rw, StatusMsg, &statusData{
 				ProtocolVersion: uint32(p.version),
 				NetworkID:       network,
@@ -389,7 +396,7 @@ func (p *peer) Handshake(network uint64, td *big.Int, head common.Hash, genesis
 		switch {
 		case p.version == eth63:
 			errc <- p.readStatusLegacy(network, &status63, genesis)
-		case p.version == eth64:
+		case p.version == eth64 || p.version == eth64ovm:
 			errc <- p.readStatus(network, &status, genesis, forkFilter)
 		default:
 			panic(fmt.Sprintf("unsupported eth protocol version: %d", p.version))
@@ -410,7 +417,7 @@ func (p *peer) Handshake(network uint64, td *big.Int, head common.Hash, genesis
 	switch {
 	case p.version == eth63:
 		p.td, p.head = status63.TD, status63.CurrentBlock
-	case p.version == eth64:
+	case p.version == eth64 || p.version == eth64ovm:
 		p.td, p.head = status.TD, status.Head
 	default:
 		panic(fmt.Sprintf("unsupported eth protocol version: %d", p.version))
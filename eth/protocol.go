@@ -26,6 +26,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/forkid"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
 )
 
@@ -33,6 +34,8 @@ import (
 const (
 	eth63 = 63
 	eth64 = 64
+
+	eth64ovm = params.Eth64OVMProtocolVersion
 )
 
 // protocolName is the official short name of the protocol used during capability negotiation.
@@ -41,8 +44,22 @@ const protocolName = "eth"
 // ProtocolVersions are the supported versions of the eth protocol (first is primary).
 var ProtocolVersions = []uint{eth64, eth63}
 
+// ovmProtocolVersions are the versions of the eth protocol supported on OVM chains.
+// Blocks and transactions exchanged over the upstream versions lack the TransactionMeta
+// the execution of OVM transactions depends on.
+var ovmProtocolVersions = []uint{eth64ovm}
+
+// protocolVersions returns the versions of the eth protocol supported on the chain
+// with the provided config (first is primary).
+func protocolVersions(config *params.ChainConfig) []uint {
+	if config.IsOVM() {
+		return ovmProtocolVersions
+	}
+	return ProtocolVersions
+}
+
 // protocolLengths are the number of implemented message corresponding to different protocol versions.
-var protocolLengths = map[uint]uint64{eth64: 17, eth63: 17}
+var protocolLengths = map[uint]uint64{eth64ovm: 17, eth64: 17, eth63: 17}
 
 const protocolMaxMsgSize = 10 * 1024 * 1024 // Maximum cap on the size of a protocol message
 
@@ -179,6 +196,7 @@ func (hn *hashOrNumber) DecodeRLP(s *rlp.Stream) error {
 type newBlockData struct {
 	Block *types.Block
 	TD    *big.Int
+	Metas [][]byte `rlp:"tail"` // Encoded TransactionMeta of the block transactions (eth/64-ovm)
 }
 
 // sanityCheck verifies that the values are reasonable, as a DoS protection
@@ -198,7 +216,64 @@ func (request *newBlockData) sanityCheck() error {
 type blockBody struct {
 	Transactions []*types.Transaction // Transactions contained within a block
 	Uncles       []*types.Header      // Uncles contained within a block
+	Metas        [][]byte             `rlp:"tail"` // Encoded TransactionMeta of the transactions (eth/64-ovm)
 }
 
 // blockBodiesData is the network packet for block content distribution.
 type blockBodiesData []*blockBody
+
+// ovmTxsData is the network packet for transaction propagation on eth/64-ovm.
+type ovmTxsData struct {
+	Transactions []*types.Transaction
+	Metas        [][]byte // Encoded TransactionMeta of the transactions
+}
+
+// encodeTxMetas returns the encoded TransactionMeta of each transaction, to be sent
+// along with them to eth/64-ovm peers.
+func encodeTxMetas(txs []*types.Transaction) [][]byte {
+	metas := make([][]byte, len(txs))
+	for i, tx := range txs {
+		metas[i] = types.TxMetaEncode(tx.GetMeta())
+	}
+	return metas
+}
+
+// setTxMetas sets the TransactionMeta of each transaction from the encoded metas
+// received along with them from an eth/64-ovm peer.
+func setTxMetas(txs []*types.Transaction, metas [][]byte) error {
+	if len(metas) != len(txs) {
+		return fmt.Errorf("transaction meta count mismatch: have %d, want %d", len(metas), len(txs))
+	}
+	for i, tx := range txs {
+		if tx == nil {
+			return fmt.Errorf("transaction %d is nil", i)
+		}
+		meta, err := types.TxMetaDecode(metas[i])
+		if err != nil {
+			return fmt.Errorf("invalid meta of transaction %d: %v", i, err)
+		}
+		tx.SetTransactionMeta(meta)
+	}
+	return nil
+}
+
+// sequencerTxs returns the transactions whose TransactionMeta is that of a
+// transaction sent to the sequencer. L1-to-L2 transactions are only ever ingested
+// from L1, so pooled transactions claiming an L1 origin, sender or tx id are
+// discarded rather than trusted from a peer.
+func sequencerTxs(txs []*types.Transaction) []*types.Transaction {
+	filtered := make([]*types.Transaction, 0, len(txs))
+	for _, tx := range txs {
+		if queueOrigin := tx.QueueOrigin(); queueOrigin != nil && queueOrigin.Cmp(big.NewInt(int64(types.QueueOriginSequencer))) != 0 {
+			continue
+		}
+		if tx.L1MessageSender() != nil || tx.L1RollupTxId() != nil {
+			continue
+		}
+		if sighash := tx.SignatureHashType(); sighash != types.SighashEIP155 && sighash != types.SighashEthSign {
+			continue
+		}
+		filtered = append(filtered, tx)
+	}
+	return filtered
+}
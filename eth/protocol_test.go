@@ -17,6 +17,7 @@
 package eth
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
 	"sync"
@@ -26,6 +27,7 @@ import (
 	"github.com/ethereum/go-ethereum/rollup"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/forkid"
@@ -334,6 +336,137 @@ func testSendTransactions(t *testing.T, protocol int) {
 	wg.Wait()
 }
 
+// Tests that eth/64-ovm peers receive transactions along with their metadata.
+func TestRecvTransactionMetas64OVM(t *testing.T) {
+	txAdded := make(chan []*types.Transaction)
+	pm, _ := newTestProtocolManagerMust(t, downloader.FullSync, 0, nil, txAdded)
+	pm.acceptTxs = 1 // mark synced to accept transactions
+	p, _ := newTestPeer("peer", eth64ovm, pm, true)
+	defer pm.Stop()
+	defer p.close()
+
+	tx := newTestTransaction(testAccount, 0, 0)
+	meta := types.TxMetaEncode(tx.GetMeta())
+
+	if err := p2p.Send(p.app, TxMsg, &ovmTxsData{Transactions: []*types.Transaction{tx}, Metas: [][]byte{meta}}); err != nil {
+		t.Fatalf("send error: %v", err)
+	}
+	select {
+	case added := <-txAdded:
+		if len(added) != 1 {
+			t.Fatalf("wrong number of added transactions: got %d, want 1", len(added))
+		}
+		if added[0].Hash() != tx.Hash() {
+			t.Errorf("added wrong tx hash: got %v, want %v", added[0].Hash(), tx.Hash())
+		}
+		if have := types.TxMetaEncode(added[0].GetMeta()); !bytes.Equal(have, meta) {
+			t.Errorf("added wrong tx meta: got %x, want %x", have, meta)
+		}
+	case <-time.After(2 * time.Second):
+		t.Errorf("no NewTxsEvent received within 2 seconds")
+	}
+}
+
+// Tests that transactions received from eth/64-ovm peers with metadata other than
+// that of a transaction sent to the sequencer are discarded.
+func TestRecvForgedTransactionMetas64OVM(t *testing.T) {
+	txAdded := make(chan []*types.Transaction)
+	pm, _ := newTestProtocolManagerMust(t, downloader.FullSync, 0, nil, txAdded)
+	pm.acceptTxs = 1 // mark synced to accept transactions
+	p, errc := newTestPeer("peer", eth64ovm, pm, true)
+	defer pm.Stop()
+	defer p.close()
+
+	var (
+		sender = common.HexToAddress("0x1111111111111111111111111111111111111111")
+		txID   = hexutil.Uint64(1)
+		forged = []*types.Transaction{
+			newTestMetaTransaction(testAccount, 0),
+			types.NewTransaction(1, common.Address{}, big.NewInt(0), 100000, big.NewInt(0), nil, &sender, nil, types.QueueOriginSequencer, types.SighashEIP155),
+			types.NewTransaction(2, common.Address{}, big.NewInt(0), 100000, big.NewInt(0), nil, nil, &txID, types.QueueOriginSequencer, types.SighashEIP155),
+			types.NewTransaction(3, common.Address{}, big.NewInt(0), 100000, big.NewInt(0), nil, nil, nil, types.QueueOriginSafety, types.SighashEIP155),
+		}
+		valid = newTestTransaction(testAccount, 0, 0)
+	)
+	for i := 1; i < len(forged); i++ {
+		forged[i], _ = types.SignTx(forged[i], types.HomesteadSigner{}, testAccount)
+	}
+	txs := append(forged, valid)
+	if err := p2p.Send(p.app, TxMsg, &ovmTxsData{Transactions: txs, Metas: encodeTxMetas(txs)}); err != nil {
+		t.Fatalf("send error: %v", err)
+	}
+	select {
+	case added := <-txAdded:
+		if len(added) != 1 {
+			t.Fatalf("wrong number of added transactions: got %d, want 1", len(added))
+		}
+		if added[0].Hash() != valid.Hash() {
+			t.Errorf("added wrong tx hash: got %v, want %v", added[0].Hash(), valid.Hash())
+		}
+	case err := <-errc:
+		t.Fatalf("peer dropped: %v", err)
+	case <-time.After(2 * time.Second):
+		t.Fatalf("no NewTxsEvent received within 2 seconds")
+	}
+}
+
+// Tests that eth/64-ovm peers sending transactions without their metadata are
+// dropped.
+func TestRecvTransactionsMissingMetas64OVM(t *testing.T) {
+	pm, _ := newTestProtocolManagerMust(t, downloader.FullSync, 0, nil, nil)
+	pm.acceptTxs = 1 // mark synced to accept transactions
+	p, errc := newTestPeer("peer", eth64ovm, pm, true)
+	defer pm.Stop()
+	defer p.close()
+
+	tx := newTestMetaTransaction(testAccount, 0)
+	if err := p2p.Send(p.app, TxMsg, &ovmTxsData{Transactions: []*types.Transaction{tx}}); err != nil {
+		t.Fatalf("send error: %v", err)
+	}
+	select {
+	case err := <-errc:
+		if err == nil {
+			t.Errorf("peer dropped without error")
+		}
+	case <-time.After(2 * time.Second):
+		t.Errorf("peer not dropped within 2 seconds")
+	}
+}
+
+// Tests that pending transactions are sent to eth/64-ovm peers along with their
+// metadata.
+func TestSendTransactionMetas64OVM(t *testing.T) {
+	pm, _ := newTestProtocolManagerMust(t, downloader.FullSync, 0, nil, nil)
+	defer pm.Stop()
+
+	tx := newTestMetaTransaction(testAccount, 0)
+	pm.txpool.AddRemotes([]*types.Transaction{tx})
+
+	p, _ := newTestPeer("peer", eth64ovm, pm, true)
+	defer p.close()
+
+	msg, err := p.app.ReadMsg()
+	if err != nil {
+		t.Fatalf("read error: %v", err)
+	}
+	if msg.Code != TxMsg {
+		t.Fatalf("got code %d, want TxMsg", msg.Code)
+	}
+	var request ovmTxsData
+	if err := msg.Decode(&request); err != nil {
+		t.Fatalf("failed to decode transactions: %v", err)
+	}
+	if err := setTxMetas(request.Transactions, request.Metas); err != nil {
+		t.Fatalf("failed to set transaction metas: %v", err)
+	}
+	if len(request.Transactions) != 1 || request.Transactions[0].Hash() != tx.Hash() {
+		t.Fatalf("wrong transactions sent: %v", request.Transactions)
+	}
+	if have, want := types.TxMetaEncode(request.Transactions[0].GetMeta()), types.TxMetaEncode(tx.GetMeta()); !bytes.Equal(have, want) {
+		t.Errorf("wrong tx meta sent: got %x, want %x", have, want)
+	}
+}
+
 // Tests that the custom union field encoder and decoder works correctly.
 func TestGetBlockHeadersDataEncodeDecode(t *testing.T) {
 	// Create a "random" hash for testing
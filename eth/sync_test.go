@@ -17,10 +17,14 @@
 package eth
 
 import (
+	"bytes"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/downloader"
 	"github.com/ethereum/go-ethereum/p2p"
 	"github.com/ethereum/go-ethereum/p2p/enode"
@@ -53,3 +57,44 @@ func TestFastSyncDisabling(t *testing.T) {
 		t.Fatalf("fast sync not disabled after successful synchronisation")
 	}
 }
+
+// Tests that transactions synchronised from eth/64-ovm peers are stored along with
+// their metadata.
+func TestSyncTransactionMetas64OVMFull(t *testing.T) {
+	testSyncTransactionMetas(t, downloader.FullSync)
+}
+func TestSyncTransactionMetas64OVMFast(t *testing.T) {
+	testSyncTransactionMetas(t, downloader.FastSync)
+}
+
+func testSyncTransactionMetas(t *testing.T, mode downloader.SyncMode) {
+	tx := newTestMetaTransaction(testBankKey, 0)
+	pmFull, _ := newTestProtocolManagerMust(t, downloader.FullSync, 8, func(i int, block *core.BlockGen) {
+		if i == 3 {
+			block.AddTx(tx)
+		}
+	}, nil)
+	defer pmFull.Stop()
+	pmEmpty, dbEmpty := newTestProtocolManagerMust(t, mode, 0, nil, nil)
+	defer pmEmpty.Stop()
+
+	// Sync up the two peers
+	io1, io2 := p2p.MsgPipe()
+
+	go pmFull.handle(pmFull.newPeer(eth64ovm, p2p.NewPeer(enode.ID{}, "empty", nil), io2))
+	go pmEmpty.handle(pmEmpty.newPeer(eth64ovm, p2p.NewPeer(enode.ID{}, "full", nil), io1))
+
+	time.Sleep(250 * time.Millisecond)
+	pmEmpty.synchronise(pmEmpty.peers.BestPeer())
+
+	if head := pmEmpty.blockchain.CurrentBlock().NumberU64(); head != 8 {
+		t.Fatalf("chain not synchronised: head %d, want 8", head)
+	}
+	meta := rawdb.ReadTransactionMeta(dbEmpty, tx.Hash())
+	if meta == nil {
+		t.Fatalf("transaction meta not stored")
+	}
+	if have, want := types.TxMetaEncode(meta), types.TxMetaEncode(tx.GetMeta()); !bytes.Equal(have, want) {
+		t.Errorf("stored tx meta mismatch: have %x, want %x", have, want)
+	}
+}
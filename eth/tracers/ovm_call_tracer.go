@@ -0,0 +1,422 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// OvmCallTracerName is the name under which the OVM call tracer is requested
+// through the tracing API.
+const OvmCallTracerName = "ovmCallTracer"
+
+var (
+	stateManagerAbi abi.ABI
+
+	// ovmCallSelectors maps the Execution Manager methods through which OVM
+	// contracts call and create other contracts to the type of the call.
+	ovmCallSelectors = make(map[string]string)
+)
+
+func init() {
+	var err error
+	if stateManagerAbi, err = abi.JSON(strings.NewReader(vm.RawStateManagerAbi)); err != nil {
+		panic(fmt.Sprintf("invalid state manager ABI: %v", err))
+	}
+	for method, typ := range map[string]string{
+		"ovmCALL":         "CALL",
+		"ovmSTATICCALL":   "STATICCALL",
+		"ovmDELEGATECALL": "DELEGATECALL",
+		"ovmCREATE":       "CREATE",
+		"ovmCREATE2":      "CREATE2",
+	} {
+		ovmCallSelectors[string(crypto.Keccak256([]byte(method + "()"))[:4])] = typ
+	}
+}
+
+// OvmCallFrame is a call or contract creation at the OVM level, with the
+// Execution Manager and state manager calls it was made through left out.
+type OvmCallFrame struct {
+	Type         string          `json:"type"`
+	From         common.Address  `json:"from"`
+	To           common.Address  `json:"to"`
+	Input        hexutil.Bytes   `json:"input"`
+	Output       hexutil.Bytes   `json:"output,omitempty"`
+	Gas          hexutil.Uint64  `json:"gas"`
+	GasUsed      hexutil.Uint64  `json:"gasUsed"`
+	Error        string          `json:"error,omitempty"`
+	RevertReason string          `json:"revertReason,omitempty"`
+	Events       []*OvmEvent     `json:"events,omitempty"`
+	Calls        []*OvmCallFrame `json:"calls,omitempty"`
+}
+
+// OvmEvent is an event of an OVM frame, as emitted by the Execution Manager.
+type OvmEvent struct {
+	Name string                 `json:"name"`
+	Args map[string]interface{} `json:"args"`
+}
+
+// ovmPendingCall is a call or creation requested from the Execution Manager by
+// an OVM frame, which is yet to reach the called contract.
+type ovmPendingCall struct {
+	typ    string
+	target common.Address
+	input  []byte
+}
+
+// ovmEvmFrame is a frame of the EVM call stack.
+type ovmEvmFrame struct {
+	address common.Address
+	lastOp  vm.OpCode
+
+	frame *OvmCallFrame // OVM frame executing in this EVM frame, or its closest ancestor
+	own   bool          // Whether this EVM frame runs the code of its OVM frame
+	gas   uint64
+}
+
+// OvmCallTracer is a tracer reconstructing the OVM level call tree of a
+// transaction from its execution through the Execution Manager.
+type OvmCallTracer struct {
+	contracts           *params.OVMContracts
+	executionManagerAbi abi.ABI
+
+	root    *OvmCallFrame
+	entered bool // Whether the root frame was matched to the EVM frame running it
+
+	pending map[*OvmCallFrame]*ovmPendingCall
+	frames  []*ovmEvmFrame
+}
+
+// NewOvmCallTracer creates a new OVM call tracer for a chain with the given OVM
+// system contracts.
+func NewOvmCallTracer(contracts *params.OVMContracts) (*OvmCallTracer, error) {
+	executionManagerAbi, err := vm.ExecutionManagerAbi(contracts)
+	if err != nil {
+		return nil, err
+	}
+	return &OvmCallTracer{
+		contracts:           contracts,
+		executionManagerAbi: executionManagerAbi,
+		pending:             make(map[*OvmCallFrame]*ovmPendingCall),
+	}, nil
+}
+
+// CaptureStart implements the Tracer interface to initialize the tracing operation.
+func (t *OvmCallTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	t.root = &OvmCallFrame{Type: "CALL", From: from, To: to, Input: common.CopyBytes(input), Gas: hexutil.Uint64(gas)}
+	pending := &ovmPendingCall{typ: "CALL", target: to}
+	if create {
+		t.root.Type, pending.typ = "CREATE", "CREATE"
+	}
+	// Transactions sent through the Execution Manager are traced as the OVM call
+	// they wrap. Execution Managers with other executeTransaction arguments are
+	// traced as the raw call.
+	if to == t.contracts.ExecutionManager && len(input) >= 4 {
+		if method, err := t.executionManagerAbi.MethodById(input[:4]); err == nil && method.Name == "executeTransaction" {
+			args := make(map[string]interface{})
+			if err := method.Inputs.UnpackIntoMap(args, input[4:]); err == nil {
+				from, okFrom := args["_fromAddress"].(common.Address)
+				entrypoint, okEntrypoint := args["_ovmEntrypoint"].(common.Address)
+				callBytes, okCallBytes := args["_callBytes"].([]byte)
+				if okFrom && okEntrypoint && okCallBytes {
+					t.root.From, t.root.To, t.root.Input = from, entrypoint, callBytes
+					pending = &ovmPendingCall{typ: "CALL", target: t.root.To}
+					if t.root.To == (common.Address{}) {
+						t.root.Type, pending.typ = "CREATE", "CREATE"
+					}
+				}
+			}
+		}
+	}
+	t.pending[t.root] = pending
+	return nil
+}
+
+// CaptureState implements the Tracer interface to trace a single step of VM execution.
+func (t *OvmCallTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	for len(t.frames) > depth {
+		t.exit()
+	}
+	if len(t.frames) < depth {
+		t.enter(contract.Address(), gas)
+	}
+	current := t.frames[len(t.frames)-1]
+	if err != nil {
+		t.fail(current, err)
+		return nil
+	}
+	current.lastOp = op
+
+	switch {
+	case op == vm.RETURN || op == vm.REVERT:
+		if current.own {
+			current.frame.Output = memory.GetCopy(stack.Back(0).Int64(), stack.Back(1).Int64())
+			current.frame.GasUsed = hexutil.Uint64(current.gas - gas + cost)
+			if op == vm.REVERT {
+				current.frame.Error = "execution reverted"
+				current.frame.RevertReason = decodeRevertReason(current.frame.Output)
+			}
+		}
+	case op == vm.STOP || op == vm.SELFDESTRUCT:
+		if current.own {
+			current.frame.GasUsed = hexutil.Uint64(current.gas - gas + cost)
+		}
+	case op >= vm.LOG0 && op <= vm.LOG4 && current.address == t.contracts.ExecutionManager:
+		t.captureEvent(current.frame, op, memory, stack)
+
+	case op == vm.CALL || op == vm.STATICCALL || op == vm.DELEGATECALL:
+		offset, size := stack.Back(2), stack.Back(3)
+		if op == vm.CALL {
+			offset, size = stack.Back(3), stack.Back(4)
+		}
+		switch to := common.BigToAddress(stack.Back(1)); {
+		case to == t.contracts.ExecutionManager && current.own:
+			t.capturePendingCall(current.frame, memory.GetCopy(offset.Int64(), size.Int64()))
+		case to == t.contracts.StateManager && current.address == t.contracts.ExecutionManager:
+			t.captureStateManagerCall(env, current.frame, memory.GetCopy(offset.Int64(), size.Int64()))
+		}
+	}
+	return nil
+}
+
+// CaptureFault implements the Tracer interface to trace an execution fault
+// while running an opcode.
+func (t *OvmCallTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	if depth > 0 && depth <= len(t.frames) {
+		t.fail(t.frames[depth-1], err)
+	}
+	return nil
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *OvmCallTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) error {
+	for len(t.frames) > 0 {
+		t.exit()
+	}
+	t.root.GasUsed = hexutil.Uint64(gasUsed)
+	if err != nil && t.root.Error == "" {
+		t.root.Error = err.Error()
+		t.root.RevertReason = decodeRevertReason(output)
+	}
+	if t.root.Type == "CREATE" && t.root.To == (common.Address{}) && len(output) == common.HashLength {
+		t.root.To = common.BytesToAddress(output)
+	}
+	t.root.Output = common.CopyBytes(output)
+	return nil
+}
+
+// GetResult returns the OVM call tree of the traced transaction.
+func (t *OvmCallTracer) GetResult() (*OvmCallFrame, error) {
+	if t.root == nil {
+		return nil, fmt.Errorf("no transaction traced")
+	}
+	return t.root, nil
+}
+
+// enter pushes an EVM frame for the contract at addr, which becomes an OVM frame
+// unless it is one of the OVM contracts.
+func (t *OvmCallTracer) enter(addr common.Address, gas uint64) {
+	evmFrame := &ovmEvmFrame{address: addr, frame: t.root, gas: gas}
+	openOp := vm.CALL
+	if len(t.frames) > 0 {
+		parent := t.frames[len(t.frames)-1]
+		evmFrame.frame, openOp = parent.frame, parent.lastOp
+	} else if t.root.Type == "CREATE" {
+		openOp = vm.CREATE
+	}
+	t.frames = append(t.frames, evmFrame)
+	// Frames of the system contracts are not part of the OVM call tree.
+	if t.contracts.IsSystemAddress(addr) {
+		return
+	}
+	parent := evmFrame.frame
+	evmFrame.own = true
+
+	// Match the frame against the call requested by its OVM parent.
+	pending := t.pending[parent]
+	matched := false
+	if pending != nil {
+		if pending.typ == "CREATE" || pending.typ == "CREATE2" {
+			matched = openOp == vm.CREATE || openOp == vm.CREATE2
+		} else {
+			matched = addr == pending.target
+		}
+	}
+	if matched && parent == t.root && !t.entered {
+		t.entered = true
+		delete(t.pending, parent)
+		if t.root.Type == "CREATE" {
+			t.root.To = addr
+		}
+		return
+	}
+	frame := &OvmCallFrame{Type: openOp.String(), From: parent.To, To: addr, Gas: hexutil.Uint64(gas)}
+	if matched {
+		delete(t.pending, parent)
+		frame.Type, frame.Input = pending.typ, pending.input
+	}
+	parent.Calls = append(parent.Calls, frame)
+	evmFrame.frame = frame
+}
+
+// exit pops the innermost EVM frame.
+func (t *OvmCallTracer) exit() {
+	evmFrame := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+	if evmFrame.own {
+		delete(t.pending, evmFrame.frame)
+	}
+}
+
+// fail records an execution error on the OVM frame run by the EVM frame.
+func (t *OvmCallTracer) fail(evmFrame *ovmEvmFrame, err error) {
+	if evmFrame.own && evmFrame.frame.Error == "" {
+		evmFrame.frame.Error = err.Error()
+		evmFrame.frame.GasUsed = evmFrame.frame.Gas
+	}
+}
+
+// capturePendingCall records a call or creation requested by an OVM frame from
+// the Execution Manager.
+func (t *OvmCallTracer) capturePendingCall(frame *OvmCallFrame, input []byte) {
+	if len(input) < 4 {
+		return
+	}
+	typ, ok := ovmCallSelectors[string(input[:4])]
+	if !ok {
+		return
+	}
+	pending := &ovmPendingCall{typ: typ}
+	switch typ {
+	case "CREATE":
+		pending.input = input[4:]
+	case "CREATE2":
+		if len(input) >= 4+common.HashLength {
+			pending.input = input[4+common.HashLength:]
+		}
+	default:
+		if len(input) < 4+common.HashLength {
+			return
+		}
+		pending.target = common.BytesToAddress(input[4 : 4+common.HashLength])
+		pending.input = input[4+common.HashLength:]
+	}
+	t.pending[frame] = pending
+}
+
+// captureStateManagerCall records the storage writes and contract creations the
+// Execution Manager makes through the state manager as events of the OVM frame
+// they were made for. They are reported in the form of the SetStorage and
+// CreatedContract events, which not every Execution Manager version emits.
+func (t *OvmCallTracer) captureStateManagerCall(env *vm.EVM, frame *OvmCallFrame, input []byte) {
+	if len(input) < 4 {
+		return
+	}
+	method, err := stateManagerAbi.MethodById(input[:4])
+	if err != nil {
+		return
+	}
+	args, err := method.Inputs.UnpackValues(input[4:])
+	if err != nil {
+		return
+	}
+	switch method.Name {
+	case "setStorage":
+		frame.Events = append(frame.Events, &OvmEvent{Name: "SetStorage", Args: map[string]interface{}{
+			"ovmContractAddress": args[0].(common.Address),
+			"slot":               common.Hash(args[1].([32]byte)),
+			"value":              common.Hash(args[2].([32]byte)),
+		}})
+	case "associateCodeContract":
+		codeAddress := args[1].(common.Address)
+		frame.Events = append(frame.Events, &OvmEvent{Name: "CreatedContract", Args: map[string]interface{}{
+			"ovmContractAddress":  args[0].(common.Address),
+			"codeContractAddress": codeAddress,
+			"codeContractHash":    env.StateDB.GetCodeHash(codeAddress),
+		}})
+	}
+}
+
+// captureEvent decodes an event emitted by the Execution Manager and attaches it
+// to the OVM frame it was emitted for.
+func (t *OvmCallTracer) captureEvent(frame *OvmCallFrame, op vm.OpCode, memory *vm.Memory, stack *vm.Stack) {
+	if op == vm.LOG0 {
+		return
+	}
+	event, err := t.executionManagerAbi.EventByID(common.BigToHash(stack.Back(2)))
+	if err != nil {
+		return
+	}
+	data := memory.GetCopy(stack.Back(0).Int64(), stack.Back(1).Int64())
+	args := make(map[string]interface{})
+	if err := event.Inputs.UnpackIntoMap(args, data); err != nil {
+		return
+	}
+	switch event.Name {
+	case "EOACreatedContract":
+		frame.Events = append(frame.Events, &OvmEvent{Name: event.Name, Args: formatEventArgs(args)})
+
+	case "EOACallRevert":
+		message, ok := args["_revertMessage"].([]byte)
+		if !ok {
+			return
+		}
+		frame.Error = "execution reverted"
+		frame.RevertReason = decodeRevertReason(message)
+		if frame.Output == nil {
+			frame.Output = message
+		}
+	}
+}
+
+// formatEventArgs converts decoded event arguments to their JSON representation,
+// dropping the leading underscore of the Solidity parameter names.
+func formatEventArgs(args map[string]interface{}) map[string]interface{} {
+	formatted := make(map[string]interface{}, len(args))
+	for name, arg := range args {
+		switch value := arg.(type) {
+		case [32]byte:
+			arg = common.Hash(value)
+		case []byte:
+			arg = hexutil.Bytes(value)
+		}
+		formatted[strings.TrimPrefix(name, "_")] = arg
+	}
+	return formatted
+}
+
+// decodeRevertReason returns the message of Error(string) revert data, or the
+// hex encoding of other non-empty revert data.
+func decodeRevertReason(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason
+	}
+	return hexutil.Encode(data)
+}
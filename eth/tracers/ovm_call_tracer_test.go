@@ -0,0 +1,186 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"bytes"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var ovmSender = common.HexToAddress("0x8888888888888888888888888888888888888888")
+
+// ovmChainConfig is the test chain configuration with the OVM contracts deployed
+// in the genesis state.
+var ovmChainConfig = func() *params.ChainConfig {
+	config := *params.TestChainConfig
+	config.OVM = &params.OVMConfig{StateDump: filepath.Join("..", "..", "core", "vm", "ovm_state_dump.json")}
+	return &config
+}()
+
+// ovmDeployCode returns init code deploying the given runtime code.
+func ovmDeployCode(runtime []byte) []byte {
+	return append([]byte{
+		byte(vm.PUSH1), byte(len(runtime)), byte(vm.PUSH1), 12, byte(vm.PUSH1), 0, byte(vm.CODECOPY),
+		byte(vm.PUSH1), byte(len(runtime)), byte(vm.PUSH1), 0, byte(vm.RETURN),
+	}, runtime...)
+}
+
+// ovmCallCode returns runtime code calling target through ovmCALL and returning
+// the first word it returns.
+func ovmCallCode(target common.Address) []byte {
+	selector := crypto.Keccak256([]byte("ovmCALL()"))[:4]
+	code := []byte{byte(vm.PUSH4), selector[0], selector[1], selector[2], selector[3], byte(vm.PUSH1), 0xe0, byte(vm.SHL), byte(vm.PUSH1), 0x00, byte(vm.MSTORE), byte(vm.PUSH20)}
+	code = append(code, target.Bytes()...)
+	return append(code,
+		byte(vm.PUSH1), 0x04, byte(vm.MSTORE),
+		byte(vm.PUSH1), 0x20, byte(vm.PUSH1), 0x40, byte(vm.PUSH1), 0x24, byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00, byte(vm.CALLER), byte(vm.GAS), byte(vm.CALL), byte(vm.POP),
+		byte(vm.PUSH1), 0x20, byte(vm.PUSH1), 0x40, byte(vm.RETURN),
+	)
+}
+
+// ovmRevertCode returns runtime code reverting with the given reason.
+func ovmRevertCode(reason string) []byte {
+	data := append(common.FromHex("0x08c379a0"), common.LeftPadBytes([]byte{0x20}, 32)...)
+	data = append(data, common.LeftPadBytes([]byte{byte(len(reason))}, 32)...)
+	data = append(data, common.RightPadBytes([]byte(reason), 32)...)
+	return append([]byte{
+		byte(vm.PUSH1), byte(len(data)), byte(vm.PUSH1), 12, byte(vm.PUSH1), 0, byte(vm.CODECOPY),
+		byte(vm.PUSH1), byte(len(data)), byte(vm.PUSH1), 0, byte(vm.REVERT),
+	}, data...)
+}
+
+// newOvmCallTracer creates an OVM call tracer for the test chain.
+func newOvmCallTracer(t *testing.T) *OvmCallTracer {
+	tracer, err := NewOvmCallTracer(ovmChainConfig.OVM.SystemContracts())
+	if err != nil {
+		t.Fatalf("failed to create tracer: %v", err)
+	}
+	return tracer
+}
+
+// applyOvmMessage applies a message sent through the Execution Manager, tracing
+// it if a tracer is given.
+func applyOvmMessage(t *testing.T, statedb *state.StateDB, tracer vm.Tracer, to *common.Address, data []byte) []byte {
+	msg := types.NewMessage(ovmSender, to, statedb.GetNonce(ovmSender), new(big.Int), 15000000, new(big.Int), data, false, &common.Address{}, nil, types.QueueOriginSequencer, types.SighashEIP155)
+	header := &types.Header{Number: new(big.Int), Difficulty: new(big.Int), Time: 1}
+	config := vm.Config{}
+	if tracer != nil {
+		config = vm.Config{Debug: true, Tracer: tracer}
+	}
+	evm := vm.NewEVM(core.NewEVMContext(msg, header, nil, &ovmSender), statedb, ovmChainConfig, config)
+	ret, _, _, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(msg.Gas()))
+	if err != nil {
+		t.Fatalf("failed to apply message: %v", err)
+	}
+	return ret
+}
+
+func TestOvmCallTracer(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	genesis, err := (&core.Genesis{Config: ovmChainConfig}).ToBlock(db)
+	if err != nil {
+		t.Fatalf("failed to create genesis block: %v", err)
+	}
+	statedb, _ := state.New(genesis.Root(), state.NewDatabase(db))
+
+	// Deploy a contract returning 42, a contract calling it and a contract
+	// reverting with a reason, tracing the first deployment.
+	var (
+		callee   = crypto.CreateAddress(ovmSender, 0)
+		caller   = crypto.CreateAddress(ovmSender, 1)
+		reverter = crypto.CreateAddress(ovmSender, 2)
+		forty2   = common.FromHex("602a60005260206000f3")
+	)
+	tracer := newOvmCallTracer(t)
+	applyOvmMessage(t, statedb, tracer, nil, ovmDeployCode(forty2))
+	applyOvmMessage(t, statedb, nil, nil, ovmDeployCode(ovmCallCode(callee)))
+	applyOvmMessage(t, statedb, nil, nil, ovmDeployCode(ovmRevertCode("boom")))
+
+	res, _ := tracer.GetResult()
+	if res.Type != "CREATE" || res.From != ovmSender || res.To != callee {
+		t.Errorf("creation mismatch: have %s from %x to %x, want CREATE from %x to %x", res.Type, res.From, res.To, ovmSender, callee)
+	}
+	if !bytes.Equal(res.Input, ovmDeployCode(forty2)) {
+		t.Errorf("creation input mismatch: have %x", res.Input)
+	}
+	var created bool
+	for _, event := range res.Events {
+		if event.Name == "CreatedContract" && event.Args["ovmContractAddress"] == callee && event.Args["codeContractHash"] == crypto.Keccak256Hash(forty2) {
+			created = true
+		}
+	}
+	if !created {
+		t.Errorf("CreatedContract event missing: %v", res.Events)
+	}
+
+	// Trace a call from the caller to the callee through the Execution Manager.
+	tracer = newOvmCallTracer(t)
+	applyOvmMessage(t, statedb, tracer, &caller, []byte{0x01})
+	if res, _ = tracer.GetResult(); res.Type != "CALL" || res.From != ovmSender || res.To != caller || !bytes.Equal(res.Input, []byte{0x01}) {
+		t.Fatalf("call mismatch: have %s from %x to %x with %x", res.Type, res.From, res.To, res.Input)
+	}
+	if len(res.Calls) != 1 {
+		t.Fatalf("nested call count mismatch: have %d, want 1", len(res.Calls))
+	}
+	if call := res.Calls[0]; call.Type != "CALL" || call.From != caller || call.To != callee || !bytes.Equal(call.Output, common.LeftPadBytes([]byte{42}, 32)) {
+		t.Errorf("nested call mismatch: have %s from %x to %x returning %x", call.Type, call.From, call.To, call.Output)
+	}
+	if len(res.Calls[0].Calls) != 0 {
+		t.Errorf("unexpected calls from the callee: %v", res.Calls[0].Calls)
+	}
+
+	// Trace a call reverting with a reason.
+	tracer = newOvmCallTracer(t)
+	applyOvmMessage(t, statedb, tracer, &reverter, nil)
+	if res, _ = tracer.GetResult(); res.Error != "execution reverted" || res.RevertReason != "boom" {
+		t.Errorf("revert mismatch: have error %q and reason %q", res.Error, res.RevertReason)
+	}
+}
+
+// Tests that transactions to an Execution Manager with other executeTransaction
+// argument names are traced as the raw call instead of crashing the tracer.
+func TestOvmCallTracerUnknownExecuteTransaction(t *testing.T) {
+	const emAbi = `[{"inputs":[{"name":"_sender","type":"address"},{"name":"_target","type":"address"},{"name":"_data","type":"bytes"}],"name":"executeTransaction","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+	contracts := *ovmChainConfig.OVM.SystemContracts()
+	contracts.ExecutionManagerAbi = emAbi
+	tracer, err := NewOvmCallTracer(&contracts)
+	if err != nil {
+		t.Fatalf("failed to create tracer: %v", err)
+	}
+	input, err := tracer.executionManagerAbi.Pack("executeTransaction", ovmSender, common.HexToAddress("0x01"), []byte{0x01})
+	if err != nil {
+		t.Fatalf("failed to pack input: %v", err)
+	}
+	if err := tracer.CaptureStart(ovmSender, contracts.ExecutionManager, false, input, 100000, new(big.Int)); err != nil {
+		t.Fatalf("failed to start trace: %v", err)
+	}
+	if res, _ := tracer.GetResult(); res.Type != "CALL" || res.To != contracts.ExecutionManager || !bytes.Equal(res.Input, input) {
+		t.Errorf("call mismatch: have %s to %x with %x", res.Type, res.To, res.Input)
+	}
+}
@@ -19,16 +19,17 @@ package ethapi
 import (
 	"bytes"
 	"context"
-	"crypto/ecdsa"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/accounts/scwallet"
 	"github.com/ethereum/go-ethereum/common"
@@ -867,9 +868,44 @@ func DoCall(ctx context.Context, b Backend, args CallArgs, blockNrOrHash rpc.Blo
 	if evm.Cancelled() {
 		return nil, 0, false, fmt.Errorf("execution aborted (timeout = %v)", timeout)
 	}
+	// Reverts caught by the Execution Manager do not fail the call, report them
+	// as failures along with their revert data.
+	if revert := core.RevertData(b.ChainConfig(), header.Number, res, failed, state.Logs()); revert != nil {
+		res, failed = revert, true
+	}
 	return res, gas, failed, err
 }
 
+// revertError is an API error that encompasses an EVM revert with JSON error
+// code and a binary data blob.
+type revertError struct {
+	error
+	reason string // revert reason hex encoded
+}
+
+// ErrorCode returns the JSON error code for a revertal.
+// See: https://github.com/ethereum/wiki/wiki/JSON-RPC-Error-Codes-Improvement-Proposal
+func (e *revertError) ErrorCode() int {
+	return 3
+}
+
+// ErrorData returns the hex encoded revert reason.
+func (e *revertError) ErrorData() interface{} {
+	return e.reason
+}
+
+// newRevertError creates a revertError instance with the provided revert data.
+func newRevertError(revert []byte) *revertError {
+	err := errors.New("execution reverted")
+	if reason, errUnpack := abi.UnpackRevert(revert); errUnpack == nil {
+		err = fmt.Errorf("execution reverted: %v", reason)
+	}
+	return &revertError{
+		error:  err,
+		reason: hexutil.Encode(revert),
+	}
+}
+
 // Call executes the given transaction on the state for the given block number.
 //
 // Additionally, the caller can specify a batch of contract for fields overriding.
@@ -881,8 +917,14 @@ func (s *PublicBlockChainAPI) Call(ctx context.Context, args CallArgs, blockNrOr
 	if overrides != nil {
 		accounts = *overrides
 	}
-	result, _, _, err := DoCall(ctx, s.b, args, blockNrOrHash, accounts, vm.Config{}, 5*time.Second, s.b.RPCGasCap())
-	return (hexutil.Bytes)(result), err
+	result, _, failed, err := DoCall(ctx, s.b, args, blockNrOrHash, accounts, vm.Config{}, 5*time.Second, s.b.RPCGasCap())
+	if err != nil {
+		return nil, err
+	}
+	if failed {
+		return nil, newRevertError(result)
+	}
+	return (hexutil.Bytes)(result), nil
 }
 
 func DoEstimateGas(ctx context.Context, b Backend, args CallArgs, blockNrOrHash rpc.BlockNumberOrHash, gasCap *big.Int) (hexutil.Uint64, error) {
@@ -891,8 +933,23 @@ func DoEstimateGas(ctx context.Context, b Backend, args CallArgs, blockNrOrHash
 	if err != nil {
 		return 0, err
 	}
-	// For now always return the gas limit
-	return hexutil.Uint64(block.GasLimit() - 1), nil
+	// For now always return the gas limit, as long as the call succeeds with it
+	limit := hexutil.Uint64(block.GasLimit() - 1)
+	if args.Gas == nil || *args.Gas > limit {
+		args.Gas = &limit
+	}
+	// Only the outcome of the call matters, don't require the sender to pay for it
+	if args.GasPrice == nil {
+		args.GasPrice = new(hexutil.Big)
+	}
+	result, _, failed, err := DoCall(ctx, b, args, blockNrOrHash, nil, vm.Config{}, 0, gasCap)
+	if err != nil {
+		return 0, err
+	}
+	if failed {
+		return 0, newRevertError(result)
+	}
+	return limit, nil
 }
 
 // EstimateGas returns an estimate of the amount of gas needed to execute the
@@ -965,7 +1022,7 @@ func FormatLogs(logs []vm.StructLog) []StructLogRes {
 
 // RPCMarshalHeader converts the given header to the RPC output .
 func RPCMarshalHeader(head *types.Header) map[string]interface{} {
-	return map[string]interface{}{
+	fields := map[string]interface{}{
 		"number":           (*hexutil.Big)(head.Number),
 		"hash":             head.Hash(),
 		"parentHash":       head.ParentHash,
@@ -984,6 +1041,12 @@ func RPCMarshalHeader(head *types.Header) map[string]interface{} {
 		"transactionsRoot": head.TxHash,
 		"receiptsRoot":     head.ReceiptHash,
 	}
+	// OVM blocks also expose the L1 block they were derived from
+	if l1Context, err := types.DecodeL1Context(head.Extra); err == nil {
+		fields["l1BlockNumber"] = hexutil.Uint64(l1Context.BlockNumber)
+		fields["l1Timestamp"] = hexutil.Uint64(l1Context.Timestamp)
+	}
+	return fields
 }
 
 // RPCMarshalBlock converts the given block to the RPC output which depends on fullTx. If inclTx is true transactions are
@@ -1097,10 +1160,7 @@ func newRPCTransaction(tx *types.Transaction, blockHash common.Hash, blockNumber
 	if meta := tx.GetMeta(); meta != nil {
 		result.L1MessageSender = meta.L1MessageSender
 		if meta.QueueOrigin != nil {
-			switch meta.QueueOrigin.Uint64() {
-			case uint64(2):
-				result.QueueOrigin = "sequencer"
-			}
+			result.QueueOrigin = types.QueueOrigin(meta.QueueOrigin.Int64()).String()
 		}
 
 		switch meta.SignatureHashType {
@@ -1149,19 +1209,14 @@ func newRPCTransactionFromBlockHash(b *types.Block, hash common.Hash) *RPCTransa
 
 // PublicTransactionPoolAPI exposes methods for the RPC interface
 type PublicTransactionPoolAPI struct {
-	b                        Backend
-	nonceLock                *AddrLocker
-	rollupTransactionsSigner *ecdsa.PrivateKey
+	b                    Backend
+	nonceLock            *AddrLocker
+	rollupSubmissionLock sync.Mutex // Serializes rollup transaction submissions
 }
 
 // NewPublicTransactionPoolAPI creates a new RPC service with methods specific for the transaction pool.
-func NewPublicTransactionPoolAPI(b Backend, nonceLock *AddrLocker, rollupTransactionsSigner *ecdsa.PrivateKey) *PublicTransactionPoolAPI {
-	if rollupTransactionsSigner == nil {
-		// should only be the case in unused code and some unit tests
-		key, _ := crypto.GenerateKey()
-		return &PublicTransactionPoolAPI{b, nonceLock, key}
-	}
-	return &PublicTransactionPoolAPI{b, nonceLock, rollupTransactionsSigner}
+func NewPublicTransactionPoolAPI(b Backend, nonceLock *AddrLocker) *PublicTransactionPoolAPI {
+	return &PublicTransactionPoolAPI{b: b, nonceLock: nonceLock}
 }
 
 // GetBlockTransactionCountByNumber returns the number of transactions in the block with the given block number.
@@ -1318,6 +1373,16 @@ func (s *PublicTransactionPoolAPI) GetTransactionReceipt(ctx context.Context, ha
 	if receipt.ContractAddress != (common.Address{}) {
 		fields["contractAddress"] = receipt.ContractAddress
 	}
+	// Expose why a failed transaction reverted.
+	if len(receipt.RevertReason) > 0 {
+		fields["revertReason"] = hexutil.Bytes(receipt.RevertReason)
+	}
+	// Show which queue the transaction was executed from.
+	fields["queueOrigin"] = ""
+	fields["l1MessageSender"] = tx.L1MessageSender()
+	if queueOrigin := tx.QueueOrigin(); queueOrigin != nil {
+		fields["queueOrigin"] = types.QueueOrigin(queueOrigin.Int64()).String()
+	}
 	return fields, nil
 }
 
@@ -1426,6 +1491,7 @@ func (args *SendTxArgs) toTransaction() *types.Transaction {
 
 type RollupTransaction struct {
 	L1RollupTxId *hexutil.Uint64 `json:"l1RollupTxId,omitempty"`
+	QueueOrigin  *hexutil.Uint64 `json:"queueOrigin,omitempty"`
 	Nonce        *hexutil.Uint64 `json:"nonce"`
 	GasLimit     *hexutil.Uint64 `json:"gasLimit"`
 	Sender       *common.Address `json:"sender"`
@@ -1433,15 +1499,32 @@ type RollupTransaction struct {
 	Calldata     *hexutil.Bytes  `json:"calldata"`
 }
 
+// queueOrigin returns the queue the RollupTransaction was submitted from. Unless set
+// explicitly, transactions with an L1 tx id come from the L1-to-L2 queue and all
+// others from the sequencer.
+func (r *RollupTransaction) queueOrigin() (types.QueueOrigin, error) {
+	switch {
+	case r.QueueOrigin != nil:
+		if origin := types.QueueOrigin(*r.QueueOrigin); origin <= types.QueueOriginSequencer {
+			return origin, nil
+		}
+		return 0, fmt.Errorf("unknown queue origin %d", uint64(*r.QueueOrigin))
+	case r.L1RollupTxId != nil:
+		return types.QueueOriginL1ToL2, nil
+	default:
+		return types.QueueOriginSequencer, nil
+	}
+}
+
 // Creates a wrapped tx (internal tx that wraps an OVM tx) from the RollupTransaction.
 // The only part of the wrapped tx that has anything to do with the RollupTransaction is the calldata.
-func (r *RollupTransaction) toTransaction(txNonce uint64) *types.Transaction {
+func (r *RollupTransaction) toTransaction(txNonce uint64, queueOrigin types.QueueOrigin) *types.Transaction {
 	var tx *types.Transaction
 	c, _ := r.Calldata.MarshalText()
 	if r.Target == nil {
-		tx = types.NewContractCreation(txNonce, big.NewInt(0), uint64(*r.GasLimit), big.NewInt(0), c, r.Sender, r.L1RollupTxId, types.QueueOriginSequencer)
+		tx = types.NewContractCreation(txNonce, big.NewInt(0), uint64(*r.GasLimit), big.NewInt(0), c, r.Sender, r.L1RollupTxId, queueOrigin)
 	} else {
-		tx = types.NewTransaction(txNonce, *r.Target, big.NewInt(0), uint64(*r.GasLimit), big.NewInt(0), c, r.Sender, r.L1RollupTxId, types.QueueOriginSequencer, types.SighashEIP155)
+		tx = types.NewTransaction(txNonce, *r.Target, big.NewInt(0), uint64(*r.GasLimit), big.NewInt(0), c, r.Sender, r.L1RollupTxId, queueOrigin, types.SighashEIP155)
 	}
 	tx.AddNonceToWrappedTransaction(uint64(*r.Nonce))
 	return tx
@@ -1449,7 +1532,9 @@ func (r *RollupTransaction) toTransaction(txNonce uint64) *types.Transaction {
 
 // SendTxArgs represents the arguments to sumbit a new transaction into the transaction pool.
 type GethSubmission struct {
+	ChainID            *hexutil.Big         `json:"chainId"`
 	Timestamp          *hexutil.Uint64      `json:"timestamp"`
+	L1BlockNumber      *hexutil.Uint64      `json:"l1BlockNumber"`
 	SubmissionNumber   *hexutil.Uint64      `json:"submissionNumber"`
 	RollupTransactions []*RollupTransaction `json:"rollupTransactions"`
 }
@@ -1528,7 +1613,7 @@ func (s *PublicTransactionPoolAPI) SendRawTransaction(ctx context.Context, encod
 	if err := rlp.DecodeBytes(encodedTx, tx); err != nil {
 		return common.Hash{}, err
 	}
-	meta := types.NewTransactionMeta(nil, nil, types.SighashEIP155)
+	meta := types.NewTransactionMeta(nil, nil, types.QueueOriginSequencer, types.SighashEIP155)
 	tx.SetTransactionMeta(meta)
 	return SubmitTransaction(ctx, s.b, tx)
 }
@@ -1542,55 +1627,190 @@ func (s *PublicTransactionPoolAPI) SendRawEthSignTransaction(ctx context.Context
 	if err := rlp.DecodeBytes(encodedTx, tx); err != nil {
 		return common.Hash{}, err
 	}
-	meta := types.NewTransactionMeta(nil, nil, types.SighashEthSign)
+	meta := types.NewTransactionMeta(nil, nil, types.QueueOriginSequencer, types.SighashEthSign)
 	tx.SetTransactionMeta(meta)
 	return SubmitTransaction(ctx, s.b, tx)
 }
 
+var (
+	errRollupSubmissionsDisabled  = errors.New("rollup transaction submissions are disabled")
+	errInvalidSubmissionSignature = errors.New("rollup transaction submission not signed by the rollup transaction sender")
+)
+
 // SendRollupTransactions will:
-// * Verify the batches are signed by the RollupTransaction sender.
-// * Update the Geth timestamp to the provided timestamp
+// * Verify the submission is signed by the RollupTransaction sender for this chain.
+// * Wrap the RollupTransactions in transactions signed by the sender's managed account.
+// * Verify the submission number is greater than the one of the last accepted submission.
+// * Tie the transactions to the L1 block number and timestamp that new blocks derive from
 // * handle the provided batch of RollupTransactions atomically
+//
+// The JSON encoded submission is signed the way eth_sign signs data, with a V of 27/28
+// or 0/1. If any of the transactions is rejected by the transaction pool, or if they do
+// not fit together in one block, the submission is not accepted and can be retried.
+// The transactions of an accepted submission are mined in one block, all of them or none.
 func (s *PublicTransactionPoolAPI) SendRollupTransactions(ctx context.Context, messageAndSig []hexutil.Bytes) []error {
 	if len(messageAndSig) != 2 {
 		return []error{fmt.Errorf("incorrect number of arguments. Expected 2, got %d", len(messageAndSig))}
 	}
-
-	// TODO: Ignoring signature because we'll move this logic into geth shortly.
+	sender := s.b.RollupTransactionSender()
+	if err := verifySubmissionSignature(sender, messageAndSig[0], messageAndSig[1]); err != nil {
+		return []error{err}
+	}
+	signTx, err := s.rollupTxSigner(*sender)
+	if err != nil {
+		return []error{err}
+	}
 
 	var submission GethSubmission
 	if err := json.Unmarshal(messageAndSig[0], &submission); err != nil {
 		return []error{fmt.Errorf("incorrect format for RollupTransactions type. Received: %s", messageAndSig[0])}
 	}
+	if submission.ChainID == nil || submission.Timestamp == nil || submission.L1BlockNumber == nil || submission.SubmissionNumber == nil {
+		return []error{errors.New("missing submission chain id, timestamp, l1 block number or submission number")}
+	}
+	// The chain id is part of the signed submission, so that it is not replayed on other chains
+	if chainID := s.b.ChainConfig().ChainID; chainID == nil || submission.ChainID.ToInt().Cmp(chainID) != 0 {
+		return []error{fmt.Errorf("submission chain id %v does not match chain id %v", submission.ChainID.ToInt(), chainID)}
+	}
+	queueOrigins := make([]types.QueueOrigin, len(submission.RollupTransactions))
+	for i, rollupTx := range submission.RollupTransactions {
+		if rollupTx == nil || rollupTx.Nonce == nil || rollupTx.GasLimit == nil || rollupTx.Calldata == nil {
+			return []error{fmt.Errorf("incomplete rollup transaction at index %d", i)}
+		}
+		if queueOrigins[i], err = rollupTx.queueOrigin(); err != nil {
+			return []error{fmt.Errorf("invalid rollup transaction at index %d: %v", i, err)}
+		}
+	}
 
-	txCount := 0
-	signer := types.MakeSigner(s.b.ChainConfig(), s.b.CurrentBlock().Number())
+	// Submissions are handled one at a time so that their numbers and the wrapped
+	// transaction nonces are consistent.
+	s.rollupSubmissionLock.Lock()
+	defer s.rollupSubmissionLock.Unlock()
 
-	wrappedTxNonce, _ := s.b.GetPoolNonce(ctx, crypto.PubkeyToAddress(s.rollupTransactionsSigner.PublicKey))
+	number := uint64(*submission.SubmissionNumber)
+	last := rawdb.ReadRollupSubmissionNumber(s.b.ChainDb())
+	if last != nil && number <= *last {
+		return []error{fmt.Errorf("submission number %d not greater than last accepted submission %d", number, *last)}
+	}
+
+	// L1 time never goes back: a submission may share the L1 block of the current
+	// L1 context or of the head block, but precede neither of them.
+	l1Context := types.L1Context{
+		BlockNumber: uint64(*submission.L1BlockNumber),
+		Timestamp:   uint64(*submission.Timestamp),
+	}
+	prevContexts := []types.L1Context{s.b.L1Context()}
+	if headContext, err := types.DecodeL1Context(s.b.CurrentBlock().Extra()); err == nil {
+		prevContexts = append(prevContexts, headContext)
+	}
+	for _, prev := range prevContexts {
+		if !l1Context.Follows(prev) {
+			return []error{fmt.Errorf("submission l1 context (block %d, timestamp %d) precedes l1 block %d at timestamp %d",
+				l1Context.BlockNumber, l1Context.Timestamp, prev.BlockNumber, prev.Timestamp)}
+		}
+	}
+	txCount := 0
+	wrappedTxNonce, err := s.b.GetPoolNonce(ctx, *sender)
+	if err != nil {
+		return []error{fmt.Errorf("rollup transaction sender %s nonce unavailable: %v", sender.Hex(), err)}
+	}
 	signedTransactions := make([]*types.Transaction, len(submission.RollupTransactions))
 	for i, rollupTx := range submission.RollupTransactions {
-		tx := rollupTx.toTransaction(wrappedTxNonce)
+		tx := rollupTx.toTransaction(wrappedTxNonce, queueOrigins[i])
 		wrappedTxNonce++
-		tx, err := types.SignTx(tx, signer, s.rollupTransactionsSigner)
+		signed, err := signTx(tx)
 		if err != nil {
-			return []error{fmt.Errorf("error signing transaction in batch %d, index %d", submission.SubmissionNumber, i)}
+			return []error{fmt.Errorf("error signing transaction in batch %d, index %d: %v", *submission.SubmissionNumber, i, err)}
 		}
-		signedTransactions[i] = tx
+		// External signers return the transaction without its metadata
+		signed.SetTransactionMeta(tx.GetMeta())
+		signed.SetL1Context(l1Context)
+		signedTransactions[i] = signed
 		txCount++
 	}
 
-	s.b.SetTimestamp(int64(*submission.Timestamp))
+	// The miner never splits a submission, so one that does not fit in a block is rejected
+	if txCount > 0 {
+		signedTransactions[0].SetSubmissionSize(txCount)
+		if err := s.b.CheckSubmission(signedTransactions); err != nil {
+			return []error{fmt.Errorf("rollup submission %d does not fit in one block: %v", number, err)}
+		}
+	}
+
+	// The number is consumed before the transactions are sent, so that no crash leaves
+	// a submission replayable once its transactions were accepted. Rejected submissions
+	// hand it back.
+	rawdb.WriteRollupSubmissionNumber(s.b.ChainDb(), number)
 
 	i := 0
 	errs := make([]error, txCount)
-	// TODO: Eventually make sure each batch is handled atomically
+	accepted := true
 	for _, e := range s.b.SendTxs(ctx, signedTransactions) {
 		errs[i] = e
+		accepted = accepted && e == nil
 		i++
 	}
+	// Only accepted submissions advance the L1 context and consume their number
+	switch {
+	case accepted:
+		s.b.SetL1Context(l1Context)
+	case last != nil:
+		rawdb.WriteRollupSubmissionNumber(s.b.ChainDb(), *last)
+	default:
+		rawdb.DeleteRollupSubmissionNumber(s.b.ChainDb())
+	}
 	return errs
 }
 
+// rollupTxSigner returns the function signing the transactions wrapping rollup
+// transactions. A sender key loaded from a key file is used directly, it is kept out
+// of the account manager so that no other API can sign with it. Other senders are
+// looked up in the account manager.
+func (s *PublicTransactionPoolAPI) rollupTxSigner(sender common.Address) (func(*types.Transaction) (*types.Transaction, error), error) {
+	chainID := s.b.ChainConfig().ChainID
+	if key := s.b.RollupTransactionSenderKey(); key != nil {
+		var signer types.Signer = types.HomesteadSigner{}
+		if chainID != nil {
+			signer = types.NewEIP155Signer(chainID)
+		}
+		return func(tx *types.Transaction) (*types.Transaction, error) {
+			return types.SignTx(tx, signer, key)
+		}, nil
+	}
+	account := accounts.Account{Address: sender}
+	wallet, err := s.b.AccountManager().Find(account)
+	if err != nil {
+		return nil, fmt.Errorf("rollup transaction sender %s unavailable: %v", sender.Hex(), err)
+	}
+	return func(tx *types.Transaction) (*types.Transaction, error) {
+		return wallet.SignTx(account, tx, chainID)
+	}, nil
+}
+
+// verifySubmissionSignature checks that the rollup transaction submission was signed by
+// the provided sender, as in eth_sign.
+func verifySubmissionSignature(sender *common.Address, message, sig []byte) error {
+	if sender == nil {
+		return errRollupSubmissionsDisabled
+	}
+	if len(sig) != crypto.SignatureLength {
+		return fmt.Errorf("invalid rollup transaction submission signature: must be %d bytes long", crypto.SignatureLength)
+	}
+	// Transform yellow paper V from 27/28 to 0/1, without modifying the caller's signature
+	if v := sig[crypto.RecoveryIDOffset]; v == 27 || v == 28 {
+		sig = common.CopyBytes(sig)
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pubkey, err := crypto.SigToPub(accounts.TextHash(message), sig)
+	if err != nil {
+		return fmt.Errorf("invalid rollup transaction submission signature: %v", err)
+	}
+	if crypto.PubkeyToAddress(*pubkey) != *sender {
+		return errInvalidSubmissionSignature
+	}
+	return nil
+}
+
 // Sign calculates an ECDSA signature for:
 // keccack256("\x19Ethereum Signed Message:\n" + len(message) + message).
 //
@@ -4,17 +4,23 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"math/big"
 	"math/rand"
+	"os"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/awstesting"
 	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/bloombits"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -31,7 +37,8 @@ var (
 	internalTxCalldata = hexutil.Bytes{0, 1, 2, 3, 4, 5, 6, 7}
 	internalTxSender   = common.Address{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
 	internalTxTarget   = common.Address{9, 8, 7, 6, 5, 4, 3, 2, 1, 0, 9, 8, 7, 6, 5, 4, 3, 2, 1, 0}
-	backendTimestamp   = int64(0)
+	backendL1Context   = types.L1Context{}
+	testChainID        = (*hexutil.Big)(params.TestChainConfig.ChainID)
 )
 
 type testCase struct {
@@ -44,6 +51,7 @@ type testCase struct {
 }
 
 func getTestCases(pk *ecdsa.PrivateKey) []testCase {
+	otherKey, _ := crypto.GenerateKey()
 	return []testCase{
 		// Bad input -- message and sig not of length 2
 		{inputCtx: getFakeContext(), inputMessageAndSig: []hexutil.Bytes{}, hasErrors: true},
@@ -53,34 +61,51 @@ func getTestCases(pk *ecdsa.PrivateKey) []testCase {
 		// Bad input -- message not signed
 		{inputCtx: getFakeContext(), inputMessageAndSig: []hexutil.Bytes{[]byte{1}, []byte{2}}, hasErrors: true},
 
+		// Bad input -- message signed by another key
+		{inputCtx: getFakeContext(), inputMessageAndSig: getRollupTransactionsInputAndSignature(otherKey, 1, 1, 1), hasErrors: true},
+
+		// Bad input -- message hash signed without the eth_sign prefix
+		{inputCtx: getFakeContext(), inputMessageAndSig: getUnprefixedRollupTransactionsInputAndSignature(pk), hasErrors: true},
+
 		// Bad input -- message is signed but incorrect format
 		{inputCtx: getFakeContext(), inputMessageAndSig: getInputMessageAndSignature([]byte{1}, pk), hasErrors: true},
 
+		// Bad input -- message is signed but misses the l1 block number
+		{inputCtx: getFakeContext(), inputMessageAndSig: getInputMessageAndSignature([]byte(`{"chainId":"0x1","timestamp":"0x1","submissionNumber":"0x1","rollupTransactions":[]}`), pk), hasErrors: true},
+
+		// Bad input -- message is signed but misses the chain id, or is signed for another chain
+		{inputCtx: getFakeContext(), inputMessageAndSig: getInputMessageAndSignature([]byte(`{"timestamp":"0x1","l1BlockNumber":"0x1","submissionNumber":"0x1","rollupTransactions":[]}`), pk), hasErrors: true},
+		{inputCtx: getFakeContext(), inputMessageAndSig: getInputMessageAndSignature([]byte(`{"chainId":"0x2","timestamp":"0x1","l1BlockNumber":"0x1","submissionNumber":"0x1","rollupTransactions":[]}`), pk), hasErrors: true},
+
 		// Returns 0 errors if no transactions but timestamp updated
 		{inputCtx: getFakeContext(), inputMessageAndSig: getRollupTransactionsInputAndSignature(pk, 0, 1, 0)},
 		{inputCtx: getFakeContext(), inputMessageAndSig: getRollupTransactionsInputAndSignature(pk, 1, 1, 0), resultingTimestamp: 1},
 
-		// Handles one transaction and updates timestamp
+		// Handles one transaction and updates timestamp, unless it is rejected
 		{inputCtx: getFakeContext(), inputMessageAndSig: getRollupTransactionsInputAndSignature(pk, 1, 1, 1), resultingTimestamp: 1},
-		{backendContext: backendContext{sendTxsErrors: getDummyErrors([]int{0}, 1)}, inputCtx: getFakeContext(), inputMessageAndSig: getRollupTransactionsInputAndSignature(pk, 1, 1, 1), hasErrors: true, resultingTimestamp: 1},
+		{inputCtx: getFakeContext(), inputMessageAndSig: withYellowPaperV(getRollupTransactionsInputAndSignature(pk, 1, 1, 1)), resultingTimestamp: 1},
+		{backendContext: backendContext{sendTxsErrors: getDummyErrors([]int{0}, 1)}, inputCtx: getFakeContext(), inputMessageAndSig: getRollupTransactionsInputAndSignature(pk, 1, 1, 1), hasErrors: true},
 
 		// Handles one batch of multiple transaction and updates timestamp
 		{inputCtx: getFakeContext(), inputMessageAndSig: getRollupTransactionsInputAndSignature(pk, 1, 1, 2), resultingTimestamp: 1},
-		{backendContext: backendContext{sendTxsErrors: getDummyErrors([]int{1}, 2)}, inputCtx: getFakeContext(), inputMessageAndSig: getRollupTransactionsInputAndSignature(pk, 1, 2, 2), hasErrors: true, resultingTimestamp: 1},
+		{backendContext: backendContext{sendTxsErrors: getDummyErrors([]int{1}, 2)}, inputCtx: getFakeContext(), inputMessageAndSig: getRollupTransactionsInputAndSignature(pk, 1, 2, 2), hasErrors: true},
+
+		// Rejects a batch that does not fit in one block and leaves the timestamp
+		{backendContext: backendContext{submissionError: errors.New("too large")}, inputCtx: getFakeContext(), inputMessageAndSig: getRollupTransactionsInputAndSignature(pk, 1, 1, 2), hasErrors: true},
 
 		// Handles multiple transactions and updates timestamp
 		{inputCtx: getFakeContext(), inputMessageAndSig: getRollupTransactionsInputAndSignature(pk, 2, 1, 3), resultingTimestamp: 2},
-		{backendContext: backendContext{sendTxsErrors: getDummyErrors([]int{0, 2}, 3)}, inputCtx: getFakeContext(), inputMessageAndSig: getRollupTransactionsInputAndSignature(pk, 1, 1, 3), hasErrors: true, resultingTimestamp: 1, multipleBatches: true},
+		{backendContext: backendContext{sendTxsErrors: getDummyErrors([]int{0, 2}, 3)}, inputCtx: getFakeContext(), inputMessageAndSig: getRollupTransactionsInputAndSignature(pk, 1, 1, 3), hasErrors: true, multipleBatches: true},
 	}
 }
 
 func TestSendRollupTransactions(t *testing.T) {
-	rollupTransactionsSender, _ := crypto.GenerateKey()
-	txSignerPrivKey, _ := crypto.GenerateKey()
+	rollupTransactionsSender, am, cleanup := newTestRollupTxSender(t)
+	defer cleanup()
 
 	for testNum, testCase := range getTestCases(rollupTransactionsSender) {
-		backendTimestamp = 0
-		api := getTestPublicTransactionPoolAPI(txSignerPrivKey, rollupTransactionsSender, testCase.backendContext)
+		backendL1Context = types.L1Context{}
+		api := getTestPublicTransactionPoolAPI(am, rollupTransactionsSender, testCase.backendContext)
 		res := api.SendRollupTransactions(testCase.inputCtx, testCase.inputMessageAndSig)
 		h := func(r []error) bool {
 			for _, e := range r {
@@ -130,10 +155,287 @@ func TestSendRollupTransactions(t *testing.T) {
 				}
 			}
 		}
-		if backendTimestamp != testCase.resultingTimestamp {
-			t.Fatalf("test case %d should have updated timestamp to %d but it was %d after execution.", testNum, testCase.resultingTimestamp, backendTimestamp)
+		if backendL1Context.Timestamp != uint64(testCase.resultingTimestamp) {
+			t.Fatalf("test case %d should have updated timestamp to %d but it was %d after execution.", testNum, testCase.resultingTimestamp, backendL1Context.Timestamp)
+		}
+		if backendL1Context.BlockNumber != uint64(testCase.resultingTimestamp) {
+			t.Fatalf("test case %d should have updated l1 block number to %d but it was %d after execution.", testNum, testCase.resultingTimestamp, backendL1Context.BlockNumber)
+		}
+	}
+}
+
+func TestSendRollupTransactionsSubmissionNumber(t *testing.T) {
+	rollupTransactionsSender, am, cleanup := newTestRollupTxSender(t)
+	defer cleanup()
+	api := getTestPublicTransactionPoolAPI(am, rollupTransactionsSender, backendContext{})
+
+	send := func(number int) bool {
+		for _, err := range api.SendRollupTransactions(getFakeContext(), getRollupTransactionsInputAndSignature(rollupTransactionsSender, 1, number, 1)) {
+			if err != nil {
+				return false
+			}
+		}
+		return true
+	}
+	if !send(2) {
+		t.Fatalf("first submission rejected")
+	}
+	if send(2) || send(1) {
+		t.Fatalf("replayed or earlier submission accepted")
+	}
+	if !send(4) {
+		t.Fatalf("later submission rejected")
+	}
+	if last := rawdb.ReadRollupSubmissionNumber(api.b.ChainDb()); last == nil || *last != 4 {
+		t.Fatalf("last accepted submission mismatch: have %v, want 4", last)
+	}
+
+	// The number is consumed by the time the transactions are sent
+	var sending *uint64
+	hooked := getTestPublicTransactionPoolAPI(am, rollupTransactionsSender, backendContext{sendTxsHook: func() {
+		sending = rawdb.ReadRollupSubmissionNumber(api.b.ChainDb())
+	}})
+	hooked.b = api.b.(mockBackend).withContext(hooked.b.(mockBackend).testContext)
+	for _, err := range hooked.SendRollupTransactions(getFakeContext(), getRollupTransactionsInputAndSignature(rollupTransactionsSender, 1, 5, 1)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if sending == nil || *sending != 5 {
+		t.Fatalf("submission number mismatch while sending: have %v, want 5", sending)
+	}
+
+	// A submission whose transactions are rejected does not consume its number
+	failing := getTestPublicTransactionPoolAPI(am, rollupTransactionsSender, backendContext{sendTxsErrors: getDummyErrors([]int{0}, 1)})
+	failing.SendRollupTransactions(getFakeContext(), getRollupTransactionsInputAndSignature(rollupTransactionsSender, 1, 1, 1))
+	if last := rawdb.ReadRollupSubmissionNumber(failing.b.ChainDb()); last != nil {
+		t.Fatalf("rejected submission recorded as %d", *last)
+	}
+	failing.b = api.b.(mockBackend).withContext(failing.b.(mockBackend).testContext)
+	failing.SendRollupTransactions(getFakeContext(), getRollupTransactionsInputAndSignature(rollupTransactionsSender, 1, 6, 1))
+	if last := rawdb.ReadRollupSubmissionNumber(failing.b.ChainDb()); last == nil || *last != 5 {
+		t.Fatalf("last accepted submission mismatch after a rejection: have %v, want 5", last)
+	}
+
+	// Nor does one whose transactions cannot be wrapped
+	unwrappable := getTestPublicTransactionPoolAPI(am, rollupTransactionsSender, backendContext{nonceError: errors.New("nonce unavailable")})
+	if errs := unwrappable.SendRollupTransactions(getFakeContext(), getRollupTransactionsInputAndSignature(rollupTransactionsSender, 1, 1, 1)); len(errs) != 1 || errs[0] == nil {
+		t.Fatalf("expected an error for an unavailable nonce, got %v", errs)
+	}
+	if last := rawdb.ReadRollupSubmissionNumber(unwrappable.b.ChainDb()); last != nil {
+		t.Fatalf("unwrapped submission recorded as %d", *last)
+	}
+}
+
+// Tests that submissions going back in L1 time from the current L1 context or the one
+// of the head block are rejected, while those sharing their L1 block are accepted.
+func TestSendRollupTransactionsL1Context(t *testing.T) {
+	rollupTransactionsSender, am, cleanup := newTestRollupTxSender(t)
+	defer cleanup()
+
+	prev := types.L1Context{BlockNumber: 10, Timestamp: 100}
+	tests := []struct {
+		next     types.L1Context
+		accepted bool
+	}{
+		{types.L1Context{BlockNumber: 10, Timestamp: 100}, true},
+		{types.L1Context{BlockNumber: 11, Timestamp: 101}, true},
+		{types.L1Context{BlockNumber: 11, Timestamp: 99}, false},
+		{types.L1Context{BlockNumber: 9, Timestamp: 101}, false},
+	}
+	for i, tt := range tests {
+		for _, head := range []bool{false, true} {
+			backendL1Context = prev
+			ctx := backendContext{}
+			if head {
+				backendL1Context, ctx.headL1Context = types.L1Context{}, &prev
+			}
+			api := getTestPublicTransactionPoolAPI(am, rollupTransactionsSender, ctx)
+
+			ts, l1BlockNumber, number := hexutil.Uint64(tt.next.Timestamp), hexutil.Uint64(tt.next.BlockNumber), hexutil.Uint64(1)
+			message, _ := json.Marshal(&GethSubmission{ChainID: testChainID, Timestamp: &ts, L1BlockNumber: &l1BlockNumber, SubmissionNumber: &number, RollupTransactions: []*RollupTransaction{getRandomRollupTransaction()}})
+			errs := api.SendRollupTransactions(getFakeContext(), getInputMessageAndSignature(message, rollupTransactionsSender))
+			if accepted := len(errs) == 1 && errs[0] == nil; accepted != tt.accepted {
+				t.Errorf("test %d (head %t): acceptance mismatch: have %t, want %t: %v", i, head, accepted, tt.accepted, errs)
+			}
+			if tt.accepted && backendL1Context != tt.next {
+				t.Errorf("test %d (head %t): l1 context mismatch: have %+v, want %+v", i, head, backendL1Context, tt.next)
+			}
+			if !tt.accepted && !head && backendL1Context != prev {
+				t.Errorf("test %d (head %t): l1 context changed to %+v", i, head, backendL1Context)
+			}
+		}
+	}
+}
+
+func TestSendRollupTransactionsDisabled(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	api := NewPublicTransactionPoolAPI(newMockBackend(nil, backendContext{}), nil)
+
+	errs := api.SendRollupTransactions(getFakeContext(), getRollupTransactionsInputAndSignature(key, 1, 1, 1))
+	if len(errs) != 1 || errs[0] != errRollupSubmissionsDisabled {
+		t.Fatalf("expected %v, got %v", errRollupSubmissionsDisabled, errs)
+	}
+}
+
+func TestSendRollupTransactionsSignedBySender(t *testing.T) {
+	rollupTransactionsSender, am, cleanup := newTestRollupTxSender(t)
+	defer cleanup()
+
+	var sent []*types.Transaction
+	api := getTestPublicTransactionPoolAPI(am, rollupTransactionsSender, backendContext{sentTxs: &sent})
+	for _, err := range api.SendRollupTransactions(getFakeContext(), getRollupTransactionsInputAndSignature(rollupTransactionsSender, 1, 1, 2)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if len(sent) != 2 {
+		t.Fatalf("expected 2 transactions, got %d", len(sent))
+	}
+	for i, tx := range sent {
+		from, err := types.Sender(types.NewEIP155Signer(params.TestChainConfig.ChainID), tx)
+		if err != nil || from != crypto.PubkeyToAddress(rollupTransactionsSender.PublicKey) {
+			t.Fatalf("transaction %d: unexpected sender %s: %v", i, from.Hex(), err)
+		}
+		if tx.Nonce() != uint64(i) || *tx.L1MessageSender() != internalTxSender {
+			t.Fatalf("transaction %d: unexpected wrapped transaction", i)
+		}
+		if l1Context := tx.L1Context(); l1Context == nil || *l1Context != (types.L1Context{BlockNumber: 1, Timestamp: 1}) {
+			t.Fatalf("transaction %d: unexpected l1 context %v", i, l1Context)
+		}
+	}
+
+	// A sender whose account is not available rejects submissions
+	otherSender, _ := crypto.GenerateKey()
+	api = getTestPublicTransactionPoolAPI(am, otherSender, backendContext{})
+	if errs := api.SendRollupTransactions(getFakeContext(), getRollupTransactionsInputAndSignature(otherSender, 1, 1, 1)); len(errs) != 1 || errs[0] == nil {
+		t.Fatalf("expected an error for an unavailable sender account, got %v", errs)
+	}
+}
+
+// Tests that mined rollup transactions record the queue they were submitted from.
+func TestSendRollupTransactionsQueueOrigin(t *testing.T) {
+	rollupTransactionsSender, am, cleanup := newTestRollupTxSender(t)
+	defer cleanup()
+
+	var sent []*types.Transaction
+	address := crypto.PubkeyToAddress(rollupTransactionsSender.PublicKey)
+	backend := newMockBackend(&address, backendContext{sentTxs: &sent})
+	backend.am = am
+	api := NewPublicTransactionPoolAPI(backend, nil)
+
+	genesis := &core.Genesis{Config: params.TestChainConfig}
+	genesisBlock := genesis.MustCommit(backend.db)
+	chain, err := core.NewBlockChain(backend.db, nil, genesis.Config, ethash.NewFaker(), vm.Config{}, nil)
+	if err != nil {
+		t.Fatalf("unable to create blockchain: %v", err)
+	}
+	defer chain.Stop()
+
+	safety := hexutil.Uint64(types.QueueOriginSafety)
+	fromL1, fromSequencer, fromSafety := getRandomRollupTransaction(), getRandomRollupTransaction(), getRandomRollupTransaction()
+	fromSequencer.L1RollupTxId = nil
+	fromSafety.QueueOrigin = &safety
+	rollupTxs := []*RollupTransaction{fromL1, fromSequencer, fromSafety}
+	for _, rollupTx := range rollupTxs {
+		gasLimit := hexutil.Uint64(100_000)
+		rollupTx.GasLimit = &gasLimit
+	}
+	ts, number := hexutil.Uint64(1), hexutil.Uint64(1)
+	message, _ := json.Marshal(&GethSubmission{ChainID: testChainID, Timestamp: &ts, L1BlockNumber: &ts, SubmissionNumber: &number, RollupTransactions: rollupTxs})
+	for _, err := range api.SendRollupTransactions(getFakeContext(), getInputMessageAndSignature(message, rollupTransactionsSender)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	blocks, _ := core.GenerateChain(genesis.Config, genesisBlock, ethash.NewFaker(), backend.db, 1, func(i int, gen *core.BlockGen) {
+		for _, tx := range sent {
+			gen.AddTx(tx)
+		}
+	})
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("unable to insert block: %v", err)
+	}
+	for i, want := range []types.QueueOrigin{types.QueueOriginL1ToL2, types.QueueOriginSequencer, types.QueueOriginSafety} {
+		receipt, err := api.GetTransactionReceipt(context.Background(), sent[i].Hash())
+		if err != nil || receipt == nil {
+			t.Fatalf("transaction %d: unable to get receipt: %v", i, err)
+		}
+		if have := receipt["queueOrigin"]; have != want.String() {
+			t.Fatalf("transaction %d: queue origin mismatch: have %v, want %v", i, have, want)
+		}
+	}
+
+	// Unknown queue origins are rejected
+	unknown := hexutil.Uint64(types.QueueOriginSequencer + 1)
+	fromSafety.QueueOrigin = &unknown
+	number++
+	message, _ = json.Marshal(&GethSubmission{ChainID: testChainID, Timestamp: &ts, L1BlockNumber: &ts, SubmissionNumber: &number, RollupTransactions: rollupTxs})
+	if errs := api.SendRollupTransactions(getFakeContext(), getInputMessageAndSignature(message, rollupTransactionsSender)); len(errs) != 1 || errs[0] == nil {
+		t.Fatalf("expected an error for an unknown queue origin, got %v", errs)
+	}
+}
+
+// Tests that a rollup transaction sender loaded from a key file signs the wrapping
+// transactions without being known to the account manager.
+func TestSendRollupTransactionsSenderKey(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+
+	am := accounts.NewManager(&accounts.Config{})
+	defer am.Close()
+
+	var sent []*types.Transaction
+	backend := newMockBackend(&address, backendContext{sentTxs: &sent})
+	backend.am = am
+	backend.rollupTransactionSenderKey = key
+	api := NewPublicTransactionPoolAPI(backend, nil)
+
+	for _, err := range api.SendRollupTransactions(getFakeContext(), getRollupTransactionsInputAndSignature(key, 1, 1, 2)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if len(sent) != 2 {
+		t.Fatalf("expected 2 transactions, got %d", len(sent))
+	}
+	for i, tx := range sent {
+		if from, err := types.Sender(types.NewEIP155Signer(params.TestChainConfig.ChainID), tx); err != nil || from != address {
+			t.Fatalf("transaction %d: unexpected sender %s: %v", i, from.Hex(), err)
 		}
 	}
+	// The key must not sign anything else, least of all a forged submission
+	message, _ := json.Marshal(&GethSubmission{ChainID: testChainID})
+	if _, err := api.Sign(address, message); err == nil {
+		t.Fatalf("eth_sign with the rollup transaction sender succeeded")
+	}
+	if _, err := api.SendTransaction(context.Background(), SendTxArgs{From: address}); err == nil {
+		t.Fatalf("eth_sendTransaction from the rollup transaction sender succeeded")
+	}
+}
+
+// newTestRollupTxSender creates a rollup transaction sender key, unlocked in the keystore
+// of the returned account manager.
+func newTestRollupTxSender(t *testing.T) (*ecdsa.PrivateKey, *accounts.Manager, func()) {
+	dir, err := ioutil.TempDir("", "rollup-tx-sender")
+	if err != nil {
+		t.Fatalf("unable to create keystore dir: %v", err)
+	}
+	key, _ := crypto.GenerateKey()
+	ks := keystore.NewKeyStore(dir, keystore.LightScryptN, keystore.LightScryptP)
+	account, err := ks.ImportECDSA(key, "")
+	if err != nil {
+		t.Fatalf("unable to import key: %v", err)
+	}
+	if err := ks.Unlock(account, ""); err != nil {
+		t.Fatalf("unable to unlock account: %v", err)
+	}
+	am := accounts.NewManager(&accounts.Config{}, ks)
+	return key, am, func() {
+		am.Close()
+		os.RemoveAll(dir)
+	}
 }
 
 func getDummyErrors(errorIndicies []int, outputSize int) []error {
@@ -157,6 +459,8 @@ func getRandomRollupTransaction() *RollupTransaction {
 	}
 }
 
+// getRollupTransactionsInputAndSignature signs a submission of batchSize random rollup
+// transactions, derived from the L1 block numbered after the timestamp.
 func getRollupTransactionsInputAndSignature(privKey *ecdsa.PrivateKey, timestamp int64, blockNumber int, batchSize int) []hexutil.Bytes {
 	ts := hexutil.Uint64(uint64(timestamp))
 	blockNum := hexutil.Uint64(uint64(blockNumber))
@@ -166,7 +470,9 @@ func getRollupTransactionsInputAndSignature(privKey *ecdsa.PrivateKey, timestamp
 		rollupTransactions[index] = getRandomRollupTransaction()
 	}
 	bb := &GethSubmission{
+		ChainID:            testChainID,
 		Timestamp:          &ts,
+		L1BlockNumber:      &ts,
 		SubmissionNumber:   &blockNum,
 		RollupTransactions: rollupTransactions,
 	}
@@ -175,11 +481,27 @@ func getRollupTransactionsInputAndSignature(privKey *ecdsa.PrivateKey, timestamp
 	return getInputMessageAndSignature(message, privKey)
 }
 
-func getInputMessageAndSignature(message []byte, privKey *ecdsa.PrivateKey) []hexutil.Bytes {
+// getUnprefixedRollupTransactionsInputAndSignature signs the plain keccak256 hash of a
+// submission instead of its eth_sign hash.
+func getUnprefixedRollupTransactionsInputAndSignature(privKey *ecdsa.PrivateKey) []hexutil.Bytes {
+	message := getRollupTransactionsInputAndSignature(privKey, 1, 1, 1)[0]
 	sig, _ := crypto.Sign(crypto.Keccak256(message), privKey)
 	return []hexutil.Bytes{message, sig}
 }
 
+func getInputMessageAndSignature(message []byte, privKey *ecdsa.PrivateKey) []hexutil.Bytes {
+	sig, _ := crypto.Sign(accounts.TextHash(message), privKey)
+	return []hexutil.Bytes{message, sig}
+}
+
+// withYellowPaperV converts the V of the signature of the message from 0/1 to 27/28, as
+// returned by eth_sign.
+func withYellowPaperV(messageAndSig []hexutil.Bytes) []hexutil.Bytes {
+	sig := common.CopyBytes(messageAndSig[1])
+	sig[crypto.RecoveryIDOffset] += 27
+	return []hexutil.Bytes{messageAndSig[0], sig}
+}
+
 func getFakeContext() context.Context {
 	return &awstesting.FakeContext{
 		Error:  fmt.Errorf("fake error%s", "!"),
@@ -187,31 +509,47 @@ func getFakeContext() context.Context {
 	}
 }
 
-func getTestPublicTransactionPoolAPI(txSignerPrivKey *ecdsa.PrivateKey, rollupTransactionsSender *ecdsa.PrivateKey, backendContext backendContext) *PublicTransactionPoolAPI {
+func getTestPublicTransactionPoolAPI(am *accounts.Manager, rollupTransactionsSender *ecdsa.PrivateKey, backendContext backendContext) *PublicTransactionPoolAPI {
 	address := crypto.PubkeyToAddress(rollupTransactionsSender.PublicKey)
 	backend := newMockBackend(&address, backendContext)
-	return NewPublicTransactionPoolAPI(backend, nil, txSignerPrivKey)
+	backend.am = am
+	return NewPublicTransactionPoolAPI(backend, nil)
 }
 
 type backendContext struct {
 	currentBlockNumber int64
 	signerNonce        uint64
 	sendTxsErrors      []error
+	sentTxs            *[]*types.Transaction
+	submissionError    error
+	headL1Context      *types.L1Context
+	nonceError         error
+	sendTxsHook        func()
 }
 
 type mockBackend struct {
-	rollupTransactionSender *common.Address
-	testContext             backendContext
-	timestamp               int64
+	am                         *accounts.Manager
+	db                         ethdb.Database
+	rollupTransactionSender    *common.Address
+	rollupTransactionSenderKey *ecdsa.PrivateKey
+	testContext                backendContext
+	timestamp                  int64
 }
 
 func newMockBackend(rollupTransactionSender *common.Address, backendContext backendContext) mockBackend {
 	return mockBackend{
+		db:                      rawdb.NewMemoryDatabase(),
 		rollupTransactionSender: rollupTransactionSender,
 		testContext:             backendContext,
 	}
 }
 
+// withContext returns a copy of the backend, sharing its database, with another context.
+func (m mockBackend) withContext(backendContext backendContext) mockBackend {
+	m.testContext = backendContext
+	return m
+}
+
 func (m mockBackend) Downloader() *downloader.Downloader {
 	panic("not implemented")
 }
@@ -225,11 +563,11 @@ func (m mockBackend) SuggestPrice(ctx context.Context) (*big.Int, error) {
 }
 
 func (m mockBackend) ChainDb() ethdb.Database {
-	panic("not implemented")
+	return m.db
 }
 
 func (m mockBackend) AccountManager() *accounts.Manager {
-	panic("not implemented")
+	return m.am
 }
 
 func (m mockBackend) ExtRPCEnabled() bool {
@@ -277,7 +615,11 @@ func (m mockBackend) StateAndHeaderByNumberOrHash(ctx context.Context, blockNrOr
 }
 
 func (m mockBackend) GetReceipts(ctx context.Context, hash common.Hash) (types.Receipts, error) {
-	panic("not implemented")
+	number := rawdb.ReadHeaderNumber(m.db, hash)
+	if number == nil {
+		return nil, nil
+	}
+	return rawdb.ReadReceipts(m.db, hash, *number, m.ChainConfig()), nil
 }
 
 func (m mockBackend) GetTd(hash common.Hash) *big.Int {
@@ -317,7 +659,7 @@ func (m mockBackend) GetPoolTransaction(txHash common.Hash) *types.Transaction {
 }
 
 func (m mockBackend) GetPoolNonce(ctx context.Context, addr common.Address) (uint64, error) {
-	return m.testContext.signerNonce, nil
+	return m.testContext.signerNonce, m.testContext.nonceError
 }
 
 func (m mockBackend) Stats() (pending int, queued int) {
@@ -357,24 +699,42 @@ func (m mockBackend) SubscribeRemovedLogsEvent(ch chan<- core.RemovedLogsEvent)
 }
 
 func (m mockBackend) SendTxs(ctx context.Context, signedTxs []*types.Transaction) []error {
+	if m.testContext.sendTxsHook != nil {
+		m.testContext.sendTxsHook()
+	}
+	if m.testContext.sentTxs != nil {
+		*m.testContext.sentTxs = append(*m.testContext.sentTxs, signedTxs...)
+	}
 	if len(m.testContext.sendTxsErrors) == 0 || len(m.testContext.sendTxsErrors) != len(signedTxs) {
 		return make([]error, len(signedTxs))
 	}
 	return m.testContext.sendTxsErrors
 }
 
-func (m mockBackend) SetTimestamp(timestamp int64) {
-	backendTimestamp = timestamp
+func (m mockBackend) L1Context() types.L1Context {
+	return backendL1Context
+}
+
+func (m mockBackend) SetL1Context(l1Context types.L1Context) {
+	backendL1Context = l1Context
+}
+
+func (m mockBackend) CheckSubmission(txs []*types.Transaction) error {
+	return m.testContext.submissionError
 }
 
 func (m mockBackend) ChainConfig() *params.ChainConfig {
-	return &params.ChainConfig{}
+	return params.TestChainConfig
 }
 
 func (m mockBackend) RollupTransactionSender() *common.Address {
 	return m.rollupTransactionSender
 }
 
+func (m mockBackend) RollupTransactionSenderKey() *ecdsa.PrivateKey {
+	return m.rollupTransactionSenderKey
+}
+
 func (m mockBackend) CurrentBlock() *types.Block {
 	header := &types.Header{
 		ParentHash:  common.Hash{},
@@ -393,6 +753,48 @@ func (m mockBackend) CurrentBlock() *types.Block {
 		MixDigest:   common.Hash{},
 		Nonce:       types.BlockNonce{},
 	}
+	if m.testContext.headL1Context != nil {
+		header.Time, header.Extra = m.testContext.headL1Context.Timestamp, m.testContext.headL1Context.Extra()
+	}
 
 	return types.NewBlock(header, []*types.Transaction{}, []*types.Header{}, []*types.Receipt{})
 }
+
+func TestNewRevertError(t *testing.T) {
+	reason := "0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000d72657665727420726561736f6e00000000000000000000000000000000000000"
+	tests := []struct {
+		revert  string
+		message string
+	}{
+		{reason, "execution reverted: revert reason"},
+		{"0xdeadbeef", "execution reverted"},
+		{"0x", "execution reverted"},
+	}
+	for i, tt := range tests {
+		err := newRevertError(common.FromHex(tt.revert))
+		if err.Error() != tt.message {
+			t.Errorf("test %d: message mismatch: have %q, want %q", i, err.Error(), tt.message)
+		}
+		if err.ErrorCode() != 3 {
+			t.Errorf("test %d: error code mismatch: have %d, want 3", i, err.ErrorCode())
+		}
+		if err.ErrorData() != tt.revert {
+			t.Errorf("test %d: error data mismatch: have %v, want %s", i, err.ErrorData(), tt.revert)
+		}
+	}
+}
+
+func TestRPCMarshalHeaderL1Context(t *testing.T) {
+	header := &types.Header{Number: big.NewInt(1), Difficulty: big.NewInt(1), Time: 1601475125}
+	if fields := RPCMarshalHeader(header); fields["l1BlockNumber"] != nil || fields["l1Timestamp"] != nil {
+		t.Fatalf("l1 context exposed for header without one: %v, %v", fields["l1BlockNumber"], fields["l1Timestamp"])
+	}
+	header.Extra = types.L1Context{BlockNumber: 10934832, Timestamp: 1601475125}.Extra()
+	fields := RPCMarshalHeader(header)
+	if have, want := fields["l1BlockNumber"], hexutil.Uint64(10934832); have != want {
+		t.Errorf("l1 block number mismatch: have %v, want %v", have, want)
+	}
+	if have, want := fields["l1Timestamp"], hexutil.Uint64(1601475125); have != want {
+		t.Errorf("l1 timestamp mismatch: have %v, want %v", have, want)
+	}
+}
@@ -19,6 +19,7 @@ package ethapi
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts"
@@ -83,12 +84,15 @@ type Backend interface {
 	SubscribeRemovedLogsEvent(ch chan<- core.RemovedLogsEvent) event.Subscription
 
 	// Optimism-specific API
-	SendTxs(ctx context.Context, signedTxs []*types.Transaction) []error
-	SetTimestamp(timestamp int64)
+	SendTxs(ctx context.Context, signedTxs []*types.Transaction) []error // Adds all transactions or none
+	L1Context() types.L1Context                                          // Retrieves the L1 block new blocks are derived from
+	SetL1Context(l1Context types.L1Context)                              // Sets the L1 block new blocks are derived from
+	CheckSubmission(txs []*types.Transaction) error                      // Checks the transactions fit together in one block
 
 	ChainConfig() *params.ChainConfig
 	CurrentBlock() *types.Block
 	RollupTransactionSender() *common.Address
+	RollupTransactionSenderKey() *ecdsa.PrivateKey
 }
 
 func GetAPIs(apiBackend Backend) []rpc.API {
@@ -107,9 +111,8 @@ func GetAPIs(apiBackend Backend) []rpc.API {
 		}, {
 			Namespace: "eth",
 			Version:   "1.0",
-			// TODO: Instantiate Private Key from env var here when we know it
-			Service: NewPublicTransactionPoolAPI(apiBackend, nonceLock, nil),
-			Public:  true,
+			Service:   NewPublicTransactionPoolAPI(apiBackend, nonceLock),
+			Public:    true,
 		}, {
 			Namespace: "txpool",
 			Version:   "1.0",
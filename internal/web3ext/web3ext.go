@@ -33,6 +33,7 @@ var Modules = map[string]string{
 	"swarmfs":    SwarmfsJs,
 	"txpool":     TxpoolJs,
 	"les":        LESJs,
+	"rollup":     RollupJs,
 }
 
 const ChequebookJs = `
@@ -856,3 +857,47 @@ web3._extend({
 	]
 });
 `
+
+const RollupJs = `
+web3._extend({
+	property: 'rollup',
+	methods: [
+		new web3._extend.Method({
+			name: 'estimateBatchCost',
+			call: 'rollup_estimateBatchCost',
+			params: 2,
+			inputFormatter: [null, null]
+		}),
+		new web3._extend.Method({
+			name: 'getTransitionWitness',
+			call: 'rollup_getTransitionWitness',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'getRawTransitionWitness',
+			call: 'rollup_getRawTransitionWitness',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'resumeIngestion',
+			call: 'rollup_resumeIngestion',
+			params: 0
+		}),
+	],
+	properties:
+	[
+		new web3._extend.Property({
+			name: 'status',
+			getter: 'rollup_status'
+		}),
+		new web3._extend.Property({
+			name: 'verifierStatus',
+			getter: 'rollup_verifierStatus'
+		}),
+		new web3._extend.Property({
+			name: 'ingestionStatus',
+			getter: 'rollup_ingestionStatus'
+		}),
+	]
+});
+`
@@ -18,6 +18,7 @@ package les
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"errors"
 	"math/big"
 
@@ -45,10 +46,17 @@ type LesApiBackend struct {
 	gpo           *gasprice.Oracle
 }
 
+// RollupTransactionSender returns nil, light clients do not accept rollup transaction
+// submissions as they cannot add them to a block atomically.
 func (b *LesApiBackend) RollupTransactionSender() *common.Address {
 	return nil
 }
 
+// RollupTransactionSenderKey returns nil, see RollupTransactionSender.
+func (b *LesApiBackend) RollupTransactionSenderKey() *ecdsa.PrivateKey {
+	return nil
+}
+
 func (b *LesApiBackend) ChainConfig() *params.ChainConfig {
 	return b.eth.chainConfig
 }
@@ -185,10 +193,20 @@ func (b *LesApiBackend) SendTxs(ctx context.Context, signedTxs []*types.Transact
 	return b.eth.txPool.AddBatch(ctx, signedTxs)
 }
 
-func (b *LesApiBackend) SetTimestamp(timestamp int64) {
+func (b *LesApiBackend) L1Context() types.L1Context {
+	// Light clients do not derive blocks from L1, so any context follows this one
+	return types.L1Context{}
+}
+
+func (b *LesApiBackend) SetL1Context(l1Context types.L1Context) {
 	// Intentionally empty because this is not needed for LightChain
 }
 
+func (b *LesApiBackend) CheckSubmission(txs []*types.Transaction) error {
+	// Intentionally empty because light clients do not mine blocks
+	return nil
+}
+
 func (b *LesApiBackend) RemoveTx(txHash common.Hash) {
 	b.eth.txPool.RemoveTx(txHash)
 }
@@ -39,13 +39,9 @@ import (
 type odrTestFn func(ctx context.Context, db ethdb.Database, config *params.ChainConfig, bc *core.BlockChain, lc *light.LightChain, bhash common.Hash) []byte
 
 func TestOdrGetBlockLes2(t *testing.T) {
-	t.Skip("OVM breaks this with `insufficient balance for transfer`, probably because transfers don't work.")
-
 	testOdr(t, 2, 1, true, odrGetBlock)
 }
 func TestOdrGetBlockLes3(t *testing.T) {
-	t.Skip("OVM breaks this with `insufficient balance for transfer`, probably because transfers don't work.")
-
 	testOdr(t, 3, 1, true, odrGetBlock)
 }
 
@@ -64,13 +60,9 @@ func odrGetBlock(ctx context.Context, db ethdb.Database, config *params.ChainCon
 }
 
 func TestOdrGetReceiptsLes2(t *testing.T) {
-	t.Skip("OVM breaks this with `insufficient balance for transfer`, probably because transfers don't work.")
-
 	testOdr(t, 2, 1, true, odrGetReceipts)
 }
 func TestOdrGetReceiptsLes3(t *testing.T) {
-	t.Skip("OVM breaks this with `insufficient balance for transfer`, probably because transfers don't work.")
-
 	testOdr(t, 3, 1, true, odrGetReceipts)
 }
 
@@ -93,13 +85,9 @@ func odrGetReceipts(ctx context.Context, db ethdb.Database, config *params.Chain
 }
 
 func TestOdrAccountsLes2(t *testing.T) {
-	t.Skip("OVM breaks this with `insufficient balance for transfer`, probably because transfers don't work.")
-
 	testOdr(t, 2, 1, true, odrAccounts)
 }
 func TestOdrAccountsLes3(t *testing.T) {
-	t.Skip("OVM breaks this with `insufficient balance for transfer`, probably because transfers don't work.")
-
 	testOdr(t, 3, 1, true, odrAccounts)
 }
 
@@ -130,13 +118,9 @@ func odrAccounts(ctx context.Context, db ethdb.Database, config *params.ChainCon
 }
 
 func TestOdrContractCallLes2(t *testing.T) {
-	t.Skip("OVM breaks this with `insufficient balance for transfer`, probably because transfers don't work.")
-
 	testOdr(t, 2, 2, true, odrContractCall)
 }
 func TestOdrContractCallLes3(t *testing.T) {
-	t.Skip("OVM breaks this with `insufficient balance for transfer`, probably because transfers don't work.")
-
 	testOdr(t, 3, 2, true, odrContractCall)
 }
 
@@ -188,13 +172,9 @@ func odrContractCall(ctx context.Context, db ethdb.Database, config *params.Chai
 }
 
 func TestOdrTxStatusLes2(t *testing.T) {
-	t.Skip("OVM breaks this with `insufficient balance for transfer`, probably because transfers don't work.")
-
 	testOdr(t, 2, 1, false, odrTxStatus)
 }
 func TestOdrTxStatusLes3(t *testing.T) {
-	t.Skip("OVM breaks this with `insufficient balance for transfer`, probably because transfers don't work.")
-
 	testOdr(t, 3, 1, false, odrTxStatus)
 }
 
@@ -37,13 +37,9 @@ func secAddr(addr common.Address) []byte {
 type accessTestFn func(db ethdb.Database, bhash common.Hash, number uint64) light.OdrRequest
 
 func TestBlockAccessLes2(t *testing.T) {
-	t.Skip("OVM breaks this with `insufficient balance for transfer`, probably because transfers don't work.")
-
 	testAccess(t, 2, tfBlockAccess)
 }
 func TestBlockAccessLes3(t *testing.T) {
-	t.Skip("OVM breaks this with `insufficient balance for transfer`, probably because transfers don't work.")
-
 	testAccess(t, 3, tfBlockAccess)
 }
 
@@ -52,13 +48,9 @@ func tfBlockAccess(db ethdb.Database, bhash common.Hash, number uint64) light.Od
 }
 
 func TestReceiptsAccessLes2(t *testing.T) {
-	t.Skip("OVM breaks this with `insufficient balance for transfer`, probably because transfers don't work.")
-
 	testAccess(t, 2, tfReceiptsAccess)
 }
 func TestReceiptsAccessLes3(t *testing.T) {
-	t.Skip("OVM breaks this with `insufficient balance for transfer`, probably because transfers don't work.")
-
 	testAccess(t, 3, tfReceiptsAccess)
 }
 
@@ -67,13 +59,9 @@ func tfReceiptsAccess(db ethdb.Database, bhash common.Hash, number uint64) light
 }
 
 func TestTrieEntryAccessLes2(t *testing.T) {
-	t.Skip("OVM breaks this with `insufficient balance for transfer`, probably because transfers don't work.")
-
 	testAccess(t, 2, tfTrieEntryAccess)
 }
 func TestTrieEntryAccessLes3(t *testing.T) {
-	t.Skip("OVM breaks this with `insufficient balance for transfer`, probably because transfers don't work.")
-
 	testAccess(t, 3, tfTrieEntryAccess)
 }
 
@@ -85,13 +73,9 @@ func tfTrieEntryAccess(db ethdb.Database, bhash common.Hash, number uint64) ligh
 }
 
 func TestCodeAccessLes2(t *testing.T) {
-	t.Skip("OVM breaks this with `insufficient balance for transfer`, probably because transfers don't work.")
-
 	testAccess(t, 2, tfCodeAccess)
 }
 func TestCodeAccessLes3(t *testing.T) {
-	t.Skip("OVM breaks this with `insufficient balance for transfer`, probably because transfers don't work.")
-
 	testAccess(t, 3, tfCodeAccess)
 }
 
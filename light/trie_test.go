@@ -33,8 +33,6 @@ import (
 )
 
 func TestNodeIterator(t *testing.T) {
-	t.Skip("OVM breaks this with `account does not exist`, probably because of the genesis state.")
-
 	var (
 		fulldb  = rawdb.NewMemoryDatabase()
 		lightdb = rawdb.NewMemoryDatabase()
@@ -51,6 +51,20 @@ type Config struct {
 	GasPrice  *big.Int       // Minimum gas price for mining a transaction
 	Recommit  time.Duration  // The time interval for miner to re-create mining work.
 	Noverify  bool           // Disable remote mining solution verification(only useful in ethash).
+
+	MaxTransactions int `toml:",omitempty"` // Maximum number of transactions in a mined block (0 = unlimited)
+
+	// NewBlockBound, if set, bounds mined blocks beyond their gas limit: it creates the
+	// BlockBound of a new block with the provided header.
+	NewBlockBound func(header *types.Header) BlockBound `toml:"-"`
+}
+
+// BlockBound bounds a block being mined beyond its gas limit. The transactions of the
+// block are added to it in order, along with the state root after each of them.
+type BlockBound interface {
+	// Add adds the transaction to the block if the block still fits with it, reporting
+	// whether it does.
+	Add(tx *types.Transaction, root common.Hash) bool
 }
 
 // Miner creates blocks and searches for proof-of-work values.
@@ -164,6 +178,12 @@ func (miner *Miner) SetRecommitInterval(interval time.Duration) {
 	miner.worker.setRecommitInterval(interval)
 }
 
+// CheckSubmission returns an error if the transactions of a rollup submission, which
+// are mined together, cannot fit in a block on top of the current head.
+func (miner *Miner) CheckSubmission(txs []*types.Transaction) error {
+	return miner.worker.checkSubmission(txs)
+}
+
 // Pending returns the currently pending block and associated state.
 func (miner *Miner) Pending() (*types.Block, *state.StateDB) {
 	return miner.worker.pending()
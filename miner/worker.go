@@ -19,6 +19,7 @@ package miner
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"math/big"
 	"sync"
 	"sync/atomic"
@@ -79,6 +80,10 @@ const (
 	timestampDelaySeconds = 300 // 5 mins for now
 )
 
+// errBlockLimitReached is returned when a transaction would take the current block
+// beyond its Config.NewBlockBound bound.
+var errBlockLimitReached = errors.New("block limit reached")
+
 // environment is the worker's current environment and holds all of the current state information.
 type environment struct {
 	signer types.Signer
@@ -93,6 +98,10 @@ type environment struct {
 	header   *types.Header
 	txs      []*types.Transaction
 	receipts []*types.Receipt
+
+	bound BlockBound     // Bound of the block, if blocks are bounded by Config.NewBlockBound
+	base  *state.StateDB // State before the transactions of the block, to replay them on, if they may be undone
+	full  bool           // Whether a transaction was rejected by the bound of the block
 }
 
 // task contains all information for consensus engine sealing and result submitting.
@@ -113,7 +122,7 @@ const (
 type newWorkReq struct {
 	interrupt *int32
 	noempty   bool
-	timestamp int64
+	l1Context types.L1Context
 }
 
 // intervalAdjust represents a resubmitting interval adjustment.
@@ -223,7 +232,11 @@ func newWorker(config *Config, chainConfig *params.ChainConfig, engine consensus
 	go worker.newWorkLoop(recommit)
 	go worker.resultLoop()
 	go worker.taskLoop()
-	go worker.timestampLoop()
+	// OVM blocks take their timestamp from the L1 block they are derived from,
+	// which must not be moved along with the local clock.
+	if !chainConfig.IsOVM() {
+		go worker.timestampLoop()
+	}
 
 	// Submit first work to initialize pending state.
 	if init {
@@ -296,8 +309,8 @@ func (w *worker) close() {
 func (w *worker) newWorkLoop(recommit time.Duration) {
 	var (
 		interrupt   *int32
-		minRecommit = recommit // minimal resubmit interval specified by user.
-		timestamp   int64      // timestamp for each round of mining.
+		minRecommit = recommit      // minimal resubmit interval specified by user.
+		l1Context   types.L1Context // L1 context for each round of mining.
 	)
 
 	timer := time.NewTimer(0)
@@ -309,7 +322,7 @@ func (w *worker) newWorkLoop(recommit time.Duration) {
 			atomic.StoreInt32(interrupt, s)
 		}
 		interrupt = new(int32)
-		w.newWorkCh <- &newWorkReq{interrupt: interrupt, noempty: noempty, timestamp: timestamp}
+		w.newWorkCh <- &newWorkReq{interrupt: interrupt, noempty: noempty, l1Context: l1Context}
 		timer.Reset(recommit)
 		atomic.StoreInt32(&w.newTxs, 0)
 	}
@@ -349,12 +362,12 @@ func (w *worker) newWorkLoop(recommit time.Duration) {
 		select {
 		case <-w.startCh:
 			clearPending(w.chain.CurrentBlock().NumberU64())
-			timestamp = w.chain.CurrentTimestamp()
+			l1Context = w.chain.CurrentL1Context()
 			commit(false, commitInterruptNewHead)
 
 		case head := <-w.chainHeadCh:
 			clearPending(head.Block.NumberU64())
-			timestamp = w.chain.CurrentTimestamp()
+			l1Context = w.chain.CurrentL1Context()
 			commit(false, commitInterruptNewHead)
 
 		case <-timer.C:
@@ -366,7 +379,7 @@ func (w *worker) newWorkLoop(recommit time.Duration) {
 					timer.Reset(recommit)
 					continue
 				}
-				timestamp = w.chain.CurrentTimestamp()
+				l1Context = w.chain.CurrentL1Context()
 				commit(true, commitInterruptResubmit)
 			}
 
@@ -414,7 +427,7 @@ func (w *worker) mainLoop() {
 	for {
 		select {
 		case req := <-w.newWorkCh:
-			w.commitNewWork(req.interrupt, req.noempty, req.timestamp)
+			w.commitNewWork(req.interrupt, req.noempty, req.l1Context)
 
 		case ev := <-w.chainSideCh:
 			// Short circuit for duplicate side blocks
@@ -487,7 +500,7 @@ func (w *worker) mainLoop() {
 				// If clique is running in dev mode(period is 0), disable
 				// advance sealing here.
 				if w.chainConfig.Clique != nil && w.chainConfig.Clique.Period == 0 {
-					w.commitNewWork(nil, true, w.chain.CurrentTimestamp())
+					w.commitNewWork(nil, true, w.chain.CurrentL1Context())
 				}
 			}
 			atomic.AddInt32(&w.newTxs, int32(len(ev.Txs)))
@@ -726,10 +739,6 @@ func (w *worker) updateSnapshot() {
 }
 
 func (w *worker) commitTransaction(tx *types.Transaction, coinbase common.Address) ([]*types.Log, error) {
-	// Make sure there's only one tx per block
-	if w.current != nil && len(w.current.txs) > 0 {
-		return nil, core.ErrGasLimitReached
-	}
 	snap := w.current.state.Snapshot()
 
 	receipt, err := core.ApplyTransaction(w.chainConfig, w.chain, &coinbase, w.current.gasPool, w.current.state, w.current.header, tx, &w.current.header.GasUsed, *w.chain.GetVMConfig())
@@ -737,12 +746,130 @@ func (w *worker) commitTransaction(tx *types.Transaction, coinbase common.Addres
 		w.current.state.RevertToSnapshot(snap)
 		return nil, err
 	}
+	if w.current.bound != nil {
+		root := w.current.state.IntermediateRoot(w.chainConfig.IsEIP158(w.current.header.Number))
+		if !w.current.bound.Add(tx, root) {
+			w.replayTransactions(coinbase, false)
+			return nil, errBlockLimitReached
+		}
+	}
 	w.current.txs = append(w.current.txs, tx)
 	w.current.receipts = append(w.current.receipts, receipt)
 
 	return receipt.Logs, nil
 }
 
+// replayTransactions undoes a transaction rejected by the bound of the current block.
+// Applied transactions cannot be reverted to a snapshot once the state is finalised,
+// so the committed transactions are replayed on a copy of the state the block started
+// from instead. The block is full once a transaction is rejected, so this happens at
+// most once per block unless the block has no transactions yet.
+//
+// If rebound is set, the bound of the block is recreated from the replayed transactions,
+// as needed when transactions it accepted are undone.
+func (w *worker) replayTransactions(coinbase common.Address, rebound bool) {
+	var (
+		env     = w.current
+		gasPool = new(core.GasPool).AddGas(env.header.GasLimit)
+		gasUsed uint64
+	)
+	if env.base == nil {
+		// Should never happen, transactions are only undone if the block keeps its base
+		log.Error("Failed to replay block transactions, missing base state")
+		return
+	}
+	rebound = rebound && env.bound != nil
+	if rebound {
+		env.bound = w.config.NewBlockBound(env.header)
+	}
+	env.state = env.base.Copy()
+	for i, tx := range env.txs {
+		env.state.Prepare(tx.Hash(), common.Hash{}, i)
+		_, err := core.ApplyTransaction(w.chainConfig, w.chain, &coinbase, gasPool, env.state, env.header, tx, &gasUsed, *w.chain.GetVMConfig())
+		if err == nil && rebound && !env.bound.Add(tx, env.state.IntermediateRoot(w.chainConfig.IsEIP158(env.header.Number))) {
+			err = errBlockLimitReached
+		}
+		if err != nil {
+			// Should never happen, fall back to an empty block
+			log.Error("Failed to replay block transactions", "hash", tx.Hash(), "err", err)
+			env.state, env.txs, env.receipts, env.tcount = env.base.Copy(), nil, nil, 0
+			gasPool, gasUsed = new(core.GasPool).AddGas(env.header.GasLimit), 0
+			if env.bound != nil {
+				env.bound = w.config.NewBlockBound(env.header)
+			}
+			break
+		}
+	}
+	env.gasPool, env.header.GasUsed = gasPool, gasUsed
+}
+
+// commitSubmission commits the transactions of a rollup submission to the current block,
+// all of them or none. The submission is never split over several blocks, so if one of
+// its transactions fails, the ones already applied are undone by replaying the others.
+func (w *worker) commitSubmission(txs types.Transactions, coinbase common.Address) ([]*types.Log, error) {
+	if w.config.MaxTransactions > 0 && len(w.current.txs)+len(txs) > w.config.MaxTransactions {
+		return nil, errBlockLimitReached
+	}
+	var (
+		committed = len(w.current.txs)
+		coalesced []*types.Log
+	)
+	for i, tx := range txs {
+		w.current.state.Prepare(tx.Hash(), common.Hash{}, w.current.tcount+i)
+
+		logs, err := w.commitTransaction(tx, coinbase)
+		if err != nil {
+			if i > 0 {
+				w.current.txs, w.current.receipts = w.current.txs[:committed], w.current.receipts[:committed]
+				w.replayTransactions(coinbase, true)
+			}
+			return nil, err
+		}
+		coalesced = append(coalesced, logs...)
+	}
+	return coalesced, nil
+}
+
+// checkSubmission returns an error if the transactions of a rollup submission do not
+// fit together in an empty block on top of the current head. Their hashes stand in for
+// the intermediate state roots of the bound, which are as large and as incompressible.
+func (w *worker) checkSubmission(txs []*types.Transaction) error {
+	if w.config.MaxTransactions > 0 && len(txs) > w.config.MaxTransactions {
+		return fmt.Errorf("submission of %d transactions exceeds the block limit of %d", len(txs), w.config.MaxTransactions)
+	}
+	parent := w.chain.CurrentBlock()
+	num := parent.Number()
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Number:     num.Add(num, common.Big1),
+		GasLimit:   core.CalcGasLimit(parent, w.config.GasFloor, w.config.GasCeil),
+		Time:       parent.Time(),
+	}
+	w.mu.RLock()
+	header.Coinbase = w.coinbase
+	w.mu.RUnlock()
+	if len(txs) > 0 && txs[0].L1Context() != nil {
+		l1Context := txs[0].L1Context()
+		header.Extra, header.Time = l1Context.Extra(), l1Context.Timestamp
+	}
+	gas := header.GasLimit
+	for _, tx := range txs {
+		if tx.Gas() > gas {
+			return fmt.Errorf("submission gas exceeds the block gas limit of %d", header.GasLimit)
+		}
+		gas -= tx.Gas()
+	}
+	if w.config.NewBlockBound != nil {
+		bound := w.config.NewBlockBound(header)
+		for _, tx := range txs {
+			if !bound.Add(tx, tx.Hash()) {
+				return fmt.Errorf("submission of %d transactions exceeds the transition batch limits of a block", len(txs))
+			}
+		}
+	}
+	return nil
+}
+
 func (w *worker) commitTransactions(txs *types.TransactionsByPriceAndNonce, coinbase common.Address, interrupt *int32) bool {
 	// Short circuit if current is nil
 	if w.current == nil {
@@ -755,6 +882,11 @@ func (w *worker) commitTransactions(txs *types.TransactionsByPriceAndNonce, coin
 
 	var coalescedLogs []*types.Log
 
+	// OVM blocks only hold transactions of the L1 context they record, or of none.
+	// Transactions of later L1 contexts are left to the following blocks.
+	blockContext, err := types.DecodeL1Context(w.current.header.Extra)
+	boundContext := w.chainConfig.IsOVM() && err == nil
+
 	for {
 		// In the following three cases, we will interrupt the execution of the transaction.
 		// (1) new head block event arrival, the interrupt signal is 1
@@ -781,6 +913,15 @@ func (w *worker) commitTransactions(txs *types.TransactionsByPriceAndNonce, coin
 			log.Trace("Not enough gas for further transactions", "have", w.current.gasPool, "want", params.TxGas)
 			break
 		}
+		// If the block is full then we're done as well
+		if w.config.MaxTransactions > 0 && len(w.current.txs) >= w.config.MaxTransactions {
+			log.Trace("Transaction limit reached for current block", "limit", w.config.MaxTransactions)
+			break
+		}
+		if w.current.full {
+			log.Trace("Block limit reached for current block", "txs", len(w.current.txs))
+			break
+		}
 		// Retrieve the next transaction and abort if all done
 		tx := txs.Peek()
 		if tx == nil {
@@ -799,11 +940,65 @@ func (w *worker) commitTransactions(txs *types.TransactionsByPriceAndNonce, coin
 			txs.Pop()
 			continue
 		}
+		if l1Context := tx.L1Context(); boundContext && l1Context != nil && l1Context.After(blockContext) {
+			log.Trace("Skipping transaction of a later L1 context", "sender", from, "l1BlockNumber", l1Context.BlockNumber, "l1Timestamp", l1Context.Timestamp)
+
+			txs.Pop()
+			continue
+		}
+		// The transactions of a rollup submission go in one block, all of them or none
+		if size := tx.SubmissionSize(); size > 1 {
+			if w.current.base == nil {
+				// The block cannot undo a failed submission, leave it to the next one
+				log.Trace("Skipping rollup submission until the next block", "sender", from, "size", size)
+				txs.Pop()
+				continue
+			}
+			submission := txs.PeekN(size)
+			if len(submission) < size {
+				log.Trace("Skipping incomplete rollup submission", "sender", from, "have", len(submission), "want", size)
+				txs.Pop()
+				continue
+			}
+			logs, err := w.commitSubmission(submission, coinbase)
+			if err == errBlockLimitReached && len(w.current.txs) > 0 {
+				log.Trace("Block limit reached for current block", "txs", len(w.current.txs))
+				w.current.full = true
+				break
+			}
+			switch err {
+			case nil:
+				coalescedLogs = append(coalescedLogs, logs...)
+				w.current.tcount += size
+				txs.ShiftN(size)
+
+			case errBlockLimitReached:
+				// The submission does not fit even in an empty block, skip the account
+				log.Warn("Skipping rollup submission exceeding the block limit", "hash", tx.Hash(), "sender", from, "size", size)
+				txs.Pop()
+
+			default:
+				// A submission is never split, so the account is skipped whatever the error
+				log.Debug("Rollup submission failed, account skipped", "hash", tx.Hash(), "size", size, "err", err)
+				txs.Pop()
+			}
+			continue
+		}
 		// Start executing the transaction
 		w.current.state.Prepare(tx.Hash(), common.Hash{}, w.current.tcount)
 
 		logs, err := w.commitTransaction(tx, coinbase)
+		if err == errBlockLimitReached && len(w.current.txs) > 0 {
+			log.Trace("Block limit reached for current block", "txs", len(w.current.txs))
+			w.current.full = true
+			break
+		}
 		switch err {
+		case errBlockLimitReached:
+			// The transaction does not fit even in an empty block, skip the account
+			log.Warn("Skipping transaction exceeding the block limit", "hash", tx.Hash(), "sender", from)
+			txs.Pop()
+
 		case core.ErrGasLimitReached:
 			// Pop the current out-of-gas transaction without shifting in the next from the account
 			log.Trace("Gas limit exceeded for current block", "sender", from)
@@ -857,31 +1052,57 @@ func (w *worker) commitTransactions(txs *types.TransactionsByPriceAndNonce, coin
 }
 
 // commitNewWork generates several new sealing tasks based on the parent block.
-func (w *worker) commitNewWork(interrupt *int32, noempty bool, timestamp int64) {
+func (w *worker) commitNewWork(interrupt *int32, noempty bool, l1Context types.L1Context) {
 	w.mu.RLock()
 	defer w.mu.RUnlock()
 
 	tstart := time.Now()
 	parent := w.chain.CurrentBlock()
 
-	// TODO: Is there some other sensible check that we can do in place of the below code with timestamps set from L1?
-	//if parent.Time() >= uint64(timestamp) {
-	//	timestamp = int64(parent.Time() + 1)
-	//}
-	// this will ensure we're not going off too far in the future
-	//if now := time.Now().Unix(); timestamp > now+1 {
-	//	wait := time.Duration(timestamp-now) * time.Second
-	//	log.Info("Mining too far in the future", "wait", common.PrettyDuration(wait))
-	//	time.Sleep(wait)
-	//}
-
+	pending, err := w.eth.TxPool().Pending()
+	if err != nil {
+		log.Error("Failed to fetch pending transactions", "err", err)
+		return
+	}
+	// Transactions are mined in blocks of the L1 context of their submission, so the
+	// oldest one of the pending transactions goes first.
+	if w.chainConfig.IsOVM() {
+		statedb, err := w.chain.StateAt(parent.Root())
+		if err != nil {
+			log.Error("Failed to create mining context", "err", err)
+			return
+		}
+		if pendingContext := oldestL1Context(statedb, pending); pendingContext != nil {
+			l1Context = *pendingContext
+		}
+	}
+	// Blocks may share the L1 block of their parent, but never go back in L1 time.
+	// An older L1 context (e.g. one set before a newer block was imported) is
+	// replaced by the L1 context of the parent.
+	if parentContext, err := types.DecodeL1Context(parent.Extra()); err == nil {
+		if parentContext.After(l1Context) {
+			log.Debug("Mining on the L1 context of the parent", "l1BlockNumber", l1Context.BlockNumber, "l1Timestamp", l1Context.Timestamp,
+				"parentL1BlockNumber", parentContext.BlockNumber, "parentL1Timestamp", parentContext.Timestamp)
+			l1Context = parentContext
+		}
+	}
+	if parent.Time() > l1Context.Timestamp {
+		l1Context.Timestamp = parent.Time()
+	}
 	num := parent.Number()
 	header := &types.Header{
 		ParentHash: parent.Hash(),
 		Number:     num.Add(num, common.Big1),
 		GasLimit:   core.CalcGasLimit(parent, w.config.GasFloor, w.config.GasCeil),
 		Extra:      w.extra,
-		Time:       uint64(timestamp),
+		Time:       l1Context.Timestamp,
+	}
+	// OVM blocks record the L1 block they are derived from ahead of the miner extra-data
+	if w.chainConfig.IsOVM() {
+		header.Extra = append(l1Context.Extra(), w.extra...)
+		if uint64(len(header.Extra)) > params.MaximumExtraDataSize {
+			header.Extra = header.Extra[:params.MaximumExtraDataSize]
+		}
 	}
 	// Only set the coinbase if our consensus engine is running (avoid spurious block rewards)
 	if w.isRunning() {
@@ -909,7 +1130,7 @@ func (w *worker) commitNewWork(interrupt *int32, noempty bool, timestamp int64)
 		}
 	}
 	// Could potentially happen if starting to mine in an odd state.
-	err := w.makeCurrent(parent, header)
+	err = w.makeCurrent(parent, header)
 	if err != nil {
 		log.Error("Failed to create mining context", "err", err)
 		return
@@ -919,6 +1140,15 @@ func (w *worker) commitNewWork(interrupt *int32, noempty bool, timestamp int64)
 	if w.chainConfig.DAOForkSupport && w.chainConfig.DAOForkBlock != nil && w.chainConfig.DAOForkBlock.Cmp(header.Number) == 0 {
 		misc.ApplyDAOHardFork(env.state)
 	}
+	// Committed transactions may have to be undone, by replaying the others on the state
+	// the block starts from, if they exceed the bound of the block or belong to a rollup
+	// submission that does not fit in it.
+	if w.config.NewBlockBound != nil {
+		env.bound = w.config.NewBlockBound(header)
+	}
+	if env.bound != nil || hasSubmission(pending) {
+		env.base = env.state.Copy()
+	}
 	// Accumulate the uncles for the current block
 	uncles := make([]*types.Header, 0, 2)
 	commitUncles := func(blocks map[common.Hash]*types.Block) {
@@ -950,12 +1180,6 @@ func (w *worker) commitNewWork(interrupt *int32, noempty bool, timestamp int64)
 		w.commit(uncles, nil, false, tstart)
 	}
 
-	// Fill the block with all available pending transactions.
-	pending, err := w.eth.TxPool().Pending()
-	if err != nil {
-		log.Error("Failed to fetch pending transactions", "err", err)
-		return
-	}
 	// Short circuit if there is no available pending transactions
 	if len(pending) == 0 {
 		w.updateSnapshot()
@@ -984,6 +1208,40 @@ func (w *worker) commitNewWork(interrupt *int32, noempty bool, timestamp int64)
 	w.commit(uncles, w.fullTaskHook, true, tstart)
 }
 
+// oldestL1Context returns the oldest L1 context the pending transactions are tied to,
+// or nil if none is. Transactions of an account are tied to L1 contexts in nonce
+// order, so only the first one of each account not included in the state is checked.
+func oldestL1Context(statedb *state.StateDB, pending map[common.Address]types.Transactions) *types.L1Context {
+	var oldest *types.L1Context
+	for addr, txs := range pending {
+		// The pool may not have dropped the transactions of the latest block yet
+		nonce := statedb.GetNonce(addr)
+		for len(txs) > 0 && txs[0].Nonce() < nonce {
+			txs = txs[1:]
+		}
+		if len(txs) == 0 {
+			continue
+		}
+		if l1Context := txs[0].L1Context(); l1Context != nil && (oldest == nil || oldest.After(*l1Context)) {
+			oldest = l1Context
+		}
+	}
+	return oldest
+}
+
+// hasSubmission returns whether any of the pending transactions belongs to a rollup
+// submission of several transactions.
+func hasSubmission(pending map[common.Address]types.Transactions) bool {
+	for _, txs := range pending {
+		for _, tx := range txs {
+			if tx.SubmissionSize() > 1 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // commit runs any post-transaction state modifications, assembles the final block
 // and commits new work if consensus engine is running.
 func (w *worker) commit(uncles []*types.Header, interval func(), update bool, start time.Time) error {
@@ -260,8 +260,6 @@ func TestEmptyWorkClique(t *testing.T) {
 }
 
 func testEmptyWork(t *testing.T, chainConfig *params.ChainConfig, engine consensus.Engine) {
-	t.Skip("OVM breaks this with `account balance mismatch`, probably because transfers don't work.")
-
 	defer engine.Close()
 
 	w, _ := newTestWorker(t, chainConfig, engine, rawdb.NewMemoryDatabase(), 0)
@@ -359,6 +357,422 @@ func TestStreamUncleBlock(t *testing.T) {
 	}
 }
 
+func TestMaxTransactionsPerBlock(t *testing.T) {
+	engine := ethash.NewFaker()
+	defer engine.Close()
+
+	backend := newTestWorkerBackend(t, ethashChainConfig, engine, rawdb.NewMemoryDatabase(), 0)
+	backend.txPool.AddLocals(append(pendingTxs, newTxs...))
+
+	config := *testConfig
+	config.MaxTransactions = 1
+	w := newWorker(&config, ethashChainConfig, engine, backend, new(event.TypeMux), nil, false)
+	w.setEtherbase(testBankAddress)
+	defer w.close()
+
+	var taskCh = make(chan int, 10)
+	w.newTaskHook = func(task *task) {
+		if task.block.NumberU64() == 1 {
+			taskCh <- len(task.receipts)
+		}
+	}
+	w.skipSealHook = func(task *task) bool { return true }
+	w.fullTaskHook = func() {
+		time.Sleep(100 * time.Millisecond)
+	}
+	w.start()
+
+	for {
+		select {
+		case receipts := <-taskCh:
+			if receipts > 1 {
+				t.Fatalf("transaction limit exceeded: have %d, want at most 1", receipts)
+			}
+			if receipts == 1 {
+				return
+			}
+		case <-time.NewTimer(time.Second).C:
+			t.Fatalf("timeout waiting for a non-empty task")
+		}
+	}
+}
+
+// testBlockBound admits a fixed number of transactions per block.
+type testBlockBound struct {
+	t   *testing.T
+	txs int
+	max int
+}
+
+func (b *testBlockBound) Add(tx *types.Transaction, root common.Hash) bool {
+	if root == (common.Hash{}) {
+		b.t.Errorf("missing post-state root of transaction %s", tx.Hash().Hex())
+	}
+	if b.txs >= b.max {
+		return false
+	}
+	b.txs++
+	return true
+}
+
+func TestBlockBoundPerBlock(t *testing.T) {
+	engine := ethash.NewFaker()
+	defer engine.Close()
+
+	db := rawdb.NewMemoryDatabase()
+	backend := newTestWorkerBackend(t, ethashChainConfig, engine, db, 0)
+	backend.txPool.AddLocals(append(pendingTxs, newTxs...))
+
+	// The state after a rejected transaction must be restored, so that the mined block
+	// imports into a fresh chain.
+	db2 := rawdb.NewMemoryDatabase()
+	backend.genesis.MustCommit(db2)
+	chain, _ := core.NewBlockChain(db2, nil, ethashChainConfig, engine, vm.Config{}, nil)
+	defer chain.Stop()
+
+	config := *testConfig
+	config.NewBlockBound = func(header *types.Header) BlockBound {
+		return &testBlockBound{t: t, max: 1}
+	}
+	w := newWorker(&config, ethashChainConfig, engine, backend, new(event.TypeMux), nil, false)
+	w.setEtherbase(testBankAddress)
+	defer w.close()
+
+	var taskCh = make(chan *types.Block, 10)
+	w.newTaskHook = func(task *task) {
+		if task.block.NumberU64() == 1 {
+			taskCh <- task.block
+		}
+	}
+	w.skipSealHook = func(task *task) bool { return true }
+	w.fullTaskHook = func() {
+		time.Sleep(100 * time.Millisecond)
+	}
+	w.start()
+
+	for {
+		select {
+		case block := <-taskCh:
+			if txs := len(block.Transactions()); txs > 1 {
+				t.Fatalf("block limit exceeded: have %d transactions, want at most 1", txs)
+			} else if txs == 0 {
+				continue
+			}
+			if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+				t.Fatalf("failed to import mined block: %v", err)
+			}
+			return
+		case <-time.NewTimer(time.Second).C:
+			t.Fatalf("timeout waiting for a non-empty task")
+		}
+	}
+}
+
+func TestSubmissionMinedInOneBlock(t *testing.T) {
+	engine := ethash.NewFaker()
+	defer engine.Close()
+
+	db := rawdb.NewMemoryDatabase()
+	backend := newTestWorkerBackend(t, ethashChainConfig, engine, db, 0)
+
+	// A better paying transaction goes ahead of a submission of three transactions
+	bankTx, _ := types.SignTx(types.NewTransaction(0, testUserAddress, big.NewInt(1000), params.TxGas, big.NewInt(1), nil, nil, nil, types.QueueOriginSequencer, types.SighashEIP155), types.HomesteadSigner{}, testBankKey)
+	var submission []*types.Transaction
+	for nonce := uint64(0); nonce < 3; nonce++ {
+		tx, _ := types.SignTx(types.NewTransaction(nonce, testBankAddress, big.NewInt(0), params.TxGas, nil, nil, nil, nil, types.QueueOriginSequencer, types.SighashEIP155), types.HomesteadSigner{}, testUserKey)
+		submission = append(submission, tx)
+	}
+	submission[0].SetSubmissionSize(len(submission))
+	backend.txPool.AddLocals(append([]*types.Transaction{bankTx}, submission...))
+
+	// The state after an undone submission must be restored, so that the mined block
+	// imports into a fresh chain.
+	db2 := rawdb.NewMemoryDatabase()
+	backend.genesis.MustCommit(db2)
+	chain, _ := core.NewBlockChain(db2, nil, ethashChainConfig, engine, vm.Config{}, nil)
+	defer chain.Stop()
+
+	// The last transaction of the submission does not fit in the block at first
+	max := int32(3)
+	config := *testConfig
+	config.NewBlockBound = func(header *types.Header) BlockBound {
+		return &testBlockBound{t: t, max: int(atomic.LoadInt32(&max))}
+	}
+	w := newWorker(&config, ethashChainConfig, engine, backend, new(event.TypeMux), nil, false)
+	w.setEtherbase(testBankAddress)
+	defer w.close()
+
+	// The submission alone fits in a block, unlike one more transaction
+	if err := w.checkSubmission(submission); err != nil {
+		t.Fatalf("submission rejected: %v", err)
+	}
+	if err := w.checkSubmission(append(submission, bankTx)); err == nil {
+		t.Fatalf("oversized submission accepted")
+	}
+
+	var taskCh = make(chan *types.Block, 10)
+	w.newTaskHook = func(task *task) {
+		if task.block.NumberU64() == 1 {
+			select {
+			case taskCh <- task.block:
+			default:
+			}
+		}
+	}
+	w.skipSealHook = func(task *task) bool { return true }
+	w.fullTaskHook = func() {
+		time.Sleep(100 * time.Millisecond)
+	}
+	waitBlock := func(want int) *types.Block {
+		for {
+			select {
+			case block := <-taskCh:
+				switch txs := len(block.Transactions()); {
+				case txs == want:
+					return block
+				case txs > 1 && txs < 1+len(submission):
+					t.Fatalf("submission split: have %d transactions in block", txs)
+				}
+			case <-time.NewTimer(time.Second).C:
+				t.Fatalf("timeout waiting for a task with %d transactions", want)
+			}
+		}
+	}
+	w.start()
+
+	block := waitBlock(1)
+	if block.Transactions()[0].Hash() != bankTx.Hash() {
+		t.Fatalf("unexpected transaction in block: %s", block.Transactions()[0].Hash().Hex())
+	}
+	if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+		t.Fatalf("failed to import mined block: %v", err)
+	}
+
+	// Once the whole submission fits, it is mined along
+	atomic.StoreInt32(&max, 4)
+	w.start()
+	waitBlock(1 + len(submission))
+}
+
+// Tests that blocks only keep the state they start from if their transactions may have
+// to be undone, as they are bounded or rollup submissions are pending.
+func TestBaseStateOfUnboundedBlocks(t *testing.T) {
+	engine := ethash.NewFaker()
+	defer engine.Close()
+
+	w, backend := newTestWorker(t, ethashChainConfig, engine, rawdb.NewMemoryDatabase(), 0)
+	defer w.close()
+
+	// The full task hook runs on the goroutine owning the current block
+	baseCh := make(chan bool, 1)
+	w.skipSealHook = func(task *task) bool { return true }
+	w.fullTaskHook = func() {
+		select {
+		case baseCh <- w.current.base != nil:
+		default:
+		}
+	}
+	waitBase := func(want bool) {
+		for timeout := time.NewTimer(3 * time.Second); ; {
+			select {
+			case base := <-baseCh:
+				if base == want {
+					return
+				}
+			case <-timeout.C:
+				t.Fatalf("timeout waiting for a block keeping its base state: %v", want)
+			}
+		}
+	}
+	w.start()
+	waitBase(false)
+
+	var submission []*types.Transaction
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		tx, _ := types.SignTx(types.NewTransaction(nonce, testBankAddress, big.NewInt(0), params.TxGas, nil, nil, nil, nil, types.QueueOriginSequencer, types.SighashEIP155), types.HomesteadSigner{}, testUserKey)
+		submission = append(submission, tx)
+	}
+	submission[0].SetSubmissionSize(len(submission))
+	backend.txPool.AddLocals(submission)
+	waitBase(true)
+}
+
+func TestL1ContextOfMinedBlocks(t *testing.T) {
+	engine := ethash.NewFaker()
+	defer engine.Close()
+
+	chainConfig := *ethashChainConfig
+	chainConfig.OVM = &params.OVMConfig{}
+
+	// The generated blocks carry an L1 context, which must pass header verification
+	backend := newTestWorkerBackend(t, &chainConfig, engine, rawdb.NewMemoryDatabase(), 2)
+	parentContext, err := types.DecodeL1Context(backend.chain.CurrentBlock().Extra())
+	if err != nil {
+		t.Fatalf("failed to decode parent l1 context: %v", err)
+	}
+	w := newWorker(testConfig, &chainConfig, engine, backend, new(event.TypeMux), nil, false)
+	w.setEtherbase(testBankAddress)
+	defer w.close()
+
+	var taskCh = make(chan *types.Block, 10)
+	w.newTaskHook = func(task *task) {
+		if task.block.NumberU64() == 3 {
+			select {
+			case taskCh <- task.block:
+			default:
+			}
+		}
+	}
+	w.skipSealHook = func(task *task) bool { return true }
+	w.fullTaskHook = func() {
+		time.Sleep(100 * time.Millisecond)
+	}
+	waitL1Context := func(want types.L1Context) {
+		for {
+			select {
+			case block := <-taskCh:
+				have, err := types.DecodeL1Context(block.Extra())
+				if err != nil {
+					t.Fatalf("failed to decode mined l1 context: %v", err)
+				}
+				if have != want {
+					continue
+				}
+				if block.Time() != want.Timestamp {
+					t.Fatalf("block timestamp mismatch: have %d, want %d", block.Time(), want.Timestamp)
+				}
+				return
+			case <-time.NewTimer(time.Second).C:
+				t.Fatalf("timeout waiting for a task with l1 context %+v", want)
+			}
+		}
+	}
+	// An L1 context older than the one of the parent is not mined on
+	backend.chain.SetCurrentL1Context(types.L1Context{BlockNumber: parentContext.BlockNumber - 1, Timestamp: parentContext.Timestamp - 1})
+	w.start()
+	waitL1Context(parentContext)
+
+	// A newer one is
+	newer := types.L1Context{BlockNumber: parentContext.BlockNumber + 5, Timestamp: parentContext.Timestamp + 60}
+	backend.chain.SetCurrentL1Context(newer)
+	w.start()
+	waitL1Context(newer)
+}
+
+func TestL1ContextOfSubmittedTransactions(t *testing.T) {
+	engine := ethash.NewFaker()
+	defer engine.Close()
+
+	chainConfig := *ethashChainConfig
+	chainConfig.OVM = &params.OVMConfig{}
+
+	backend := newTestWorkerBackend(t, &chainConfig, engine, rawdb.NewMemoryDatabase(), 2)
+	parentContext, err := types.DecodeL1Context(backend.chain.CurrentBlock().Extra())
+	if err != nil {
+		t.Fatalf("failed to decode parent l1 context: %v", err)
+	}
+	w := newWorker(testConfig, &chainConfig, engine, backend, new(event.TypeMux), nil, false)
+	w.setEtherbase(testBankAddress)
+	defer w.close()
+
+	// The transactions of two submissions are pending, while the L1 context has moved past both
+	older := types.L1Context{BlockNumber: parentContext.BlockNumber + 1, Timestamp: parentContext.Timestamp + 10}
+	newer := types.L1Context{BlockNumber: parentContext.BlockNumber + 2, Timestamp: parentContext.Timestamp + 20}
+	backend.chain.SetCurrentL1Context(types.L1Context{BlockNumber: parentContext.BlockNumber + 3, Timestamp: parentContext.Timestamp + 30})
+
+	olderTx, _ := types.SignTx(types.NewTransaction(0, testUserAddress, big.NewInt(1000), params.TxGas, nil, nil, nil, nil, types.QueueOriginSequencer, types.SighashEIP155), types.HomesteadSigner{}, testBankKey)
+	olderTx.SetL1Context(older)
+	newerTx, _ := types.SignTx(types.NewTransaction(0, testBankAddress, big.NewInt(0), params.TxGas, nil, nil, nil, nil, types.QueueOriginSequencer, types.SighashEIP155), types.HomesteadSigner{}, testUserKey)
+	newerTx.SetL1Context(newer)
+	backend.txPool.AddLocals([]*types.Transaction{olderTx, newerTx})
+
+	var taskCh = make(chan *types.Block, 10)
+	w.newTaskHook = func(task *task) {
+		if task.block.NumberU64() == 3 && len(task.block.Transactions()) > 0 {
+			select {
+			case taskCh <- task.block:
+			default:
+			}
+		}
+	}
+	w.skipSealHook = func(task *task) bool { return true }
+	w.fullTaskHook = func() {
+		time.Sleep(100 * time.Millisecond)
+	}
+	w.start()
+
+	// The block is mined on the L1 context of the older submission and ends before the newer one
+	select {
+	case block := <-taskCh:
+		if len(block.Transactions()) != 1 || block.Transactions()[0].Hash() != olderTx.Hash() {
+			t.Fatalf("unexpected transactions in block: %d", len(block.Transactions()))
+		}
+		if have, err := types.DecodeL1Context(block.Extra()); err != nil || have != older {
+			t.Fatalf("l1 context mismatch: have %+v, want %+v", have, older)
+		}
+	case <-time.NewTimer(time.Second).C:
+		t.Fatalf("timeout waiting for a task")
+	}
+
+	// Transactions the pool still holds after their block was mined are not considered
+	statedb, _ := backend.chain.State()
+	statedb.SetNonce(testBankAddress, 1)
+	pending := map[common.Address]types.Transactions{testBankAddress: {olderTx}, testUserAddress: {newerTx}}
+	if oldest := oldestL1Context(statedb, pending); oldest == nil || *oldest != newer {
+		t.Fatalf("oldest l1 context mismatch: have %v, want %+v", oldest, newer)
+	}
+}
+
+// Tests that a transaction of a later L1 block than the parent, yet with an earlier
+// timestamp, is mined rather than skipped in every block.
+func TestL1ContextBehindParentTimestamp(t *testing.T) {
+	engine := ethash.NewFaker()
+	defer engine.Close()
+
+	chainConfig := *ethashChainConfig
+	chainConfig.OVM = &params.OVMConfig{}
+
+	backend := newTestWorkerBackend(t, &chainConfig, engine, rawdb.NewMemoryDatabase(), 2)
+	parentContext, err := types.DecodeL1Context(backend.chain.CurrentBlock().Extra())
+	if err != nil {
+		t.Fatalf("failed to decode parent l1 context: %v", err)
+	}
+	w := newWorker(testConfig, &chainConfig, engine, backend, new(event.TypeMux), nil, false)
+	w.setEtherbase(testBankAddress)
+	defer w.close()
+
+	l1Context := types.L1Context{BlockNumber: parentContext.BlockNumber + 1, Timestamp: parentContext.Timestamp - 1}
+	tx, _ := types.SignTx(types.NewTransaction(0, testUserAddress, big.NewInt(1000), params.TxGas, nil, nil, nil, nil, types.QueueOriginSequencer, types.SighashEIP155), types.HomesteadSigner{}, testBankKey)
+	tx.SetL1Context(l1Context)
+	backend.txPool.AddLocals([]*types.Transaction{tx})
+
+	var taskCh = make(chan *types.Block, 10)
+	w.newTaskHook = func(task *task) {
+		if task.block.NumberU64() == 3 && len(task.block.Transactions()) > 0 {
+			select {
+			case taskCh <- task.block:
+			default:
+			}
+		}
+	}
+	w.skipSealHook = func(task *task) bool { return true }
+	w.fullTaskHook = func() {
+		time.Sleep(100 * time.Millisecond)
+	}
+	w.start()
+
+	// The block keeps the L1 block of the transaction, without going back in time
+	select {
+	case block := <-taskCh:
+		want := types.L1Context{BlockNumber: l1Context.BlockNumber, Timestamp: parentContext.Timestamp}
+		if have, err := types.DecodeL1Context(block.Extra()); err != nil || have != want {
+			t.Fatalf("l1 context mismatch: have %+v, want %+v", have, want)
+		}
+	case <-time.NewTimer(time.Second).C:
+		t.Fatalf("timeout waiting for the transaction to be mined")
+	}
+}
+
 func TestRegenerateMiningBlockEthash(t *testing.T) {
 	testRegenerateMiningBlock(t, ethashChainConfig, ethash.NewFaker())
 }
@@ -368,8 +782,6 @@ func TestRegenerateMiningBlockClique(t *testing.T) {
 }
 
 func testRegenerateMiningBlock(t *testing.T, chainConfig *params.ChainConfig, engine consensus.Engine) {
-	t.Skip("OVM breaks this with `account balance mismatch`, probably because transfers don't work.")
-
 	defer engine.Close()
 
 	w, b := newTestWorker(t, chainConfig, engine, rawdb.NewMemoryDatabase(), 0)
@@ -383,7 +795,7 @@ func testRegenerateMiningBlock(t *testing.T, chainConfig *params.ChainConfig, en
 			// The first task is an empty task, the second
 			// one has 1 pending tx, the third one has 2 txs
 			if taskIndex == 2 {
-				receiptLen, balance := 1, big.NewInt(1000)
+				receiptLen, balance := 2, big.NewInt(2000)
 				if len(task.receipts) != receiptLen {
 					t.Errorf("receipt number mismatch: have %d, want %d", len(task.receipts), receiptLen)
 				}
@@ -225,16 +225,16 @@ var (
 	//
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
-	AllEthashProtocolChanges = &ChainConfig{big.NewInt(108), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, new(EthashConfig), nil, nil}
+	AllEthashProtocolChanges = &ChainConfig{big.NewInt(108), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, new(EthashConfig), nil, nil, nil}
 
 	// AllCliqueProtocolChanges contains every protocol change (EIPs) introduced
 	// and accepted by the Ethereum core developers into the Clique consensus.
 	//
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
-	AllCliqueProtocolChanges = &ChainConfig{big.NewInt(420), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, &CliqueConfig{Period: 0, Epoch: 30000}, nil}
+	AllCliqueProtocolChanges = &ChainConfig{big.NewInt(420), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, &CliqueConfig{Period: 0, Epoch: 30000}, nil, nil}
 
-	TestChainConfig = &ChainConfig{big.NewInt(1), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, new(EthashConfig), nil, nil}
+	TestChainConfig = &ChainConfig{big.NewInt(1), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, new(EthashConfig), nil, nil, nil}
 	TestRules       = TestChainConfig.Rules(new(big.Int))
 )
 
@@ -312,6 +312,10 @@ type ChainConfig struct {
 	Clique *CliqueConfig `json:"clique,omitempty"`
 
 	BlockBatchesSender *ecdsa.PublicKey `json:"blockBatchSender,omitempty"`
+
+	// OVM executes transactions through the Execution Manager, chains without
+	// it run on the plain EVM.
+	OVM *OVMConfig `json:"ovm,omitempty"`
 }
 
 // EthashConfig is the consensus engine configs for proof-of-work based sealing.
@@ -344,7 +348,7 @@ func (c *ChainConfig) String() string {
 	default:
 		engine = "unknown"
 	}
-	return fmt.Sprintf("{ChainID: %v Homestead: %v DAO: %v DAOSupport: %v EIP150: %v EIP155: %v EIP158: %v Byzantium: %v Constantinople: %v Petersburg: %v Istanbul: %v, Muir Glacier: %v, Engine: %v}",
+	return fmt.Sprintf("{ChainID: %v Homestead: %v DAO: %v DAOSupport: %v EIP150: %v EIP155: %v EIP158: %v Byzantium: %v Constantinople: %v Petersburg: %v Istanbul: %v, Muir Glacier: %v, Engine: %v, OVM: %v}",
 		c.ChainID,
 		c.HomesteadBlock,
 		c.DAOForkBlock,
@@ -358,9 +362,24 @@ func (c *ChainConfig) String() string {
 		c.IstanbulBlock,
 		c.MuirGlacierBlock,
 		engine,
+		c.OVM,
 	)
 }
 
+// IsOVM returns whether transactions are executed through the Execution Manager.
+func (c *ChainConfig) IsOVM() bool {
+	return c.OVM != nil
+}
+
+// ovmContracts returns the OVM system contracts of the chain, nil if it runs on
+// the plain EVM.
+func ovmContracts(c *ChainConfig) *OVMContracts {
+	if c.OVM == nil {
+		return nil
+	}
+	return c.OVM.SystemContracts()
+}
+
 // IsHomestead returns whether num is either equal to the homestead block or greater.
 func (c *ChainConfig) IsHomestead(num *big.Int) bool {
 	return isForked(c.HomesteadBlock, num)
@@ -437,7 +456,8 @@ func (c *ChainConfig) CheckCompatible(newcfg *ChainConfig, height uint64) *Confi
 }
 
 // CheckConfigForkOrder checks that we don't "skip" any forks, geth isn't pluggable enough
-// to guarantee that forks can be implemented in a different order than on official networks
+// to guarantee that forks can be implemented in a different order than on official networks.
+// It also checks that the OVM system contracts are known.
 func (c *ChainConfig) CheckConfigForkOrder() error {
 	type fork struct {
 		name  string
@@ -470,6 +490,9 @@ func (c *ChainConfig) CheckConfigForkOrder() error {
 		}
 		lastFork = cur
 	}
+	if c.OVM != nil {
+		return c.OVM.CheckConfig()
+	}
 	return nil
 }
 
@@ -513,6 +536,40 @@ func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int) *Confi
 	if isForkIncompatible(c.EWASMBlock, newcfg.EWASMBlock, head) {
 		return newCompatError("ewasm fork block", c.EWASMBlock, newcfg.EWASMBlock)
 	}
+	// The OVM system contracts are in place since genesis, changing them alters
+	// the whole chain. Upgrades only alter the chain from their activation block.
+	if head.Sign() > 0 && !ovmContracts(c).equal(ovmContracts(newcfg)) {
+		return newCompatError("OVM system contracts", common.Big0, common.Big0)
+	}
+	return checkOVMUpgradesCompatible(ovmUpgrades(c), ovmUpgrades(newcfg), head)
+}
+
+// ovmUpgrades returns the OVM upgrades of the chain, nil if it runs on the plain EVM.
+func ovmUpgrades(c *ChainConfig) []*OVMUpgrade {
+	if c.OVM == nil {
+		return nil
+	}
+	return c.OVM.Upgrades
+}
+
+// checkOVMUpgradesCompatible checks that the OVM upgrades activated by head are kept,
+// with the same activation blocks and system contracts.
+func checkOVMUpgradesCompatible(stored, new []*OVMUpgrade, head *big.Int) *ConfigCompatError {
+	for i := 0; i < len(stored) || i < len(new); i++ {
+		var (
+			storedBlock, newBlock         *big.Int
+			storedContracts, newContracts *OVMContracts
+		)
+		if i < len(stored) {
+			storedBlock, storedContracts = stored[i].Block, stored[i].SystemContracts()
+		}
+		if i < len(new) {
+			newBlock, newContracts = new[i].Block, new[i].SystemContracts()
+		}
+		if isForkIncompatible(storedBlock, newBlock, head) || (isForked(storedBlock, head) && !storedContracts.equal(newContracts)) {
+			return newCompatError(fmt.Sprintf("OVM upgrade %d", i), storedBlock, newBlock)
+		}
+	}
 	return nil
 }
 
@@ -580,6 +637,7 @@ type Rules struct {
 	ChainID                                                 *big.Int
 	IsHomestead, IsEIP150, IsEIP155, IsEIP158               bool
 	IsByzantium, IsConstantinople, IsPetersburg, IsIstanbul bool
+	IsOVM                                                   bool
 }
 
 // Rules ensures c's ChainID is not nil.
@@ -598,5 +656,6 @@ func (c *ChainConfig) Rules(num *big.Int) Rules {
 		IsConstantinople: c.IsConstantinople(num),
 		IsPetersburg:     c.IsPetersburg(num),
 		IsIstanbul:       c.IsIstanbul(num),
+		IsOVM:            c.IsOVM(),
 	}
 }
@@ -20,8 +20,17 @@ import (
 	"math/big"
 	"reflect"
 	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
+var customOVMContracts = &OVMContracts{
+	ExecutionManager:    common.HexToAddress("0x00000000000000000000000000000000beef0000"),
+	StateManager:        common.HexToAddress("0x00000000000000000000000000000000beef0001"),
+	SystemAddressPrefix: common.FromHex("0x00000000000000000000000000000000beef"),
+	ExecutionManagerAbi: "[]",
+}
+
 func TestCheckCompatible(t *testing.T) {
 	type test struct {
 		stored, new *ChainConfig
@@ -70,6 +79,68 @@ func TestCheckCompatible(t *testing.T) {
 				RewindTo:     9,
 			},
 		},
+		{
+			stored: &ChainConfig{OVM: &OVMConfig{}},
+			new:    &ChainConfig{OVM: &OVMConfig{StateDump: "dump.json"}},
+			head:   10,
+		},
+		{
+			stored: &ChainConfig{OVM: &OVMConfig{}},
+			new:    &ChainConfig{OVM: &OVMConfig{Contracts: OVMContractsV0}},
+			head:   10,
+		},
+		{
+			stored: &ChainConfig{OVM: &OVMConfig{}},
+			new:    &ChainConfig{OVM: &OVMConfig{Contracts: customOVMContracts}},
+			head:   0,
+		},
+		{
+			stored: &ChainConfig{OVM: &OVMConfig{}},
+			new:    &ChainConfig{OVM: &OVMConfig{Contracts: customOVMContracts}},
+			head:   10,
+			wantErr: &ConfigCompatError{
+				What:         "OVM system contracts",
+				StoredConfig: big.NewInt(0),
+				NewConfig:    big.NewInt(0),
+			},
+		},
+		{
+			stored: &ChainConfig{},
+			new:    &ChainConfig{OVM: &OVMConfig{}},
+			head:   10,
+			wantErr: &ConfigCompatError{
+				What:         "OVM system contracts",
+				StoredConfig: big.NewInt(0),
+				NewConfig:    big.NewInt(0),
+			},
+		},
+		{
+			stored: &ChainConfig{OVM: &OVMConfig{}},
+			new:    &ChainConfig{OVM: &OVMConfig{Upgrades: []*OVMUpgrade{{Block: big.NewInt(20), Contracts: customOVMContracts}}}},
+			head:   10,
+		},
+		{
+			stored: &ChainConfig{OVM: &OVMConfig{Upgrades: []*OVMUpgrade{{Block: big.NewInt(20), Contracts: customOVMContracts}}}},
+			new:    &ChainConfig{OVM: &OVMConfig{Upgrades: []*OVMUpgrade{{Block: big.NewInt(30), Contracts: customOVMContracts}}}},
+			head:   25,
+			wantErr: &ConfigCompatError{
+				What:         "OVM upgrade 0",
+				StoredConfig: big.NewInt(20),
+				NewConfig:    big.NewInt(30),
+				RewindTo:     19,
+			},
+		},
+		{
+			stored: &ChainConfig{OVM: &OVMConfig{Upgrades: []*OVMUpgrade{{Block: big.NewInt(20), Contracts: customOVMContracts}}}},
+			new:    &ChainConfig{OVM: &OVMConfig{Upgrades: []*OVMUpgrade{{Block: big.NewInt(20)}}}},
+			head:   25,
+			wantErr: &ConfigCompatError{
+				What:         "OVM upgrade 0",
+				StoredConfig: big.NewInt(20),
+				NewConfig:    big.NewInt(20),
+				RewindTo:     19,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -79,3 +150,45 @@ func TestCheckCompatible(t *testing.T) {
 		}
 	}
 }
+
+func TestOVMSystemContracts(t *testing.T) {
+	tests := []struct {
+		config *OVMConfig
+		want   *OVMContracts
+		err    bool
+	}{
+		{config: &OVMConfig{}, want: OVMContractsV0},
+		{config: &OVMConfig{Version: 1}, err: true},
+		{config: &OVMConfig{Version: 1, Contracts: customOVMContracts}, want: customOVMContracts},
+		{config: &OVMConfig{Contracts: &OVMContracts{ExecutionManagerAbi: "[]"}}, want: &OVMContracts{ExecutionManagerAbi: "[]"}, err: true},
+		{config: &OVMConfig{Upgrades: []*OVMUpgrade{{Block: big.NewInt(10), Contracts: customOVMContracts}}}, want: OVMContractsV0},
+		{config: &OVMConfig{Upgrades: []*OVMUpgrade{{Block: big.NewInt(10), Version: 1}}}, want: OVMContractsV0, err: true},
+		{config: &OVMConfig{Upgrades: []*OVMUpgrade{{Contracts: customOVMContracts}}}, want: OVMContractsV0, err: true},
+		{config: &OVMConfig{Upgrades: []*OVMUpgrade{{Block: big.NewInt(10)}, {Block: big.NewInt(10)}}}, want: OVMContractsV0, err: true},
+	}
+	for i, tt := range tests {
+		if have := tt.config.SystemContracts(); !reflect.DeepEqual(have, tt.want) {
+			t.Errorf("test %d: system contracts mismatch: have %+v, want %+v", i, have, tt.want)
+		}
+		if err := (&ChainConfig{OVM: tt.config}).CheckConfigForkOrder(); (err != nil) != tt.err {
+			t.Errorf("test %d: config check error mismatch: have %v, want error %v", i, err, tt.err)
+		}
+	}
+	upgraded := &OVMConfig{Upgrades: []*OVMUpgrade{{Block: big.NewInt(10), Contracts: customOVMContracts}, {Block: big.NewInt(20)}}}
+	for number, want := range map[int64]*OVMContracts{0: OVMContractsV0, 9: OVMContractsV0, 10: customOVMContracts, 19: customOVMContracts, 20: OVMContractsV0} {
+		if have := upgraded.SystemContractsAt(big.NewInt(number)); have != want {
+			t.Errorf("block %d: system contracts mismatch: have %+v, want %+v", number, have, want)
+		}
+	}
+	for addr, want := range map[common.Address]bool{
+		OVMContractsV0.ExecutionManager:                                   true,
+		OVMContractsV0.StateManager:                                       true,
+		common.HexToAddress("0x00000000000000000000000000000000dead9999"): true,
+		common.HexToAddress("0x00000000000000000000000000000000beef0000"): false,
+		common.HexToAddress("0x9999999999999999999999999999999999999999"): false,
+	} {
+		if have := OVMContractsV0.IsSystemAddress(addr); have != want {
+			t.Errorf("address %x: system address mismatch: have %v, want %v", addr, have, want)
+		}
+	}
+}
@@ -58,4 +58,10 @@ const (
 	// hard limit against deep ancestors, by the blockchain against deep reorgs, by
 	// the freezer as the cutoff treshold and by clique as the snapshot trust limit.
 	ImmutabilityThreshold = 90000
+
+	// Eth64OVMProtocolVersion is eth/64 extended with the TransactionMeta of the
+	// transactions in TxMsg, NewBlockMsg and BlockBodiesMsg, which isn't part of their
+	// RLP encoding. It is numbered apart from the upstream versions, so that it is
+	// preferred over them without colliding with later ones.
+	Eth64OVMProtocolVersion = 1<<16 | 64
 )
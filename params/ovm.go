@@ -0,0 +1,664 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package params
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// OVMConfig is the configuration of the Optimistic Virtual Machine.
+type OVMConfig struct {
+	// Version selects the system contracts among the known OVM versions, unless
+	// they are given explicitly.
+	Version uint64 `json:"version,omitempty"`
+
+	// Contracts overrides the system contracts of the version, allowing chains
+	// to run with system contracts unknown to this release.
+	Contracts *OVMContracts `json:"contracts,omitempty"`
+
+	// StateDump is the path of a state dump holding the OVM contracts, which are
+	// deployed in the genesis state in addition to the genesis allocation. The dump
+	// is embedded in the genesis allocation as the genesis is set up, so the chain
+	// configuration stored along with the genesis block never references it.
+	StateDump string `json:"stateDump,omitempty"`
+
+	// Upgrades replace the system contracts from their activation blocks on, in
+	// ascending order of activation block.
+	Upgrades []*OVMUpgrade `json:"upgrades,omitempty"`
+}
+
+// OVMUpgrade replaces the system contracts of the OVM from its activation block on.
+// The system contracts are selected the same way as those of an OVMConfig.
+type OVMUpgrade struct {
+	Block     *big.Int      `json:"block"` // Activation block of the upgrade
+	Version   uint64        `json:"version,omitempty"`
+	Contracts *OVMContracts `json:"contracts,omitempty"`
+}
+
+// OVMContracts describes the system contracts transactions are executed through.
+type OVMContracts struct {
+	ExecutionManager    common.Address `json:"executionManager"`    // Address of the Execution Manager, which all transactions are sent to
+	StateManager        common.Address `json:"stateManager"`        // Address of the State Manager, which is implemented natively
+	ActiveContractSlot  common.Hash    `json:"activeContractSlot"`  // Execution Manager storage slot holding the address of the contract being created
+	SystemAddressPrefix hexutil.Bytes  `json:"systemAddressPrefix"` // Prefix of the addresses reserved for the system contracts
+	ExecutionManagerAbi string         `json:"executionManagerAbi"` // JSON ABI of the Execution Manager
+}
+
+var (
+	// OVMContractsV0 are the system contracts of the initial OVM release.
+	OVMContractsV0 = &OVMContracts{
+		ExecutionManager:    common.HexToAddress("0x00000000000000000000000000000000dead0000"),
+		StateManager:        common.HexToAddress("0x00000000000000000000000000000000dead0001"),
+		ActiveContractSlot:  common.BigToHash(big.NewInt(6)),
+		SystemAddressPrefix: common.FromHex("0x00000000000000000000000000000000dead"),
+		ExecutionManagerAbi: executionManagerAbiV0,
+	}
+
+	// ovmVersions holds the system contracts of each OVM version, indexed by version.
+	ovmVersions = []*OVMContracts{OVMContractsV0}
+)
+
+// systemContracts returns the provided contracts, or those of the version if nil.
+// Nil is returned if the version is unknown and no contracts are given.
+func systemContracts(version uint64, contracts *OVMContracts) *OVMContracts {
+	if contracts != nil {
+		return contracts
+	}
+	if version < uint64(len(ovmVersions)) {
+		return ovmVersions[version]
+	}
+	return nil
+}
+
+// SystemContracts returns the system contracts the OVM starts with at genesis, or
+// nil if the version is unknown and no contracts are given.
+func (c *OVMConfig) SystemContracts() *OVMContracts {
+	return systemContracts(c.Version, c.Contracts)
+}
+
+// SystemContractsAt returns the system contracts transactions of the block with the
+// provided number are executed through, those of the last upgrade activated by then.
+func (c *OVMConfig) SystemContractsAt(num *big.Int) *OVMContracts {
+	for i := len(c.Upgrades) - 1; i >= 0; i-- {
+		if isForked(c.Upgrades[i].Block, num) {
+			return c.Upgrades[i].SystemContracts()
+		}
+	}
+	return c.SystemContracts()
+}
+
+// SystemContracts returns the system contracts of the upgrade, or nil if the version
+// is unknown and no contracts are given.
+func (u *OVMUpgrade) SystemContracts() *OVMContracts {
+	return systemContracts(u.Version, u.Contracts)
+}
+
+// String implements the stringer interface, returning the OVM details.
+func (c *OVMConfig) String() string {
+	upgrades := make([]string, len(c.Upgrades))
+	for i, upgrade := range c.Upgrades {
+		upgrades[i] = fmt.Sprintf("%v@%v", upgrade.Version, upgrade.Block)
+	}
+	if c.Contracts != nil {
+		return fmt.Sprintf("ovm(version: %d, executionManager: %s, stateManager: %s, upgrades: %v)", c.Version, c.Contracts.ExecutionManager.Hex(), c.Contracts.StateManager.Hex(), upgrades)
+	}
+	return fmt.Sprintf("ovm(version: %d, upgrades: %v)", c.Version, upgrades)
+}
+
+// CheckConfig checks that the system contracts of the OVM and its upgrades are known
+// and complete, and that the upgrades are ordered by activation block.
+func (c *OVMConfig) CheckConfig() error {
+	if err := checkSystemContracts(c.Version, c.SystemContracts()); err != nil {
+		return err
+	}
+	last := common.Big0
+	for i, upgrade := range c.Upgrades {
+		if upgrade.Block == nil || upgrade.Block.Cmp(last) <= 0 {
+			return fmt.Errorf("OVM upgrade %d activated at block %v, not after block %v", i, upgrade.Block, last)
+		}
+		if err := checkSystemContracts(upgrade.Version, upgrade.SystemContracts()); err != nil {
+			return fmt.Errorf("OVM upgrade %d: %v", i, err)
+		}
+		last = upgrade.Block
+	}
+	return nil
+}
+
+// checkSystemContracts checks that the system contracts of an OVM version are known
+// and complete.
+func checkSystemContracts(version uint64, contracts *OVMContracts) error {
+	switch {
+	case contracts == nil:
+		return fmt.Errorf("unsupported OVM version %d", version)
+	case contracts.ExecutionManager == (common.Address{}):
+		return errors.New("missing OVM Execution Manager address")
+	case contracts.StateManager == (common.Address{}):
+		return errors.New("missing OVM State Manager address")
+	case contracts.ExecutionManagerAbi == "":
+		return errors.New("missing OVM Execution Manager ABI")
+	}
+	return nil
+}
+
+// IsSystemAddress returns whether addr belongs to the system contracts, which
+// OVM contracts are not allowed to access.
+func (c *OVMContracts) IsSystemAddress(addr common.Address) bool {
+	return addr == c.ExecutionManager || addr == c.StateManager || bytes.HasPrefix(addr.Bytes(), c.SystemAddressPrefix)
+}
+
+// equal returns whether the system contracts are the same.
+func (c *OVMContracts) equal(other *OVMContracts) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	return c.ExecutionManager == other.ExecutionManager && c.StateManager == other.StateManager &&
+		c.ActiveContractSlot == other.ActiveContractSlot && bytes.Equal(c.SystemAddressPrefix, other.SystemAddressPrefix) &&
+		c.ExecutionManagerAbi == other.ExecutionManagerAbi
+}
+
+// executionManagerAbiV0 is the ABI of the Execution Manager of OVM version 0.
+const executionManagerAbiV0 = `[
+  {
+    "inputs": [
+      {
+        "internalType": "uint256",
+        "name": "_opcodeWhitelistMask",
+        "type": "uint256"
+      },
+      {
+        "internalType": "address",
+        "name": "_owner",
+        "type": "address"
+      },
+      {
+        "internalType": "uint256",
+        "name": "_blockGasLimit",
+        "type": "uint256"
+      },
+      {
+        "internalType": "bool",
+        "name": "_overridePurityChecker",
+        "type": "bool"
+      }
+    ],
+    "payable": false,
+    "stateMutability": "nonpayable",
+    "type": "constructor"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "_activeContract",
+        "type": "address"
+      }
+    ],
+    "name": "ActiveContract",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "_ovmFromAddress",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "_ovmToAddress",
+        "type": "address"
+      }
+    ],
+    "name": "CallingWithEOA",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "_ovmContractAddress",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "_codeContractAddress",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes32",
+        "name": "_codeContractHash",
+        "type": "bytes32"
+      }
+    ],
+    "name": "CreatedContract",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "bytes",
+        "name": "_revertMessage",
+        "type": "bytes"
+      }
+    ],
+    "name": "EOACallRevert",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "_ovmContractAddress",
+        "type": "address"
+      }
+    ],
+    "name": "EOACreatedContract",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": false,
+        "internalType": "address",
+        "name": "_ovmContractAddress",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes32",
+        "name": "_slot",
+        "type": "bytes32"
+      },
+      {
+        "indexed": false,
+        "internalType": "bytes32",
+        "name": "_value",
+        "type": "bytes32"
+      }
+    ],
+    "name": "SetStorage",
+    "type": "event"
+  },
+  {
+    "constant": false,
+    "inputs": [
+      {
+        "internalType": "uint256",
+        "name": "_timestamp",
+        "type": "uint256"
+      },
+      {
+        "internalType": "uint256",
+        "name": "_queueOrigin",
+        "type": "uint256"
+      },
+      {
+        "internalType": "uint256",
+        "name": "_nonce",
+        "type": "uint256"
+      },
+      {
+        "internalType": "address",
+        "name": "_ovmEntrypoint",
+        "type": "address"
+      },
+      {
+        "internalType": "bytes",
+        "name": "_callBytes",
+        "type": "bytes"
+      },
+      {
+        "internalType": "uint8",
+        "name": "_v",
+        "type": "uint8"
+      },
+      {
+        "internalType": "bytes32",
+        "name": "_r",
+        "type": "bytes32"
+      },
+      {
+        "internalType": "bytes32",
+        "name": "_s",
+        "type": "bytes32"
+      }
+    ],
+    "name": "executeEOACall",
+    "outputs": [],
+    "payable": false,
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "constant": false,
+    "inputs": [
+      {
+        "internalType": "uint256",
+        "name": "_timestamp",
+        "type": "uint256"
+      },
+      {
+        "internalType": "uint256",
+        "name": "_queueOrigin",
+        "type": "uint256"
+      },
+      {
+        "internalType": "address",
+        "name": "_ovmEntrypoint",
+        "type": "address"
+      },
+      {
+        "internalType": "bytes",
+        "name": "_callBytes",
+        "type": "bytes"
+      },
+      {
+        "internalType": "address",
+        "name": "_fromAddress",
+        "type": "address"
+      },
+      {
+        "internalType": "address",
+        "name": "_l1MsgSenderAddress",
+        "type": "address"
+      },
+      {
+        "internalType": "bool",
+        "name": "_allowRevert",
+        "type": "bool"
+      }
+    ],
+    "name": "executeTransaction",
+    "outputs": [],
+    "payable": false,
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "constant": false,
+    "inputs": [],
+    "name": "getL1MessageSender",
+    "outputs": [
+      {
+        "internalType": "address",
+        "name": "",
+        "type": "address"
+      }
+    ],
+    "payable": false,
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "constant": true,
+    "inputs": [],
+    "name": "getStateManagerAddress",
+    "outputs": [
+      {
+        "internalType": "address",
+        "name": "",
+        "type": "address"
+      }
+    ],
+    "payable": false,
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "constant": false,
+    "inputs": [
+      {
+        "internalType": "address",
+        "name": "addr",
+        "type": "address"
+      }
+    ],
+    "name": "incrementNonce",
+    "outputs": [],
+    "payable": false,
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "constant": true,
+    "inputs": [],
+    "name": "isStaticContext",
+    "outputs": [],
+    "payable": false,
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "constant": true,
+    "inputs": [],
+    "name": "ovmADDRESS",
+    "outputs": [],
+    "payable": false,
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "constant": true,
+    "inputs": [],
+    "name": "ovmBlockGasLimit",
+    "outputs": [],
+    "payable": false,
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "constant": false,
+    "inputs": [],
+    "name": "ovmCALL",
+    "outputs": [],
+    "payable": false,
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "constant": true,
+    "inputs": [],
+    "name": "ovmCALLER",
+    "outputs": [],
+    "payable": false,
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "constant": false,
+    "inputs": [],
+    "name": "ovmCREATE",
+    "outputs": [],
+    "payable": false,
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "constant": false,
+    "inputs": [],
+    "name": "ovmCREATE2",
+    "outputs": [],
+    "payable": false,
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "constant": false,
+    "inputs": [],
+    "name": "ovmDELEGATECALL",
+    "outputs": [],
+    "payable": false,
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "constant": true,
+    "inputs": [],
+    "name": "ovmEXTCODECOPY",
+    "outputs": [],
+    "payable": false,
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "constant": true,
+    "inputs": [],
+    "name": "ovmEXTCODEHASH",
+    "outputs": [],
+    "payable": false,
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "constant": true,
+    "inputs": [],
+    "name": "ovmEXTCODESIZE",
+    "outputs": [],
+    "payable": false,
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "constant": true,
+    "inputs": [],
+    "name": "ovmGASLIMIT",
+    "outputs": [],
+    "payable": false,
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "constant": true,
+    "inputs": [],
+    "name": "ovmORIGIN",
+    "outputs": [],
+    "payable": false,
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "constant": true,
+    "inputs": [],
+    "name": "ovmQueueOrigin",
+    "outputs": [],
+    "payable": false,
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "constant": true,
+    "inputs": [],
+    "name": "ovmSLOAD",
+    "outputs": [],
+    "payable": false,
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "constant": false,
+    "inputs": [],
+    "name": "ovmSSTORE",
+    "outputs": [],
+    "payable": false,
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "constant": false,
+    "inputs": [],
+    "name": "ovmSTATICCALL",
+    "outputs": [],
+    "payable": false,
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "constant": true,
+    "inputs": [],
+    "name": "ovmTIMESTAMP",
+    "outputs": [],
+    "payable": false,
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "constant": true,
+    "inputs": [
+      {
+        "internalType": "uint256",
+        "name": "_nonce",
+        "type": "uint256"
+      },
+      {
+        "internalType": "address",
+        "name": "_to",
+        "type": "address"
+      },
+      {
+        "internalType": "bytes",
+        "name": "_callData",
+        "type": "bytes"
+      },
+      {
+        "internalType": "uint8",
+        "name": "_v",
+        "type": "uint8"
+      },
+      {
+        "internalType": "bytes32",
+        "name": "_r",
+        "type": "bytes32"
+      },
+      {
+        "internalType": "bytes32",
+        "name": "_s",
+        "type": "bytes32"
+      }
+    ],
+    "name": "recoverEOAAddress",
+    "outputs": [
+      {
+        "internalType": "address",
+        "name": "",
+        "type": "address"
+      }
+    ],
+    "payable": false,
+    "stateMutability": "view",
+    "type": "function"
+  }
+]`
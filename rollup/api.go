@@ -0,0 +1,111 @@
+package rollup
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+var ErrBatchBuilderDisabled = errors.New("transition batch builder disabled")
+
+// PublicRollupAPI provides an API to inspect the rollup components of the node.
+type PublicRollupAPI struct {
+	db           ethdb.Database
+	chain        *core.BlockChain
+	batchBuilder RollupTransitionBatchBuilder
+	codec        BatchCodec
+	verifier     *Verifier
+	txIngestion  *TxIngestion
+}
+
+// NewPublicRollupAPI creates a new rollup API. The codec is the BatchCodec the
+// batch builder encodes with, or nil if it is disabled. The verifier is nil
+// unless the node runs in verifier mode.
+func NewPublicRollupAPI(db ethdb.Database, chain *core.BlockChain, batchBuilder RollupTransitionBatchBuilder, codec BatchCodec, verifier *Verifier, txIngestion *TxIngestion) *PublicRollupAPI {
+	return &PublicRollupAPI{db: db, chain: chain, batchBuilder: batchBuilder, codec: codec, verifier: verifier, txIngestion: txIngestion}
+}
+
+// Status returns the health and progress of the transition batch builder. A
+// halted builder requires manual intervention before it can make progress.
+func (api *PublicRollupAPI) Status() *BuilderStatus {
+	return api.batchBuilder.Status()
+}
+
+// VerifierStatus returns the progress of the verifier. A halted verifier found a
+// transition whose committed post-state root it could not reproduce.
+func (api *PublicRollupAPI) VerifierStatus() (*VerifierStatus, error) {
+	if api.verifier == nil {
+		return nil, ErrVerifierDisabled
+	}
+	return api.verifier.Status(), nil
+}
+
+// IngestionStatus returns the progress of L1-to-L2 transaction ingestion. Halted
+// ingestion failed to apply a transaction and makes no progress until resumed.
+func (api *PublicRollupAPI) IngestionStatus() (*TxIngestionStatus, error) {
+	if api.txIngestion == nil {
+		return nil, ErrTxIngestionDisabled
+	}
+	return api.txIngestion.Status()
+}
+
+// ResumeIngestion clears the failure halted L1-to-L2 transaction ingestion is stuck at
+// and retries the failed transaction. The cause of the failure should be addressed
+// first, or ingestion halts again.
+func (api *PublicRollupAPI) ResumeIngestion() error {
+	if api.txIngestion == nil {
+		return ErrTxIngestionDisabled
+	}
+	return api.txIngestion.Resume()
+}
+
+// GetTransitionWitness returns the witness needed to contest the post-state root of the
+// transition with the provided index, counting transitions across all batches.
+func (api *PublicRollupAPI) GetTransitionWitness(index hexutil.Uint64) (*TransitionWitness, error) {
+	return NewTransitionWitness(api.db, api.chain, uint64(index))
+}
+
+// GetRawTransitionWitness returns the witness of the transition with the provided index
+// in the encoding consumed by on-chain verification.
+func (api *PublicRollupAPI) GetRawTransitionWitness(index hexutil.Uint64) (hexutil.Bytes, error) {
+	witness, err := NewTransitionWitness(api.db, api.chain, uint64(index))
+	if err != nil {
+		return nil, err
+	}
+	return EncodeTransitionWitness(witness)
+}
+
+// EstimateBatchCost estimates the L1 gas used to submit a transition batch holding the
+// provided RLP-encoded signed transactions, using the encoding of the batch builder.
+// Post-state roots are not known before execution and are estimated as incompressible.
+// If an L1 gas price is provided, the estimate includes the cost in wei.
+func (api *PublicRollupAPI) EstimateBatchCost(txs []hexutil.Bytes, gasPrice *hexutil.Big) (*BatchGasEstimate, error) {
+	if api.codec == nil {
+		return nil, ErrBatchBuilderDisabled
+	}
+	batch := NewTransitionBatch(len(txs))
+	for i, encoded := range txs {
+		tx := new(types.Transaction)
+		if err := rlp.DecodeBytes(encoded, tx); err != nil {
+			return nil, fmt.Errorf("invalid transaction %d: %v", i, err)
+		}
+		root := crypto.Keccak256Hash(new(big.Int).SetInt64(int64(i)).Bytes())
+		batch.transitions = append(batch.transitions, newTransition(tx, root, TransitionContext{}))
+	}
+
+	estimate, err := EstimateBatchGas(api.codec, batch)
+	if err != nil {
+		return nil, err
+	}
+	if gasPrice != nil {
+		estimate = estimate.withCost(gasPrice.ToInt())
+	}
+	return estimate, nil
+}
@@ -0,0 +1,199 @@
+package rollup
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// BatchEncoding identifies the BatchCodec used to encode a TransitionBatch, and the
+// layout of its transitions. It is stored as the version byte heading every encoded
+// TransitionBatch.
+type BatchEncoding byte
+
+const (
+	BatchEncodingRLPV0  BatchEncoding = 0x00 // Plain RLP list of transitions without L1 block numbers
+	BatchEncodingZlibV0 BatchEncoding = 0x01 // zlib compressed BatchEncodingRLPV0 body
+	BatchEncodingRLPV1  BatchEncoding = 0x02 // Plain RLP list of transitions without block difficulties
+	BatchEncodingZlibV1 BatchEncoding = 0x03 // zlib compressed BatchEncodingRLPV1 body
+	BatchEncodingRLP    BatchEncoding = 0x04 // Plain RLP list of transitions
+	BatchEncodingZlib   BatchEncoding = 0x05 // zlib compressed BatchEncodingRLP body
+
+	// maxDecodedBatchSize bounds the size of a decompressed TransitionBatch body.
+	maxDecodedBatchSize = 128 * 1024 * 1024
+)
+
+var (
+	ErrEmptyBatchEncoding    = errors.New("empty transition batch encoding")
+	ErrUnknownBatchEncoding  = errors.New("unknown transition batch encoding")
+	ErrBatchEncodingTooLarge = errors.New("transition batch encoding too large")
+
+	batchCodecs = map[BatchEncoding]BatchCodec{
+		BatchEncodingRLP:  rlpBatchCodec{},
+		BatchEncodingZlib: zlibBatchCodec{},
+	}
+	// legacyBatchCodecs decode TransitionBatches encoded before TransitionContexts held
+	// the L1 block number or the block difficulty, which are left zero. They are never
+	// used to encode.
+	legacyBatchCodecs = map[BatchEncoding]BatchCodec{
+		BatchEncodingRLPV0:  rlpBatchCodec{},
+		BatchEncodingZlibV0: zlibBatchCodec{},
+		BatchEncodingRLPV1:  rlpBatchCodec{},
+		BatchEncodingZlibV1: zlibBatchCodec{},
+	}
+)
+
+// BatchCodec encodes the body of TransitionBatches for L1 submission.
+type BatchCodec interface {
+	// Encoding returns the version byte identifying the codec.
+	Encoding() BatchEncoding
+	// Name returns the name the codec is configured by.
+	Name() string
+	// Encode encodes the transitions of the TransitionBatch.
+	Encode(batch *TransitionBatch) ([]byte, error)
+	// Decode decodes a body produced by Encode.
+	Decode(data []byte) (*TransitionBatch, error)
+}
+
+// BatchCodecByName returns the BatchCodec with the provided name.
+func BatchCodecByName(name string) (BatchCodec, error) {
+	for _, codec := range batchCodecs {
+		if codec.Name() == name {
+			return codec, nil
+		}
+	}
+	return nil, fmt.Errorf("%v: %q", ErrUnknownBatchEncoding, name)
+}
+
+// EncodeTransitionBatch encodes the TransitionBatch with the provided BatchCodec,
+// prefixed with the version byte of the codec.
+func EncodeTransitionBatch(codec BatchCodec, batch *TransitionBatch) ([]byte, error) {
+	body, err := codec.Encode(batch)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{byte(codec.Encoding())}, body...), nil
+}
+
+// DecodeTransitionBatch decodes a TransitionBatch encoded by EncodeTransitionBatch,
+// using the BatchCodec identified by its version byte.
+func DecodeTransitionBatch(data []byte) (*TransitionBatch, error) {
+	if len(data) == 0 {
+		return nil, ErrEmptyBatchEncoding
+	}
+	codec, ok := batchCodecs[BatchEncoding(data[0])]
+	if !ok {
+		codec, ok = legacyBatchCodecs[BatchEncoding(data[0])]
+	}
+	if !ok {
+		return nil, fmt.Errorf("%v: %#x", ErrUnknownBatchEncoding, data[0])
+	}
+	return codec.Decode(data[1:])
+}
+
+// encodedTransition is the RLP representation of a Transition. The TransactionMeta is
+// not part of the RLP encoding of a Transaction, so it is encoded separately.
+type encodedTransition struct {
+	Transaction *types.Transaction
+	Meta        []byte
+	PostState   common.Hash
+	Context     TransitionContext
+}
+
+func newEncodedTransition(transition *Transition) encodedTransition {
+	return encodedTransition{
+		Transaction: transition.transaction,
+		Meta:        types.TxMetaEncode(transition.transaction.GetMeta()),
+		PostState:   transition.postState,
+		Context:     transition.context,
+	}
+}
+
+// transition reconstructs the encoded Transition.
+func (e encodedTransition) transition() (*Transition, error) {
+	meta, err := types.TxMetaDecode(e.Meta)
+	if err != nil {
+		return nil, err
+	}
+	e.Transaction.SetTransactionMeta(meta)
+	return newTransition(e.Transaction, e.PostState, e.Context), nil
+}
+
+// rlpBatchCodec encodes a TransitionBatch as an RLP list of its transitions.
+type rlpBatchCodec struct{}
+
+func (rlpBatchCodec) Encoding() BatchEncoding { return BatchEncodingRLP }
+func (rlpBatchCodec) Name() string            { return "rlp" }
+
+func (rlpBatchCodec) Encode(batch *TransitionBatch) ([]byte, error) {
+	transitions := make([]encodedTransition, len(batch.transitions))
+	for i, transition := range batch.transitions {
+		transitions[i] = newEncodedTransition(transition)
+	}
+	return rlp.EncodeToBytes(transitions)
+}
+
+func (rlpBatchCodec) Decode(data []byte) (*TransitionBatch, error) {
+	var transitions []encodedTransition
+	if err := rlp.DecodeBytes(data, &transitions); err != nil {
+		return nil, err
+	}
+	batch := NewTransitionBatch(len(transitions))
+	for _, encoded := range transitions {
+		transition, err := encoded.transition()
+		if err != nil {
+			return nil, err
+		}
+		batch.transitions = append(batch.transitions, transition)
+	}
+	return batch, nil
+}
+
+// zlibBatchCodec compresses the rlpBatchCodec encoding with zlib.
+type zlibBatchCodec struct{}
+
+func (zlibBatchCodec) Encoding() BatchEncoding { return BatchEncodingZlib }
+func (zlibBatchCodec) Name() string            { return "zlib" }
+
+func (zlibBatchCodec) Encode(batch *TransitionBatch) ([]byte, error) {
+	body, err := rlpBatchCodec{}.Encode(batch)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	w, err := zlib.NewWriterLevel(&buf, zlib.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(body); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (zlibBatchCodec) Decode(data []byte) (*TransitionBatch, error) {
+	r, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(r, maxDecodedBatchSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxDecodedBatchSize {
+		return nil, ErrBatchEncodingTooLarge
+	}
+	return rlpBatchCodec{}.Decode(body)
+}
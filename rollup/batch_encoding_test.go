@@ -0,0 +1,211 @@
+package rollup
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// v0EncodedTransition is the RLP layout of a Transition before TransitionContexts held
+// the L1 block number.
+type v0EncodedTransition struct {
+	Transaction *types.Transaction
+	Meta        []byte
+	PostState   common.Hash
+	Context     v0TransitionContextRLP
+}
+
+// v1EncodedTransition is the RLP layout of a Transition before TransitionContexts held
+// the block difficulty.
+type v1EncodedTransition struct {
+	Transaction *types.Transaction
+	Meta        []byte
+	PostState   common.Hash
+	Context     v1TransitionContextRLP
+}
+
+func newV0EncodedTransitions(batch *TransitionBatch) []v0EncodedTransition {
+	transitions := make([]v0EncodedTransition, len(batch.transitions))
+	for i, transition := range batch.transitions {
+		transitions[i] = v0EncodedTransition{
+			Transaction: transition.transaction,
+			Meta:        types.TxMetaEncode(transition.transaction.GetMeta()),
+			PostState:   transition.postState,
+			Context: v0TransitionContextRLP{
+				BlockNumber: transition.context.BlockNumber,
+				Timestamp:   transition.context.Timestamp,
+				GasLimit:    transition.context.GasLimit,
+				Coinbase:    transition.context.Coinbase,
+			},
+		}
+	}
+	return transitions
+}
+
+func TestBatchEncodingRoundTrip(t *testing.T) {
+	blocks := createBlocks(20, 1, true)
+	batch := newTestTransitionBatch(blocks)
+
+	for _, codec := range batchCodecs {
+		t.Run(codec.Name(), func(t *testing.T) {
+			encoded, err := EncodeTransitionBatch(codec, batch)
+			if err != nil {
+				t.Fatalf("unable to encode batch: %v", err)
+			}
+			if BatchEncoding(encoded[0]) != codec.Encoding() {
+				t.Fatalf("expected version byte %#x, got %#x", codec.Encoding(), encoded[0])
+			}
+			decoded, err := DecodeTransitionBatch(encoded)
+			if err != nil {
+				t.Fatalf("unable to decode batch: %v", err)
+			}
+			if len(decoded.transitions) != len(blocks) {
+				t.Fatalf("expected %d transitions, got %d", len(blocks), len(decoded.transitions))
+			}
+			for i, block := range blocks {
+				assertTransitionFromBlock(t, decoded.transitions[i], block)
+			}
+		})
+	}
+}
+
+func TestBatchEncodingL1BlockNumber(t *testing.T) {
+	blocks := createBlocks(3, 1, true)
+	batch := newTestTransitionBatch(blocks)
+	for i, transition := range batch.transitions {
+		transition.context.L1BlockNumber = uint64(100 + i)
+	}
+
+	for _, codec := range batchCodecs {
+		encoded, err := EncodeTransitionBatch(codec, batch)
+		if err != nil {
+			t.Fatalf("%s: unable to encode batch: %v", codec.Name(), err)
+		}
+		decoded, err := DecodeTransitionBatch(encoded)
+		if err != nil {
+			t.Fatalf("%s: unable to decode batch: %v", codec.Name(), err)
+		}
+		for i, transition := range decoded.transitions {
+			if transition.context != batch.transitions[i].context {
+				t.Fatalf("%s: transition %d: expected context %+v, got %+v", codec.Name(), i, batch.transitions[i].context, transition.context)
+			}
+		}
+	}
+}
+
+// Tests that batches encoded before TransitionContexts held the L1 block number still decode.
+func TestDecodeV0BatchEncoding(t *testing.T) {
+	blocks := createBlocks(3, 1, true)
+	batch := newTestTransitionBatch(blocks)
+
+	body, err := rlp.EncodeToBytes(newV0EncodedTransitions(batch))
+	if err != nil {
+		t.Fatalf("unable to encode batch: %v", err)
+	}
+	decoded, err := DecodeTransitionBatch(append([]byte{byte(BatchEncodingRLPV0)}, body...))
+	if err != nil {
+		t.Fatalf("unable to decode batch: %v", err)
+	}
+	if len(decoded.transitions) != len(blocks) {
+		t.Fatalf("expected %d transitions, got %d", len(blocks), len(decoded.transitions))
+	}
+	for i, block := range blocks {
+		assertTransitionFromBlock(t, decoded.transitions[i], block)
+		if decoded.transitions[i].context != batch.transitions[i].context {
+			t.Fatalf("transition %d: expected context %+v, got %+v", i, batch.transitions[i].context, decoded.transitions[i].context)
+		}
+	}
+}
+
+// Tests that batches encoded before TransitionContexts held the block difficulty still
+// decode, and are re-executed at a difficulty of one.
+func TestDecodeV1BatchEncoding(t *testing.T) {
+	blocks := createBlocks(3, 1, true)
+	batch := newTestTransitionBatch(blocks)
+
+	transitions := make([]v1EncodedTransition, len(batch.transitions))
+	for i, transition := range batch.transitions {
+		transition.context.L1BlockNumber = uint64(100 + i)
+		transition.context.Difficulty = 2
+		transitions[i] = v1EncodedTransition{
+			Transaction: transition.transaction,
+			Meta:        types.TxMetaEncode(transition.transaction.GetMeta()),
+			PostState:   transition.postState,
+			Context: v1TransitionContextRLP{
+				BlockNumber:   transition.context.BlockNumber,
+				Timestamp:     transition.context.Timestamp,
+				GasLimit:      transition.context.GasLimit,
+				Coinbase:      transition.context.Coinbase,
+				L1BlockNumber: transition.context.L1BlockNumber,
+			},
+		}
+	}
+	body, err := rlp.EncodeToBytes(transitions)
+	if err != nil {
+		t.Fatalf("unable to encode batch: %v", err)
+	}
+	decoded, err := DecodeTransitionBatch(append([]byte{byte(BatchEncodingRLPV1)}, body...))
+	if err != nil {
+		t.Fatalf("unable to decode batch: %v", err)
+	}
+	for i, transition := range decoded.transitions {
+		want := batch.transitions[i].context
+		want.Difficulty = 0
+		if transition.context != want {
+			t.Fatalf("transition %d: expected context %+v, got %+v", i, want, transition.context)
+		}
+		if !transition.context.equal(batch.transitions[i].context) {
+			t.Fatalf("transition %d: context without difficulty does not match the block", i)
+		}
+		if difficulty := transition.context.header(params.TestChainConfig, blocks[i].Header()).Difficulty; difficulty.Cmp(common.Big1) != 0 {
+			t.Fatalf("transition %d: expected difficulty 1, got %d", i, difficulty)
+		}
+	}
+}
+
+func TestBatchEncodingGasUsage(t *testing.T) {
+	batch := newTestTransitionBatch(createBlocks(20, 1, true))
+
+	rlpGas, err := TransitionBatchGasUsage(rlpBatchCodec{}, batch)
+	if err != nil {
+		t.Fatalf("unable to compute rlp gas usage: %v", err)
+	}
+	zlibGas, err := TransitionBatchGasUsage(zlibBatchCodec{}, batch)
+	if err != nil {
+		t.Fatalf("unable to compute zlib gas usage: %v", err)
+	}
+	if zlibGas >= rlpGas {
+		t.Fatalf("expected compressed batch to use less gas, rlp: %d, zlib: %d", rlpGas, zlibGas)
+	}
+}
+
+func TestDecodeInvalidBatchEncoding(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"unknown version", []byte{0xff, 0xc0}},
+		{"invalid rlp", []byte{byte(BatchEncodingRLP), 0xff}},
+		{"invalid zlib", []byte{byte(BatchEncodingZlib), 0x01, 0x02}},
+	}
+	for _, tt := range tests {
+		if _, err := DecodeTransitionBatch(tt.data); err == nil {
+			t.Errorf("%s: expected decoding error", tt.name)
+		}
+	}
+}
+
+func TestBatchCodecByName(t *testing.T) {
+	for _, codec := range batchCodecs {
+		if have, err := BatchCodecByName(codec.Name()); err != nil || have != codec {
+			t.Errorf("%s: unexpected codec %v, error %v", codec.Name(), have, err)
+		}
+	}
+	if _, err := BatchCodecByName("brotli"); err == nil {
+		t.Error("expected error for unknown codec")
+	}
+}
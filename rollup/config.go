@@ -2,10 +2,22 @@ package rollup
 
 import (
 	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
 	"time"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 type Config struct {
+	// RollupTxSender is the account authenticating rollup transaction submissions and
+	// signing the transactions wrapping them. Unless RollupTxSenderKey is set, it is
+	// managed by the account manager, so it may be a keystore account or one of an
+	// external signer. Rollup transaction submissions are rejected if it is not set.
+	RollupTxSender    *common.Address
+	RollupTxSenderKey *ecdsa.PrivateKey // Key of RollupTxSender loaded from a key file, only held in memory
+
 	TxIngestionEnable       bool
 	TxIngestionDBHost       string
 	TxIngestionDBPort       uint
@@ -14,8 +26,127 @@ type Config struct {
 	TxIngestionDBPassword   string
 	TxIngestionPollInterval time.Duration
 	TxIngestionSignerKey    *ecdsa.PrivateKey
+
+	TxIngestionL1Endpoint      string // Ingest enqueue events from L1 instead of Postgres if set
+	TxIngestionL1QueueAddress  common.Address
+	TxIngestionL1StartBlock    uint64 // L1 block the transaction queue contract was deployed in
+	TxIngestionL1Confirmations uint64
+	TxIngestionL1Backend       L1IngestionBackend // Connection to TxIngestionL1Endpoint, set up by the node
+	TxIngestionReplayFile      string             // JSONL file to replay submissions from instead of Postgres or L1
+
+	BatchBuilderEnable               bool
+	BatchBuilderMaxBatchAge          time.Duration // Time after which a non-empty batch is built even if not full
+	BatchBuilderMaxBatchGas          uint64        // Maximum L1 gas a batch may use
+	BatchBuilderMaxBatchTransactions int           // Maximum number of transactions in a batch
+	BatchBuilderEncoding             string        // Name of the BatchCodec batches are encoded with
+	BatchBuilderMinBackoff           time.Duration // Delay before retrying a failed batch builder operation
+	BatchBuilderMaxBackoff           time.Duration // Upper bound of the exponentially growing retry delay
+
+	BatchSubmitterL1Endpoint      string
+	BatchSubmitterL1ChainID       *big.Int // Chain ID L1 submissions are signed for, queried from BatchSubmitterL1Endpoint if not set
+	BatchSubmitterContractAddress common.Address
+	BatchSubmitterConfirmations   uint64
+	BatchSubmitterPollInterval    time.Duration
+	BatchSubmitterResubmitTimeout time.Duration // Time after which a submission that is not mined is replaced
+	BatchSubmitterKey             *ecdsa.PrivateKey
+	BatchSubmitterL1Backend       L1Backend // Connection to BatchSubmitterL1Endpoint, set up by the node
+
+	VerifierL1Endpoint      string
+	VerifierContractAddress common.Address
+	VerifierStartBlock      uint64 // L1 block the canonical transition chain contract was deployed in
+	VerifierConfirmations   uint64
+	VerifierPollInterval    time.Duration
+	VerifierFixture         string        // File to read transition batches from instead of L1
+	VerifierL1Backend       L1BatchReader // Connection to VerifierL1Endpoint, set up by the node
 }
 
 func (c *Config) IsTxIngestionEnabled() bool {
 	return c.TxIngestionEnable
 }
+
+func (c *Config) IsBatchBuilderEnabled() bool {
+	return c.BatchBuilderEnable
+}
+
+func (c *Config) IsBatchSubmitterEnabled() bool {
+	return c.BatchBuilderEnable && c.BatchSubmitterL1Endpoint != ""
+}
+
+func (c *Config) IsVerifierEnabled() bool {
+	return c.VerifierL1Endpoint != "" || c.VerifierFixture != ""
+}
+
+func (c *Config) BatchBuilderBackoff() Backoff {
+	return Backoff{Min: c.BatchBuilderMinBackoff, Max: c.BatchBuilderMaxBackoff}
+}
+
+// Validate checks that the transaction ingestion, transition batch builder, submitter and
+// verifier settings are usable. Settings of disabled components are not checked.
+func (c *Config) Validate() error {
+	if c.IsTxIngestionEnabled() {
+		if c.TxIngestionL1Endpoint != "" && c.TxIngestionReplayFile != "" {
+			return errors.New("transaction ingestion reads submissions from either L1 or a replay file, not both")
+		}
+		if c.TxIngestionL1Endpoint != "" && c.TxIngestionL1QueueAddress == (common.Address{}) {
+			return errors.New("L1 transaction ingestion requires a queue contract address")
+		}
+		if c.TxIngestionPollInterval <= 0 {
+			return errors.New("transaction ingestion poll interval must be positive")
+		}
+	}
+	if c.IsVerifierEnabled() {
+		return c.validateVerifier()
+	}
+	if !c.IsBatchBuilderEnabled() {
+		return nil
+	}
+	if c.BatchBuilderMaxBatchAge <= 0 {
+		return errors.New("max transition batch age must be positive")
+	}
+	if minGas := TransitionBatchFixedGas + MinTxGas; c.BatchBuilderMaxBatchGas < minGas {
+		return fmt.Errorf("max transition batch gas %d is below the minimum of %d", c.BatchBuilderMaxBatchGas, minGas)
+	}
+	if c.BatchBuilderMaxBatchTransactions <= 0 {
+		return errors.New("max transition batch transactions must be positive")
+	}
+	if _, err := BatchCodecByName(c.BatchBuilderEncoding); err != nil {
+		return err
+	}
+	if c.BatchBuilderMinBackoff <= 0 || c.BatchBuilderMaxBackoff < c.BatchBuilderMinBackoff {
+		return fmt.Errorf("invalid transition batch builder backoff %v-%v", c.BatchBuilderMinBackoff, c.BatchBuilderMaxBackoff)
+	}
+	if !c.IsBatchSubmitterEnabled() {
+		return nil
+	}
+	if c.BatchSubmitterContractAddress == (common.Address{}) {
+		return errors.New("transition batch submitter requires a contract address")
+	}
+	if c.BatchSubmitterKey == nil {
+		return ErrBatchSubmitterMissingKey
+	}
+	if c.BatchSubmitterPollInterval <= 0 {
+		return errors.New("transition batch submitter poll interval must be positive")
+	}
+	if c.BatchSubmitterResubmitTimeout <= 0 {
+		return errors.New("transition batch submitter resubmission timeout must be positive")
+	}
+	return nil
+}
+
+// validateVerifier checks that the verifier settings are usable. A verifier derives
+// its chain from L1 only, so it cannot ingest transactions or build batches itself.
+func (c *Config) validateVerifier() error {
+	if c.IsTxIngestionEnabled() || c.IsBatchBuilderEnabled() {
+		return errors.New("verifier cannot ingest transactions or build transition batches")
+	}
+	if c.VerifierL1Endpoint != "" && c.VerifierFixture != "" {
+		return errors.New("verifier reads transition batches from either L1 or a fixture, not both")
+	}
+	if c.VerifierL1Endpoint != "" && c.VerifierContractAddress == (common.Address{}) {
+		return errors.New("verifier requires a contract address")
+	}
+	if c.VerifierPollInterval <= 0 {
+		return errors.New("verifier poll interval must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package rollup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestConfigValidate(t *testing.T) {
+	valid := func() Config {
+		return Config{
+			BatchBuilderEnable:               true,
+			BatchBuilderMaxBatchAge:          time.Minute,
+			BatchBuilderMaxBatchGas:          1_000_000_000,
+			BatchBuilderMaxBatchTransactions: 10,
+			BatchBuilderEncoding:             "zlib",
+			BatchBuilderMinBackoff:           time.Second,
+			BatchBuilderMaxBackoff:           time.Minute,
+			BatchSubmitterL1Endpoint:         "http://localhost:8545",
+			BatchSubmitterContractAddress:    testCanonicalChainAddr,
+			BatchSubmitterPollInterval:       time.Second,
+			BatchSubmitterResubmitTimeout:    time.Minute,
+			BatchSubmitterKey:                testL1Key,
+			TxIngestionPollInterval:          time.Second,
+		}
+	}
+	enableVerifier := func(c *Config) {
+		c.BatchBuilderEnable = false
+		c.VerifierL1Endpoint = "http://localhost:8545"
+		c.VerifierContractAddress = testCanonicalChainAddr
+		c.VerifierPollInterval = time.Second
+	}
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		valid  bool
+	}{
+		{"valid", func(c *Config) {}, true},
+		{"builder disabled ignores limits", func(c *Config) { c.BatchBuilderEnable, c.BatchBuilderMaxBatchGas = false, 0 }, true},
+		{"submitter disabled ignores key", func(c *Config) { c.BatchSubmitterL1Endpoint, c.BatchSubmitterKey = "", nil }, true},
+		{"zero max age", func(c *Config) { c.BatchBuilderMaxBatchAge = 0 }, false},
+		{"max gas below minimum", func(c *Config) { c.BatchBuilderMaxBatchGas = TransitionBatchFixedGas }, false},
+		{"zero max transactions", func(c *Config) { c.BatchBuilderMaxBatchTransactions = 0 }, false},
+		{"unknown encoding", func(c *Config) { c.BatchBuilderEncoding = "brotli" }, false},
+		{"zero min backoff", func(c *Config) { c.BatchBuilderMinBackoff = 0 }, false},
+		{"max backoff below min", func(c *Config) { c.BatchBuilderMaxBackoff = time.Millisecond }, false},
+		{"missing contract", func(c *Config) { c.BatchSubmitterContractAddress = common.Address{} }, false},
+		{"missing key", func(c *Config) { c.BatchSubmitterKey = nil }, false},
+		{"zero poll interval", func(c *Config) { c.BatchSubmitterPollInterval = 0 }, false},
+		{"zero resubmission timeout", func(c *Config) { c.BatchSubmitterResubmitTimeout = 0 }, false},
+		{"ingestion from L1", func(c *Config) {
+			c.TxIngestionEnable, c.TxIngestionL1Endpoint, c.TxIngestionL1QueueAddress = true, "ws://localhost:8546", testQueueAddr
+		}, true},
+		{"ingestion from L1 missing queue", func(c *Config) { c.TxIngestionEnable, c.TxIngestionL1Endpoint = true, "ws://localhost:8546" }, false},
+		{"ingestion zero poll interval", func(c *Config) { c.TxIngestionEnable, c.TxIngestionPollInterval = true, 0 }, false},
+		{"ingestion from replay file", func(c *Config) { c.TxIngestionEnable, c.TxIngestionReplayFile = true, "submissions.jsonl" }, true},
+		{"ingestion from L1 and replay file", func(c *Config) {
+			c.TxIngestionEnable, c.TxIngestionL1Endpoint, c.TxIngestionL1QueueAddress = true, "ws://localhost:8546", testQueueAddr
+			c.TxIngestionReplayFile = "submissions.jsonl"
+		}, false},
+		{"verifier", enableVerifier, true},
+		{"verifier from fixture", func(c *Config) { enableVerifier(c); c.VerifierL1Endpoint, c.VerifierFixture = "", "batches.json" }, true},
+		{"verifier with builder", func(c *Config) { enableVerifier(c); c.BatchBuilderEnable = true }, false},
+		{"verifier with ingestion", func(c *Config) { enableVerifier(c); c.TxIngestionEnable = true }, false},
+		{"verifier with endpoint and fixture", func(c *Config) { enableVerifier(c); c.VerifierFixture = "batches.json" }, false},
+		{"verifier missing contract", func(c *Config) { enableVerifier(c); c.VerifierContractAddress = common.Address{} }, false},
+		{"verifier zero poll interval", func(c *Config) { enableVerifier(c); c.VerifierPollInterval = 0 }, false},
+	}
+	for _, tt := range tests {
+		cfg := valid()
+		tt.modify(&cfg)
+		if err := cfg.Validate(); (err == nil) != tt.valid {
+			t.Errorf("%s: expected valid %v, got error %v", tt.name, tt.valid, err)
+		}
+	}
+}
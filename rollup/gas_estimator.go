@@ -0,0 +1,98 @@
+package rollup
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+const (
+	// TransitionBatchFixedGas is the L1 gas every TransitionBatch submission uses regardless
+	// of its contents: the intrinsic transaction gas and storing the batch header.
+	TransitionBatchFixedGas = params.TxGas + params.SstoreSetGas
+	// StateRootCommitmentGas is the L1 gas used to commit to a single post-state root.
+	StateRootCommitmentGas = params.SstoreSetGas
+)
+
+// BatchGasEstimate is the breakdown of the L1 gas submitting a TransitionBatch uses.
+type BatchGasEstimate struct {
+	Transitions  hexutil.Uint64 `json:"transitions"`
+	EncodedSize  hexutil.Uint64 `json:"encodedSize"`  // Size of the encoded batch, including its version byte
+	ZeroBytes    hexutil.Uint64 `json:"zeroBytes"`    // Zero bytes of the submission calldata
+	NonZeroBytes hexutil.Uint64 `json:"nonZeroBytes"` // Non-zero bytes of the submission calldata
+	FixedGas     hexutil.Uint64 `json:"fixedGas"`
+	CalldataGas  hexutil.Uint64 `json:"calldataGas"`
+	StateRootGas hexutil.Uint64 `json:"stateRootGas"`
+	Gas          hexutil.Uint64 `json:"gas"`
+	Cost         *hexutil.Big   `json:"cost,omitempty"` // Gas priced at the requested L1 gas price, if any
+}
+
+// EstimateBatchGas estimates the L1 gas used to submit the TransitionBatch encoded with
+// the provided BatchCodec. Calldata is priced per byte of the actual submission, which
+// includes the ABI encoding of the call, distinguishing zero and non-zero bytes.
+func EstimateBatchGas(codec BatchCodec, batch *TransitionBatch) (*BatchGasEstimate, error) {
+	encoded, err := EncodeTransitionBatch(codec, batch)
+	if err != nil {
+		return nil, err
+	}
+	calldata, err := canonicalTransitionChainAbi.Pack(appendTransitionBatchMethod, encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	var zeros uint64
+	for _, b := range calldata {
+		if b == 0 {
+			zeros++
+		}
+	}
+	nonZeros := uint64(len(calldata)) - zeros
+
+	var (
+		calldataGas  = zeros*params.TxDataZeroGas + nonZeros*params.TxDataNonZeroGasEIP2028
+		stateRootGas = uint64(len(batch.transitions)) * StateRootCommitmentGas
+	)
+	return &BatchGasEstimate{
+		Transitions:  hexutil.Uint64(len(batch.transitions)),
+		EncodedSize:  hexutil.Uint64(len(encoded)),
+		ZeroBytes:    hexutil.Uint64(zeros),
+		NonZeroBytes: hexutil.Uint64(nonZeros),
+		FixedGas:     hexutil.Uint64(TransitionBatchFixedGas),
+		CalldataGas:  hexutil.Uint64(calldataGas),
+		StateRootGas: hexutil.Uint64(stateRootGas),
+		Gas:          hexutil.Uint64(TransitionBatchFixedGas + calldataGas + stateRootGas),
+	}, nil
+}
+
+// TransitionBatchGasUsage determines the amount of L1 gas submitting the TransitionBatch
+// encoded with the provided BatchCodec will use.
+func TransitionBatchGasUsage(codec BatchCodec, batch *TransitionBatch) (uint64, error) {
+	estimate, err := EstimateBatchGas(codec, batch)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(estimate.Gas), nil
+}
+
+// maxEncodingOverhead bounds the bytes the BatchCodecs of this package add to the RLP
+// encodings of the transitions of a TransitionBatch, besides the stored block headers
+// of incompressible zlib data: the version byte, the RLP list header, and the zlib
+// header and checksum.
+const maxEncodingOverhead = 64
+
+// transitionBatchGasBound bounds the L1 gas submitting a TransitionBatch of the provided
+// number of transitions uses, given the total size of the RLP encodings of the
+// transitions. Every calldata byte is accounted for as non-zero, and zlib is assumed
+// unable to compress the batch at all.
+func transitionBatchGasBound(transitions int, rlpSize uint64) uint64 {
+	encoded := rlpSize + rlpSize/1024 + maxEncodingOverhead
+	calldata := 4 + 2*32 + (encoded+31)/32*32 // Method selector, offset, length and padded batch
+	return TransitionBatchFixedGas + calldata*params.TxDataNonZeroGasEIP2028 + uint64(transitions)*StateRootCommitmentGas
+}
+
+// withCost prices the estimate at the provided L1 gas price.
+func (e *BatchGasEstimate) withCost(gasPrice *big.Int) *BatchGasEstimate {
+	e.Cost = (*hexutil.Big)(new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(uint64(e.Gas))))
+	return e
+}
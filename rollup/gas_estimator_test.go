@@ -0,0 +1,124 @@
+package rollup
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// TestEstimateBatchGasCalldata checks the calldata part of the estimate against the
+// intrinsic gas of the actual L1 submission.
+func TestEstimateBatchGasCalldata(t *testing.T) {
+	for _, codec := range batchCodecs {
+		batch := newTestTransitionBatch(createBlocks(10, 1, true))
+		estimate, err := EstimateBatchGas(codec, batch)
+		if err != nil {
+			t.Fatalf("%s: unable to estimate batch gas: %v", codec.Name(), err)
+		}
+		if want := uint64(estimate.FixedGas + estimate.CalldataGas + estimate.StateRootGas); uint64(estimate.Gas) != want {
+			t.Fatalf("%s: gas mismatch: have %d, want %d", codec.Name(), estimate.Gas, want)
+		}
+		if want := uint64(len(batch.transitions)) * StateRootCommitmentGas; uint64(estimate.StateRootGas) != want {
+			t.Fatalf("%s: state root gas mismatch: have %d, want %d", codec.Name(), estimate.StateRootGas, want)
+		}
+
+		encoded, err := EncodeTransitionBatch(codec, batch)
+		if err != nil {
+			t.Fatalf("%s: unable to encode batch: %v", codec.Name(), err)
+		}
+		calldata, err := canonicalTransitionChainAbi.Pack(appendTransitionBatchMethod, encoded)
+		if err != nil {
+			t.Fatalf("%s: unable to pack submission: %v", codec.Name(), err)
+		}
+		intrinsic, err := core.IntrinsicGas(calldata, false, true, true)
+		if err != nil {
+			t.Fatalf("%s: unable to compute intrinsic gas: %v", codec.Name(), err)
+		}
+		if want := intrinsic - params.TxGas; uint64(estimate.CalldataGas) != want {
+			t.Fatalf("%s: calldata gas mismatch: have %d, want %d", codec.Name(), estimate.CalldataGas, want)
+		}
+		if have := uint64(estimate.ZeroBytes + estimate.NonZeroBytes); have != uint64(len(calldata)) {
+			t.Fatalf("%s: calldata size mismatch: have %d, want %d", codec.Name(), have, len(calldata))
+		}
+		if uint64(estimate.EncodedSize) != uint64(len(encoded)) {
+			t.Fatalf("%s: encoded size mismatch: have %d, want %d", codec.Name(), estimate.EncodedSize, len(encoded))
+		}
+	}
+}
+
+// TestTransitionBatchGasBound checks that the gas bound of a TransitionBatch is never
+// below its actual gas usage, even for incompressible transactions.
+func TestTransitionBatchGasBound(t *testing.T) {
+	for _, codec := range batchCodecs {
+		for _, size := range []int{0, 100, 40000, 200000} {
+			data := make([]byte, size)
+			rand.Read(data)
+			tx := types.NewTransaction(0, testUserAddress, big.NewInt(1), params.TxGas, big.NewInt(0), data, &testUserAddress, &testRollupTxId, types.QueueOriginSequencer, types.SighashEIP155)
+
+			batch := NewTransitionBatch(3)
+			var rlpSize uint64
+			for i := 0; i < 3; i++ {
+				transition := newTransition(tx, common.Hash{byte(i)}, TransitionContext{BlockNumber: 1})
+				encoded, err := rlp.EncodeToBytes(newEncodedTransition(transition))
+				if err != nil {
+					t.Fatalf("%s: unable to encode transition: %v", codec.Name(), err)
+				}
+				batch.transitions = append(batch.transitions, transition)
+				rlpSize += uint64(len(encoded))
+
+				gas, err := TransitionBatchGasUsage(codec, batch)
+				if err != nil {
+					t.Fatalf("%s: unable to compute batch gas usage: %v", codec.Name(), err)
+				}
+				if bound := transitionBatchGasBound(len(batch.transitions), rlpSize); bound < gas {
+					t.Errorf("%s: %d transitions of %d bytes: bound %d below gas %d", codec.Name(), i+1, size, bound, gas)
+				}
+			}
+		}
+	}
+}
+
+func TestEstimateBatchCostAPI(t *testing.T) {
+	blocks := createBlocks(3, 1, true)
+	txs := make([]hexutil.Bytes, len(blocks))
+	for i, block := range blocks {
+		txs[i], _ = rlp.EncodeToBytes(block.Transactions()[0])
+	}
+
+	api := NewPublicRollupAPI(nil, nil, NewDummyBatchBuilder(), nil, nil, nil)
+	if _, err := api.EstimateBatchCost(txs, nil); err != ErrBatchBuilderDisabled {
+		t.Fatalf("expected %v, got %v", ErrBatchBuilderDisabled, err)
+	}
+
+	api = NewPublicRollupAPI(nil, nil, NewDummyBatchBuilder(), testCodec, nil, nil)
+	empty, err := api.EstimateBatchCost(nil, nil)
+	if err != nil {
+		t.Fatalf("unable to estimate empty batch: %v", err)
+	}
+	if empty.Cost != nil || empty.Transitions != 0 || empty.StateRootGas != 0 {
+		t.Fatalf("unexpected empty batch estimate: %+v", empty)
+	}
+
+	gasPrice := big.NewInt(3)
+	estimate, err := api.EstimateBatchCost(txs, (*hexutil.Big)(gasPrice))
+	if err != nil {
+		t.Fatalf("unable to estimate batch: %v", err)
+	}
+	if estimate.Transitions != hexutil.Uint64(len(txs)) || estimate.Gas <= empty.Gas {
+		t.Fatalf("unexpected batch estimate: %+v", estimate)
+	}
+	if want := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(uint64(estimate.Gas))); estimate.Cost.ToInt().Cmp(want) != 0 {
+		t.Fatalf("cost mismatch: have %v, want %v", estimate.Cost, want)
+	}
+
+	if _, err := api.EstimateBatchCost([]hexutil.Bytes{{0x01}}, nil); err == nil {
+		t.Fatal("expected error for invalid transaction")
+	}
+}
@@ -0,0 +1,80 @@
+package rollup
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// ChainBlockStore is the BlockStore of a BlockChain. The intermediate state roots of
+// Geth Blocks with more than one transaction are computed by replaying the Block on top
+// of its parent.
+type ChainBlockStore struct {
+	*core.BlockChain
+}
+
+// NewChainBlockStore creates the BlockStore of the provided BlockChain.
+func NewChainBlockStore(chain *core.BlockChain) *ChainBlockStore {
+	return &ChainBlockStore{BlockChain: chain}
+}
+
+// IntermediateRoots returns the state root after each transaction of the Geth Block.
+func (s *ChainBlockStore) IntermediateRoots(block *types.Block) ([]common.Hash, error) {
+	if len(block.Transactions()) <= 1 {
+		return []common.Hash{block.Root()}, nil
+	}
+	parent := s.GetBlock(block.ParentHash(), block.NumberU64()-1)
+	if parent == nil {
+		return nil, fmt.Errorf("missing parent block %d", block.NumberU64()-1)
+	}
+	statedb, err := s.StateAt(parent.Root())
+	if err != nil {
+		return nil, err
+	}
+	header := types.CopyHeader(block.Header())
+	header.GasUsed = 0
+	_, _, roots, err := applyTransactions(s.BlockChain, header, block.Transactions(), statedb)
+	if err != nil {
+		return nil, err
+	}
+	if header.Root != block.Root() {
+		return nil, fmt.Errorf("replayed block %d state root mismatch: have %x, want %x", block.NumberU64(), header.Root, block.Root())
+	}
+	return roots, nil
+}
+
+// applyTransactions applies the transactions to the state in a Geth Block with the provided
+// header, returning their receipts and logs and the state root after each of them. The Block
+// is finalized after the last transaction, whose root is the state root of the Block. The
+// gas used and state root of the header are filled in.
+func applyTransactions(chain *core.BlockChain, header *types.Header, txs types.Transactions, statedb *state.StateDB) (types.Receipts, []*types.Log, []common.Hash, error) {
+	if len(txs) == 0 {
+		return nil, nil, nil, errors.New("no transactions to apply")
+	}
+	var (
+		config   = chain.Config()
+		gp       = new(core.GasPool).AddGas(header.GasLimit)
+		receipts = make(types.Receipts, 0, len(txs))
+		logs     []*types.Log
+		roots    = make([]common.Hash, len(txs))
+	)
+	for i, tx := range txs {
+		statedb.Prepare(tx.Hash(), common.Hash{}, i)
+		receipt, err := core.ApplyTransaction(config, chain, nil, gp, statedb, header, tx, &header.GasUsed, vm.Config{})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		receipts = append(receipts, receipt)
+		logs = append(logs, receipt.Logs...)
+		roots[i] = statedb.IntermediateRoot(config.IsEIP158(header.Number))
+	}
+	chain.Engine().Finalize(chain, header, statedb, txs, nil)
+	header.Root = statedb.IntermediateRoot(config.IsEIP158(header.Number))
+	roots[len(roots)-1] = header.Root
+	return receipts, logs, roots, nil
+}
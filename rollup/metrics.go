@@ -0,0 +1,21 @@
+package rollup
+
+import (
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+var (
+	batchBuilderErrorMeter   = metrics.NewRegisteredMeter("rollup/builder/errors", nil)
+	batchBuilderRetryMeter   = metrics.NewRegisteredMeter("rollup/builder/retries", nil)
+	batchBuilderFailureGauge = metrics.NewRegisteredGauge("rollup/builder/failures", nil)
+	batchBuilderHaltedGauge  = metrics.NewRegisteredGauge("rollup/builder/halted", nil)
+	batchBuilderReorgMeter   = metrics.NewRegisteredMeter("rollup/builder/reorgs", nil)
+	batchConfirmedMeter      = metrics.NewRegisteredMeter("rollup/builder/batches/confirmed", nil)
+	batchReplacedMeter       = metrics.NewRegisteredMeter("rollup/builder/batches/replaced", nil)
+
+	txIngestionMeter        = metrics.NewRegisteredMeter("rollup/ingestion/transactions", nil)
+	txIngestionFailureMeter = metrics.NewRegisteredMeter("rollup/ingestion/failures", nil)
+
+	verifierBatchMeter    = metrics.NewRegisteredMeter("rollup/verifier/batches", nil)
+	verifierMismatchMeter = metrics.NewRegisteredMeter("rollup/verifier/mismatches", nil)
+)
@@ -3,107 +3,212 @@ package rollup
 import (
 	"context"
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"net/url"
+	"sync"
 	"time"
 
-	"errors"
-
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
-
-	"github.com/jmoiron/sqlx"
-	_ "github.com/lib/pq"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
-type TxIngestion struct {
-	loopTicker *time.Ticker
-	db         *sqlx.DB
-	signer     types.Signer
-	key        *ecdsa.PrivateKey
-	txpool     *core.TxPool
+// Submission is a group of L1-to-L2 transactions that are ingested together.
+type Submission struct {
+	Index        uint64
+	Transactions []*types.Transaction
 }
 
-// TODO(mark): sanitize the poll interval input
-func NewTxIngestion(cfg Config, chaincfg *params.ChainConfig, txpool *core.TxPool) *TxIngestion {
-	if cfg.TxIngestionSignerKey == nil {
-		cfg.TxIngestionSignerKey, _ = crypto.GenerateKey()
-	}
+// IngestionSource provides the Submissions of L1-to-L2 transactions to ingest, in order.
+type IngestionSource interface {
+	// NextSubmission returns the oldest Submission that has not been acknowledged, or
+	// nil if there is none yet.
+	NextSubmission(ctx context.Context) (*Submission, error)
+	// Acknowledge marks the Submission returned by NextSubmission as ingested.
+	Acknowledge(ctx context.Context, submission *Submission) error
+	// Close releases the resources held by the source.
+	Close() error
+}
 
-	txIngestion := TxIngestion{
-		signer:     types.NewOVMSigner(chaincfg.ChainID),
-		txpool:     txpool,
-		loopTicker: time.NewTicker(cfg.TxIngestionPollInterval),
-		key:        cfg.TxIngestionSignerKey,
+// NewIngestionSource creates the IngestionSource configured by cfg, replaying a file if
+// one is configured, ingesting enqueue events from L1 if an L1 endpoint is configured
+// and reading from Postgres otherwise. Nil is returned if transaction ingestion is disabled.
+func NewIngestionSource(cfg *Config, db ethdb.Database) (IngestionSource, error) {
+	if !cfg.IsTxIngestionEnabled() {
+		return nil, nil
+	}
+	if cfg.TxIngestionReplayFile != "" {
+		return NewReplayIngestionSource(cfg.TxIngestionReplayFile)
+	}
+	if cfg.TxIngestionL1Endpoint != "" {
+		if cfg.TxIngestionL1Backend == nil {
+			return nil, fmt.Errorf("no L1 backend available for transaction ingestion from %s", cfg.TxIngestionL1Endpoint)
+		}
+		return NewL1IngestionSource(db, cfg.TxIngestionL1Backend, cfg.TxIngestionL1QueueAddress, cfg.TxIngestionL1StartBlock, cfg.TxIngestionL1Confirmations, cfg.TxIngestionPollInterval)
 	}
+	return NewSQLIngestionSource(cfg)
+}
 
-	if cfg.IsTxIngestionEnabled() {
-		log.Info("Transaction ingestion connecting to database", "host", cfg.TxIngestionDBHost, "port", cfg.TxIngestionDBPort)
+// States of a TxIngestion, as reported by its TxIngestionStatus.
+const (
+	TxIngestionIngesting = "ingesting"
+	TxIngestionHalted    = "halted"
+)
 
-		// TODO: If geth is closing gracefully, need to get event
-		// and pass through to cancel this context
-		ctx := context.Background()
-		db, err := txIngestion.DBConnectWithRetry(ctx, &cfg)
-		if err != nil {
-			panic("Timed out connecting to database")
-		}
-		log.Info("TxIngestion connected to database")
-		txIngestion.db = db
+var (
+	ErrTxIngestionDisabled = errors.New("transaction ingestion disabled")
+	ErrMissingL1RollupTxId = errors.New("missing L1 rollup tx id")
+	ErrL1RollupTxIdOrder   = errors.New("L1 rollup tx id not increasing")
+	ErrTxIngestionRunning  = errors.New("transaction ingestion not halted")
 
-		go txIngestion.loop()
-	}
-	return &txIngestion
+	// TxIngestionFailureDBKey -> RLP(TxIngestionFailure) of the element ingestion halted at.
+	TxIngestionFailureDBKey = []byte("rollupTxIngestionFailure")
+	// TxIngestionAcknowledgedDBKey tracks the L1 tx id of the last transaction of the last
+	// acknowledged Submission.
+	TxIngestionAcknowledgedDBKey = []byte("rollupTxIngestionAcknowledged")
+)
+
+const (
+	// chainHeadChanSize is the size of the channel listening to ChainHeadEvent.
+	chainHeadChanSize = 10
+	// txChanSize is the size of the channel listening to NewTxsEvent.
+	txChanSize = 4096
+)
+
+// maxTxIngestionBackoff is the upper bound of the delay between retries of failed
+// transaction ingestion attempts.
+const maxTxIngestionBackoff = time.Minute
+
+// txIngestionProgress is the position of the next element to be included in the chain.
+type txIngestionProgress struct {
+	NextSubmissionIndex uint64
+	NextElement         uint64 // Index of the next transaction within the submission
+	LastL1RollupTxId    uint64 // L1 tx id of the last included transaction, if Included
+	Included            bool   // Whether any transaction has been included yet
 }
 
-func (t *TxIngestion) DBConnectWithRetry(ctx context.Context, cfg *Config) (*sqlx.DB, error) {
-	connErrCh := make(chan error, 1)
-	defer close(connErrCh)
+// TxIngestionFailure describes the element of a Submission that could not be applied.
+type TxIngestionFailure struct {
+	SubmissionIndex hexutil.Uint64 `json:"submissionIndex"`
+	Element         hexutil.Uint64 `json:"element"`
+	TxHash          common.Hash    `json:"txHash"`
+	Error           string         `json:"error"`
+}
 
-	var db *sqlx.DB
-	var err error
+// TxIngestionStatus reports the progress of a TxIngestion.
+type TxIngestionStatus struct {
+	State               string              `json:"state"`
+	NextSubmissionIndex hexutil.Uint64      `json:"nextSubmissionIndex"`
+	NextElement         hexutil.Uint64      `json:"nextElement"`
+	LastL1RollupTxId    *hexutil.Uint64     `json:"lastL1RollupTxId"`
+	LastError           string              `json:"lastError,omitempty"`
+	Failure             *TxIngestionFailure `json:"failure,omitempty"`
+}
 
-	go func() {
-		try := 0
-		for {
-			try++
-			db, err = t.DBConnect(cfg)
-			if err != nil {
-				log.Error("Cannot connect to postgres", "msg", err.Error(), "try", try)
-				select {
-				case <-ctx.Done():
-					break
-				case <-time.After(time.Second):
-					continue
-				}
-			}
-			break
-		}
-		connErrCh <- err
-	}()
+// TxIngestion signs the L1-to-L2 transactions of the Submissions of an IngestionSource
+// and adds them to the transaction pool. Every transaction is applied exactly once, as
+// identified by its L1 tx id: transactions up to the last L1 tx id included in the
+// canonical chain are skipped, as are transactions already in the pool, and the rest
+// are added to the pool again until they are included. A Submission is acknowledged
+// once all of its transactions are included. L1 tx ids must increase in the order the
+// source provides them, or ingestion halts. Transactions the pool rejects only
+// temporarily are retried with exponential backoff. If a transaction cannot be applied,
+// ingestion halts and records the failure, which persists across restarts until
+// ingestion is resumed.
+type TxIngestion struct {
+	db           ethdb.Database
+	chain        *core.BlockChain
+	source       IngestionSource
+	signer       types.Signer
+	key          *ecdsa.PrivateKey
+	txpool       *core.TxPool
+	pollInterval time.Duration
+	backoff      Backoff
 
-	select {
-	case err = <-connErrCh:
-		break
-	case <-time.After(time.Minute * 3):
-		return nil, errors.New("db connection timed out")
-	case <-ctx.Done():
-		return nil, errors.New("db connection cancelled")
-	}
+	ctx     context.Context
+	cancel  context.CancelFunc
+	done    chan struct{}
+	tracked chan struct{} // Closed once trackLoop exits
+	resume  chan struct{}
+
+	headCh  chan core.ChainHeadEvent
+	headSub event.Subscription
+	txsCh   chan core.NewTxsEvent
+	txsSub  event.Subscription
+
+	pooledMu sync.Mutex
+	pooled   map[hexutil.Uint64]common.Hash // L1 tx id -> hash of the transactions that entered the pool and are not known to be included
 
-	return db, err
+	acknowledged *hexutil.Uint64 // L1 tx id of the last transaction of the last acknowledged Submission
+
+	statusMu sync.RWMutex
+	progress txIngestionProgress
+	failure  *TxIngestionFailure
+	lastErr  error
 }
 
-func (t *TxIngestion) DBConnect(cfg *Config) (*sqlx.DB, error) {
-	conn := DbConnectionString(cfg)
-	db, err := sqlx.Connect("postgres", conn)
-	if err != nil {
-		return nil, err
+// NewTxIngestion creates a TxIngestion resuming from the transactions included in the
+// chain, and starts it if a source is provided.
+func NewTxIngestion(cfg Config, chaincfg *params.ChainConfig, chain *core.BlockChain, txpool *core.TxPool, db ethdb.Database, source IngestionSource) (*TxIngestion, error) {
+	if cfg.TxIngestionSignerKey == nil {
+		cfg.TxIngestionSignerKey, _ = crypto.GenerateKey()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	txIngestion := &TxIngestion{
+		db:           db,
+		chain:        chain,
+		signer:       types.NewOVMSigner(chaincfg.ChainID),
+		txpool:       txpool,
+		pollInterval: cfg.TxIngestionPollInterval,
+		backoff:      Backoff{Min: cfg.TxIngestionPollInterval, Max: maxTxIngestionBackoff},
+		key:          cfg.TxIngestionSignerKey,
+		source:       source,
+		ctx:          ctx,
+		cancel:       cancel,
+		done:         make(chan struct{}),
+		tracked:      make(chan struct{}),
+		resume:       make(chan struct{}, 1),
+		pooled:       make(map[hexutil.Uint64]common.Hash),
+	}
+	if source == nil {
+		close(txIngestion.done)
+		close(txIngestion.tracked)
+		return txIngestion, nil
+	}
+	if data, _ := db.Get(TxIngestionFailureDBKey); len(data) > 0 {
+		txIngestion.failure = new(TxIngestionFailure)
+		if err := rlp.DecodeBytes(data, txIngestion.failure); err != nil {
+			return nil, fmt.Errorf("invalid transaction ingestion failure: %v", err)
+		}
+		log.Error("Transaction ingestion halted at failed element", "submission", txIngestion.failure.SubmissionIndex, "element", txIngestion.failure.Element, "error", txIngestion.failure.Error)
 	}
-	return db, nil
+	if data, _ := db.Get(TxIngestionAcknowledgedDBKey); len(data) == 8 {
+		acknowledged := hexutil.Uint64(DeserializeBlockNumber(data))
+		txIngestion.acknowledged = &acknowledged
+	}
+	// Subscribe before reading the pool, so that no transaction entering it is missed.
+	txIngestion.headCh = make(chan core.ChainHeadEvent, chainHeadChanSize)
+	txIngestion.headSub = chain.SubscribeChainHeadEvent(txIngestion.headCh)
+	txIngestion.txsCh = make(chan core.NewTxsEvent, txChanSize)
+	txIngestion.txsSub = txpool.SubscribeNewTxsEvent(txIngestion.txsCh)
+	pending, queued := txpool.Content()
+	for _, txs := range []map[common.Address]types.Transactions{pending, queued} {
+		for _, list := range txs {
+			txIngestion.trackPooled(list)
+		}
+	}
+	go txIngestion.trackLoop()
+	go txIngestion.loop()
+	return txIngestion, nil
 }
 
 func (t *TxIngestion) applyTransaction(tx *types.Transaction) error {
@@ -111,52 +216,313 @@ func (t *TxIngestion) applyTransaction(tx *types.Transaction) error {
 }
 
 func (t *TxIngestion) loop() {
-	if t.key == nil {
-		panic("Transaction ingestion requires a private key")
-	}
+	defer close(t.done)
 
 	hex := hexutil.Encode(crypto.FromECDSAPub(&t.key.PublicKey))
 	address := crypto.PubkeyToAddress(t.key.PublicKey)
 	log.Info("Starting transaction ingestion", "key", hex, "address", address.Hex())
 
-	for range t.loopTicker.C {
-		txs, index, err := GetMostRecentQueuedTransactions(t.db)
+	var failures uint64
+	for {
+		if t.halted() {
+			select {
+			case <-t.ctx.Done():
+				return
+			case <-t.resume:
+				failures = 0
+				continue
+			}
+		}
+		err := t.ingestNextSubmission(address)
+		t.statusMu.Lock()
+		t.lastErr = err
+		t.statusMu.Unlock()
+
+		delay := t.pollInterval
 		if err != nil {
-			log.Error("Error getting most recently queued transactions: " + err.Error())
-			continue
+			failures++
+			delay = t.backoff.delay(failures)
+			log.Error("Cannot ingest next submission", "message", err.Error(), "failures", failures, "backoff", delay)
+		} else {
+			failures = 0
+		}
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-time.After(delay):
 		}
+	}
+}
 
-		for i, tx := range txs {
-			log.Debug("Transaction Ingestion", "hash", tx.Hash().Hex(), "submission index", index, "element", i)
+// isTransientPoolError returns whether the transaction pool may accept a transaction
+// it rejected with the provided error once its contents or the chain head change. A
+// full pool rejects transactions as underpriced.
+func isTransientPoolError(err error) bool {
+	return err == core.ErrUnderpriced || err == core.ErrReplaceUnderpriced || err == core.ErrNonceTooLow
+}
 
-			nonce := t.txpool.Nonce(address)
-			tx.SetNonce(nonce)
-			tx, err := types.SignTx(tx, t.signer, t.key)
+// ingestNextSubmission applies the elements of the next Submission that are neither
+// included in the chain nor in the pool, and acknowledges it once all of them are
+// included. A failed element is recorded rather than returned as an error, unless
+// the pool rejected it only temporarily.
+func (t *TxIngestion) ingestNextSubmission(address common.Address) error {
+	submission, err := t.source.NextSubmission(t.ctx)
+	if err != nil || submission == nil {
+		return err
+	}
+	// The pool is read before the chain: transactions leave the pool only after the
+	// chain head including them is set, so none is missed in between.
+	pooled := t.pooledL1RollupTxIds()
+	included := t.lastIncludedL1RollupTxId()
 
-			if err != nil {
-				log.Error("Cannot sign transaction", "hash", tx.Hash().Hex(), "message", err.Error())
-				continue
-			}
+	progress := txIngestionProgress{NextSubmissionIndex: submission.Index, NextElement: uint64(len(submission.Transactions))}
+	if included != nil {
+		progress.LastL1RollupTxId, progress.Included = uint64(*included), true
+	}
+	previous := t.acknowledged
+	for i, tx := range submission.Transactions {
+		id := tx.L1RollupTxId()
+		if id == nil {
+			return t.fail(&TxIngestionFailure{
+				SubmissionIndex: hexutil.Uint64(submission.Index),
+				Element:         hexutil.Uint64(i),
+				TxHash:          tx.Hash(),
+				Error:           ErrMissingL1RollupTxId.Error(),
+			})
+		}
+		// Transactions are told apart by their L1 tx id only, an id that does not
+		// increase would be taken for an included transaction and skipped.
+		if previous != nil && *id <= *previous {
+			return t.fail(&TxIngestionFailure{
+				SubmissionIndex: hexutil.Uint64(submission.Index),
+				Element:         hexutil.Uint64(i),
+				TxHash:          tx.Hash(),
+				Error:           fmt.Sprintf("%v: %d after %d", ErrL1RollupTxIdOrder, *id, *previous),
+			})
+		}
+		previous = id
+		if included != nil && *id <= *included {
+			log.Debug("Skipping included transaction", "submission index", submission.Index, "element", i, "l1 tx id", *id)
+			continue
+		}
+		if uint64(i) < progress.NextElement {
+			progress.NextElement = uint64(i)
+		}
+		if _, ok := pooled[*id]; ok {
+			log.Debug("Skipping pooled transaction", "submission index", submission.Index, "element", i, "l1 tx id", *id)
+			continue
+		}
+		log.Debug("Transaction Ingestion", "hash", tx.Hash().Hex(), "submission index", submission.Index, "element", i, "l1 tx id", *id)
 
-			err = t.applyTransaction(tx)
-			if err != nil {
-				log.Error("Cannot apply transaction", "hash", tx.Hash().Hex(), "message", err.Error())
-				continue
+		tx.SetNonce(t.txpool.Nonce(address))
+		signed, err := types.SignTx(tx, t.signer, t.key)
+		if err == nil {
+			if err = t.applyTransaction(signed); err == nil {
+				t.trackPooled(types.Transactions{signed})
 			}
 		}
-
-		err = UpdateSentSubmissionStatus(t.db, "Sent", index)
 		if err != nil {
-			// TODO(mark): this should probably panic to prevent playing the
-			// same transaction twice
-			log.Error("Cannot update submission status", "message", err.Error())
-			continue
+			t.setProgress(progress)
+			if isTransientPoolError(err) {
+				return fmt.Errorf("cannot apply element %d of submission %d: %v", i, submission.Index, err)
+			}
+			return t.fail(&TxIngestionFailure{
+				SubmissionIndex: hexutil.Uint64(submission.Index),
+				Element:         hexutil.Uint64(i),
+				TxHash:          tx.Hash(),
+				Error:           err.Error(),
+			})
+		}
+		txIngestionMeter.Mark(1)
+	}
+	t.setProgress(progress)
+	if progress.NextElement < uint64(len(submission.Transactions)) {
+		// Acknowledge the submission only once it is fully included, so that
+		// transactions dropped from the pool are applied again.
+		return nil
+	}
+	if err := t.source.Acknowledge(t.ctx, submission); err != nil {
+		return err
+	}
+	if previous != nil {
+		t.acknowledged = previous
+		if err := t.db.Put(TxIngestionAcknowledgedDBKey, SerializeBlockNumber(uint64(*previous))); err != nil {
+			return err
 		}
 	}
+	progress.NextSubmissionIndex, progress.NextElement = submission.Index+1, 0
+	t.setProgress(progress)
+	return nil
+}
+
+// lastIncludedL1RollupTxId returns the L1 tx id of the last L1-to-L2 transaction
+// included in the canonical chain, or nil if there is none.
+func (t *TxIngestion) lastIncludedL1RollupTxId() *hexutil.Uint64 {
+	id := rawdb.ReadBlockL1RollupTxId(t.db, t.chain.CurrentBlock().Hash())
+	if id == nil {
+		return nil
+	}
+	included := hexutil.Uint64(*id)
+	return &included
 }
 
+// pooledL1RollupTxIds returns the L1 tx ids of the tracked transactions that are
+// still in the pool.
+func (t *TxIngestion) pooledL1RollupTxIds() map[hexutil.Uint64]struct{} {
+	t.pooledMu.Lock()
+	defer t.pooledMu.Unlock()
+
+	ids := make(map[hexutil.Uint64]struct{})
+	for id, hash := range t.pooled {
+		if t.txpool.Get(hash) != nil {
+			ids[id] = struct{}{}
+		}
+	}
+	return ids
+}
+
+// trackPooled records the L1-to-L2 transactions among the provided ones that
+// entered the pool.
+func (t *TxIngestion) trackPooled(txs types.Transactions) {
+	t.pooledMu.Lock()
+	defer t.pooledMu.Unlock()
+
+	for _, tx := range txs {
+		if id := tx.L1RollupTxId(); id != nil {
+			t.pooled[*id] = tx.Hash()
+		}
+	}
+}
+
+// prunePooled stops tracking the transactions included up to the provided block.
+func (t *TxIngestion) prunePooled(block *types.Block) {
+	included := rawdb.ReadBlockL1RollupTxId(t.db, block.Hash())
+	if included == nil {
+		return
+	}
+	t.pooledMu.Lock()
+	defer t.pooledMu.Unlock()
+
+	for id := range t.pooled {
+		if uint64(id) <= *included {
+			delete(t.pooled, id)
+		}
+	}
+}
+
+// trackLoop keeps the tracked pool transactions up to date, adding the L1-to-L2
+// transactions entering the pool, as after a reorg, and pruning those included in
+// new chain heads.
+func (t *TxIngestion) trackLoop() {
+	defer close(t.tracked)
+	defer t.headSub.Unsubscribe()
+	defer t.txsSub.Unsubscribe()
+
+	for {
+		select {
+		case ev := <-t.txsCh:
+			t.trackPooled(ev.Txs)
+		case ev := <-t.headCh:
+			t.prunePooled(ev.Block)
+		case <-t.txsSub.Err():
+			return
+		case <-t.headSub.Err():
+			return
+		case <-t.ctx.Done():
+			return
+		}
+	}
+}
+
+func (t *TxIngestion) setProgress(progress txIngestionProgress) {
+	t.statusMu.Lock()
+	t.progress = progress
+	t.statusMu.Unlock()
+}
+
+// fail halts ingestion at the provided failed element.
+func (t *TxIngestion) fail(failure *TxIngestionFailure) error {
+	log.Error("Transaction ingestion halted at failed element", "submission", failure.SubmissionIndex, "element", failure.Element, "hash", failure.TxHash.Hex(), "error", failure.Error)
+	txIngestionFailureMeter.Mark(1)
+
+	t.statusMu.Lock()
+	t.failure = failure
+	t.statusMu.Unlock()
+
+	data, err := rlp.EncodeToBytes(failure)
+	if err != nil {
+		return err
+	}
+	return t.db.Put(TxIngestionFailureDBKey, data)
+}
+
+// Resume clears the failure ingestion halted at and retries the failed element,
+// after the cause of the failure has been addressed.
+func (t *TxIngestion) Resume() error {
+	if t.source == nil {
+		return ErrTxIngestionDisabled
+	}
+	t.statusMu.Lock()
+	defer t.statusMu.Unlock()
+
+	if t.failure == nil {
+		return ErrTxIngestionRunning
+	}
+	if err := t.db.Delete(TxIngestionFailureDBKey); err != nil {
+		return err
+	}
+	log.Info("Resuming transaction ingestion", "submission", t.failure.SubmissionIndex, "element", t.failure.Element)
+	t.failure = nil
+	select {
+	case t.resume <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (t *TxIngestion) halted() bool {
+	t.statusMu.RLock()
+	defer t.statusMu.RUnlock()
+	return t.failure != nil
+}
+
+// Status returns the progress of the TxIngestion.
+func (t *TxIngestion) Status() (*TxIngestionStatus, error) {
+	if t.source == nil {
+		return nil, ErrTxIngestionDisabled
+	}
+	t.statusMu.RLock()
+	defer t.statusMu.RUnlock()
+
+	status := &TxIngestionStatus{
+		State:               TxIngestionIngesting,
+		NextSubmissionIndex: hexutil.Uint64(t.progress.NextSubmissionIndex),
+		NextElement:         hexutil.Uint64(t.progress.NextElement),
+		Failure:             t.failure,
+	}
+	if t.progress.Included {
+		id := hexutil.Uint64(t.progress.LastL1RollupTxId)
+		status.LastL1RollupTxId = &id
+	}
+	if t.failure != nil {
+		status.State = TxIngestionHalted
+	}
+	if t.lastErr != nil {
+		status.LastError = t.lastErr.Error()
+	}
+	return status, nil
+}
+
+// Stop stops the TxIngestion, waits for it to exit and closes its source.
 func (t *TxIngestion) Stop() {
-	t.loopTicker.Stop()
+	t.cancel()
+	<-t.done
+	<-t.tracked
+	if t.source != nil {
+		if err := t.source.Close(); err != nil {
+			log.Error("Cannot close transaction ingestion source", "message", err.Error())
+		}
+	}
 }
 
 // DbConnectionString resolves Postgres config params to a connection string
@@ -0,0 +1,394 @@
+package rollup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	// RawL1ToL2TransactionQueueAbi is the ABI of the event the L1 transaction queue contract
+	// emits for every enqueued L1-to-L2 transaction.
+	RawL1ToL2TransactionQueueAbi = `[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint256","name":"_queueIndex","type":"uint256"},{"indexed":true,"internalType":"address","name":"_sender","type":"address"},{"indexed":false,"internalType":"address","name":"_target","type":"address"},{"indexed":false,"internalType":"uint256","name":"_gasLimit","type":"uint256"},{"indexed":false,"internalType":"bytes","name":"_data","type":"bytes"}],"name":"L1ToL2TransactionEnqueued","type":"event"}]`
+
+	l1ToL2TransactionEnqueuedEvent = "L1ToL2TransactionEnqueued"
+
+	// maxL1LogQueryRange is the maximum number of L1 blocks whose enqueue logs are
+	// queried at once, as L1 nodes limit the size of log queries.
+	maxL1LogQueryRange = 1000
+)
+
+var (
+	l1ToL2TransactionQueueAbi abi.ABI
+
+	ErrL1ReorgBeyondConfirmations = errors.New("L1 reorg deeper than the ingestion confirmation depth")
+
+	// L1IngestionCursorDBKey -> RLP(l1IngestionCursor)
+	L1IngestionCursorDBKey = []byte("rollupL1IngestionCursor")
+)
+
+func init() {
+	var err error
+	l1ToL2TransactionQueueAbi, err = abi.JSON(strings.NewReader(RawL1ToL2TransactionQueueAbi))
+	if err != nil {
+		panic(fmt.Sprintf("Error reading L1ToL2TransactionQueueAbi! Error: %s", err))
+	}
+}
+
+// L1IngestionBackend is the L1 chain access needed by the L1IngestionSource. It is
+// satisfied by *ethclient.Client connected over a transport supporting subscriptions.
+type L1IngestionBackend interface {
+	ethereum.LogFilterer
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// l1IngestionCursor is the L1 position up to which Submissions have been acknowledged.
+type l1IngestionCursor struct {
+	NextBlock           uint64      // First L1 block that may hold unacknowledged Submissions
+	LastBlockHash       common.Hash // Hash of the block before NextBlock, zero if not known
+	NextSubmissionIndex uint64
+	NextQueueIndex      uint64 // Queue index of the first unacknowledged enqueued transaction
+}
+
+// l1Submission is a confirmed Submission along with the L1 block it was enqueued in.
+type l1Submission struct {
+	*Submission
+	blockNumber    uint64
+	blockHash      common.Hash
+	nextQueueIndex uint64 // Queue index following the transactions of the Submission
+}
+
+// L1IngestionSource is an IngestionSource following the enqueue events of the L1
+// transaction queue contract. The transactions enqueued in a single L1 block form a
+// Submission once the block has the configured number of confirmations, so reorgs
+// shallower than that never reach the L2 chain. Its position is persisted in the chain
+// DB when Submissions are acknowledged, and a reorg of an acknowledged Submission halts it.
+type L1IngestionSource struct {
+	db            ethdb.Database
+	backend       L1IngestionBackend
+	queue         common.Address
+	confirmations uint64
+	pollInterval  time.Duration
+
+	// Only accessed by the loop goroutine
+	nextBlock      uint64      // First L1 block that has not been confirmed
+	lastHash       common.Hash // Hash of the block before nextBlock, zero if not known
+	nextIndex      uint64      // Index of the next confirmed Submission
+	nextQueueIndex uint64      // Queue index of the next confirmed enqueued transaction
+
+	mu        sync.Mutex
+	cursor    l1IngestionCursor
+	confirmed []*l1Submission
+	err       error // Set once the source cannot make progress anymore
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewL1IngestionSource creates an L1IngestionSource resuming from the cursor stored in db,
+// or starting at the provided L1 block, and starts following L1.
+func NewL1IngestionSource(db ethdb.Database, backend L1IngestionBackend, queue common.Address, startBlock uint64, confirmations uint64, pollInterval time.Duration) (*L1IngestionSource, error) {
+	cursor := l1IngestionCursor{NextBlock: startBlock}
+	if data, _ := db.Get(L1IngestionCursorDBKey); len(data) > 0 {
+		if err := rlp.DecodeBytes(data, &cursor); err != nil {
+			return nil, fmt.Errorf("invalid L1 ingestion cursor: %v", err)
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &L1IngestionSource{
+		db:             db,
+		backend:        backend,
+		queue:          queue,
+		confirmations:  confirmations,
+		pollInterval:   pollInterval,
+		cursor:         cursor,
+		nextBlock:      cursor.NextBlock,
+		lastHash:       cursor.LastBlockHash,
+		nextIndex:      cursor.NextSubmissionIndex,
+		nextQueueIndex: cursor.NextQueueIndex,
+		ctx:            ctx,
+		cancel:         cancel,
+		done:           make(chan struct{}),
+	}
+	go s.loop()
+	return s, nil
+}
+
+func (s *L1IngestionSource) NextSubmission(ctx context.Context) (*Submission, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return nil, s.err
+	}
+	if len(s.confirmed) == 0 {
+		return nil, nil
+	}
+	return s.confirmed[0].Submission, nil
+}
+
+func (s *L1IngestionSource) Acknowledge(ctx context.Context, submission *Submission) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.confirmed) == 0 || s.confirmed[0].Index != submission.Index {
+		return fmt.Errorf("submission %d is not the next submission", submission.Index)
+	}
+	acknowledged := s.confirmed[0]
+	cursor := l1IngestionCursor{
+		NextBlock:           acknowledged.blockNumber + 1,
+		LastBlockHash:       acknowledged.blockHash,
+		NextSubmissionIndex: acknowledged.Index + 1,
+		NextQueueIndex:      acknowledged.nextQueueIndex,
+	}
+	data, err := rlp.EncodeToBytes(cursor)
+	if err != nil {
+		return err
+	}
+	if err := s.db.Put(L1IngestionCursorDBKey, data); err != nil {
+		return err
+	}
+	s.cursor = cursor
+	s.confirmed = s.confirmed[1:]
+	return nil
+}
+
+// Close stops following L1.
+func (s *L1IngestionSource) Close() error {
+	s.cancel()
+	<-s.done
+	return nil
+}
+
+func (s *L1IngestionSource) loop() {
+	defer close(s.done)
+
+	for !s.failed() {
+		if err := s.follow(); err != nil {
+			log.Warn("L1 ingestion subscription failed", "error", err)
+		}
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(s.pollInterval):
+		}
+	}
+	<-s.ctx.Done()
+}
+
+// follow subscribes to enqueue events and confirms L1 blocks whenever one is emitted or
+// the poll interval elapses, until the subscription fails.
+func (s *L1IngestionSource) follow() error {
+	logs := make(chan types.Log, 128)
+	sub, err := s.backend.SubscribeFilterLogs(s.ctx, s.query(), logs)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.confirm(); err != nil {
+			return err
+		}
+		if s.failed() {
+			return nil
+		}
+		select {
+		case <-logs:
+		case <-ticker.C:
+		case err := <-sub.Err():
+			return err
+		case <-s.ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (s *L1IngestionSource) query() ethereum.FilterQuery {
+	return ethereum.FilterQuery{
+		Addresses: []common.Address{s.queue},
+		Topics:    [][]common.Hash{{l1ToL2TransactionQueueAbi.Events[l1ToL2TransactionEnqueuedEvent].ID()}},
+	}
+}
+
+// confirm turns the enqueue logs of the L1 blocks that gained enough confirmations into
+// Submissions. The logs are only used if their blocks are canonical, otherwise L1 is
+// reorging and confirming is retried later. Confirmed blocks are final, so the source
+// halts if the last of them is reorged out.
+func (s *L1IngestionSource) confirm() error {
+	if s.lastHash != (common.Hash{}) {
+		last, err := s.backend.HeaderByNumber(s.ctx, new(big.Int).SetUint64(s.nextBlock-1))
+		if err != nil {
+			return err
+		}
+		if last.Hash() != s.lastHash {
+			s.fail(ErrL1ReorgBeyondConfirmations)
+			return nil
+		}
+	}
+	head, err := s.backend.HeaderByNumber(s.ctx, nil)
+	if err != nil {
+		return err
+	}
+	if head.Number.Uint64() < s.nextBlock+s.confirmations {
+		return nil
+	}
+	confirmedBlock := head.Number.Uint64() - s.confirmations
+
+	for s.nextBlock <= confirmedBlock {
+		to := confirmedBlock
+		if to-s.nextBlock >= maxL1LogQueryRange {
+			to = s.nextBlock + maxL1LogQueryRange - 1
+		}
+		if ok, err := s.confirmRange(to); !ok || err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// confirmRange confirms the L1 blocks from nextBlock up to the provided one, reporting
+// whether it did.
+func (s *L1IngestionSource) confirmRange(to uint64) (bool, error) {
+	query := s.query()
+	query.FromBlock = new(big.Int).SetUint64(s.nextBlock)
+	query.ToBlock = new(big.Int).SetUint64(to)
+	logs, err := s.backend.FilterLogs(s.ctx, query)
+	if err != nil {
+		return false, err
+	}
+	byBlock := make(map[uint64][]types.Log)
+	for _, l := range logs {
+		if !l.Removed {
+			byBlock[l.BlockNumber] = append(byBlock[l.BlockNumber], l)
+		}
+	}
+	numbers := make([]uint64, 0, len(byBlock))
+	for number := range byBlock {
+		numbers = append(numbers, number)
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+
+	confirmed, err := s.backend.HeaderByNumber(s.ctx, query.ToBlock)
+	if err != nil {
+		return false, err
+	}
+	var (
+		blocks         []*l1Submission
+		nextQueueIndex = s.nextQueueIndex
+	)
+	for _, number := range numbers {
+		logs := byBlock[number]
+		header, err := s.backend.HeaderByNumber(s.ctx, new(big.Int).SetUint64(number))
+		if err != nil {
+			return false, err
+		}
+		for _, l := range logs {
+			if l.BlockHash != header.Hash() {
+				log.Debug("L1 reorg while confirming enqueued transactions", "block", number)
+				return false, nil
+			}
+		}
+		submission, err := newL1Submission(logs, nextQueueIndex)
+		if err != nil {
+			// Enqueued transactions cannot be skipped without breaking the queue order.
+			s.fail(err)
+			return false, nil
+		}
+		nextQueueIndex = submission.nextQueueIndex
+		blocks = append(blocks, submission)
+	}
+
+	s.mu.Lock()
+	for _, block := range blocks {
+		block.Index = s.nextIndex
+		s.nextIndex++
+		s.confirmed = append(s.confirmed, block)
+	}
+	s.mu.Unlock()
+
+	s.nextBlock = to + 1
+	s.lastHash = confirmed.Hash()
+	s.nextQueueIndex = nextQueueIndex
+	return true, nil
+}
+
+func (s *L1IngestionSource) failed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err != nil
+}
+
+func (s *L1IngestionSource) fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err == nil {
+		log.Error("L1 transaction ingestion halted", "error", err)
+		s.err = err
+	}
+}
+
+// newL1Submission creates the Submission of the enqueue logs of a single L1 block, whose
+// first enqueued transaction must have the provided queue index.
+func newL1Submission(logs []types.Log, queueIndex uint64) (*l1Submission, error) {
+	sort.Slice(logs, func(i, j int) bool { return logs[i].Index < logs[j].Index })
+	submission := &l1Submission{
+		Submission:  &Submission{Transactions: make([]*types.Transaction, len(logs))},
+		blockNumber: logs[0].BlockNumber,
+		blockHash:   logs[0].BlockHash,
+	}
+	for i, l := range logs {
+		tx, err := newEnqueuedTransaction(l, queueIndex+uint64(i))
+		if err != nil {
+			return nil, fmt.Errorf("invalid enqueue log %d of L1 tx %s: %v", l.Index, l.TxHash.Hex(), err)
+		}
+		submission.Transactions[i] = tx
+	}
+	submission.nextQueueIndex = queueIndex + uint64(len(logs))
+	return submission, nil
+}
+
+// newEnqueuedTransaction creates the unsigned L1-to-L2 transaction of an enqueue log,
+// which must have the provided queue index.
+func newEnqueuedTransaction(l types.Log, queueIndex uint64) (*types.Transaction, error) {
+	if len(l.Topics) != 3 {
+		return nil, fmt.Errorf("expected 3 topics, got %d", len(l.Topics))
+	}
+	if index := l.Topics[1].Big(); !index.IsUint64() || index.Uint64() != queueIndex {
+		return nil, fmt.Errorf("queue index %v out of order, expected %d", index, queueIndex)
+	}
+	var event struct {
+		Target   common.Address
+		GasLimit *big.Int
+		Data     []byte
+	}
+	if err := l1ToL2TransactionQueueAbi.Unpack(&event, l1ToL2TransactionEnqueuedEvent, l.Data); err != nil {
+		return nil, err
+	}
+	if !event.GasLimit.IsUint64() {
+		return nil, fmt.Errorf("gas limit %v out of range", event.GasLimit)
+	}
+	var (
+		sender = common.BytesToAddress(l.Topics[2].Bytes())
+		l1TxId = hexutil.Uint64(queueIndex)
+	)
+	return types.NewTransaction(0, event.Target, big.NewInt(0), event.GasLimit.Uint64(), big.NewInt(0), event.Data, &sender, &l1TxId, types.QueueOriginL1ToL2, types.SighashEIP155), nil
+}
@@ -0,0 +1,373 @@
+package rollup
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+var testQueueAddr = common.HexToAddress("0x0000000000000000000000000000000000000a11")
+
+// testEnqueue is an L1-to-L2 transaction enqueued in the L1 transaction queue contract.
+type testEnqueue struct {
+	queueIndex int64
+	target     common.Address
+}
+
+// fakeL1IngestionBackend is an L1IngestionBackend over an in-memory L1 chain that can be
+// reorged, delivering enqueue logs to its subscribers like an L1 node would.
+type fakeL1IngestionBackend struct {
+	t *testing.T
+
+	mu      sync.Mutex
+	headers []*types.Header
+	logs    [][]types.Log // Enqueue logs by block number
+	subs    []chan<- types.Log
+	fork    byte
+}
+
+func newFakeL1IngestionBackend(t *testing.T) *fakeL1IngestionBackend {
+	return &fakeL1IngestionBackend{
+		t:       t,
+		headers: []*types.Header{{Number: big.NewInt(0)}},
+		logs:    [][]types.Log{nil},
+	}
+}
+
+// mine adds an L1 block enqueueing the provided transactions.
+func (b *fakeL1IngestionBackend) mine(enqueues ...testEnqueue) *types.Header {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	parent := b.headers[len(b.headers)-1]
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Number:     new(big.Int).Add(parent.Number, common.Big1),
+		Extra:      []byte{b.fork},
+	}
+	event := l1ToL2TransactionQueueAbi.Events[l1ToL2TransactionEnqueuedEvent]
+	logs := make([]types.Log, len(enqueues))
+	for i, enqueue := range enqueues {
+		data, err := event.Inputs.NonIndexed().Pack(enqueue.target, big.NewInt(1_000_000), []byte{byte(i)})
+		if err != nil {
+			b.t.Fatalf("unable to pack enqueue event: %v", err)
+		}
+		logs[i] = types.Log{
+			Address:     testQueueAddr,
+			Topics:      []common.Hash{event.ID(), common.BigToHash(big.NewInt(enqueue.queueIndex)), testUserAddress.Hash()},
+			Data:        data,
+			BlockNumber: header.Number.Uint64(),
+			BlockHash:   header.Hash(),
+			Index:       uint(i),
+		}
+	}
+	b.headers = append(b.headers, header)
+	b.logs = append(b.logs, logs)
+	b.send(logs)
+	return header
+}
+
+// reorg removes the blocks from the provided number on, removing their logs.
+func (b *fakeL1IngestionBackend) reorg(number uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.fork++
+	for _, logs := range b.logs[number:] {
+		removed := make([]types.Log, len(logs))
+		for i, l := range logs {
+			l.Removed = true
+			removed[i] = l
+		}
+		b.send(removed)
+	}
+	b.headers = b.headers[:number]
+	b.logs = b.logs[:number]
+}
+
+func (b *fakeL1IngestionBackend) send(logs []types.Log) {
+	for _, sub := range b.subs {
+		for _, l := range logs {
+			sub <- l
+		}
+	}
+}
+
+func (b *fakeL1IngestionBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if number == nil {
+		return b.headers[len(b.headers)-1], nil
+	}
+	if number.Uint64() >= uint64(len(b.headers)) {
+		return nil, ethereum.NotFound
+	}
+	return b.headers[number.Uint64()], nil
+}
+
+func (b *fakeL1IngestionBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if span := query.ToBlock.Uint64() - query.FromBlock.Uint64(); span >= maxL1LogQueryRange {
+		b.t.Errorf("log query of %d blocks exceeds the maximum range of %d", span+1, maxL1LogQueryRange)
+	}
+	var logs []types.Log
+	for number := query.FromBlock.Uint64(); number < uint64(len(b.logs)) && number <= query.ToBlock.Uint64(); number++ {
+		logs = append(logs, b.logs[number]...)
+	}
+	return logs, nil
+}
+
+func (b *fakeL1IngestionBackend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.subs = append(b.subs, ch)
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		for i, sub := range b.subs {
+			if sub == ch {
+				b.subs = append(b.subs[:i], b.subs[i+1:]...)
+				break
+			}
+		}
+		return nil
+	}), nil
+}
+
+func startTestL1IngestionSource(t *testing.T, db ethdb.Database, backend *fakeL1IngestionBackend, confirmations uint64) *L1IngestionSource {
+	source, err := NewL1IngestionSource(db, backend, testQueueAddr, 1, confirmations, time.Millisecond)
+	if err != nil {
+		t.Fatalf("unable to create L1 ingestion source: %v", err)
+	}
+	return source
+}
+
+// waitForSubmission waits for the next Submission of the source.
+func waitForSubmission(t *testing.T, source IngestionSource) *Submission {
+	var submission *Submission
+	waitFor(t, time.Second, func() (bool, string) {
+		var err error
+		if submission, err = source.NextSubmission(context.Background()); err != nil {
+			t.Fatalf("unable to get next submission: %v", err)
+		}
+		return submission != nil, "submission"
+	})
+	return submission
+}
+
+// assertNoSubmission checks that the source does not provide a Submission for a while.
+func assertNoSubmission(t *testing.T, source IngestionSource) {
+	time.Sleep(50 * time.Millisecond)
+	if submission, err := source.NextSubmission(context.Background()); submission != nil || err != nil {
+		t.Fatalf("expected no submission, got %v, %v", submission, err)
+	}
+}
+
+func assertSubmission(t *testing.T, submission *Submission, index uint64, enqueues ...testEnqueue) {
+	if submission.Index != index {
+		t.Fatalf("expected submission %d, got %d", index, submission.Index)
+	}
+	if len(submission.Transactions) != len(enqueues) {
+		t.Fatalf("expected %d transactions, got %d", len(enqueues), len(submission.Transactions))
+	}
+	for i, tx := range submission.Transactions {
+		if *tx.To() != enqueues[i].target || tx.Gas() != 1_000_000 || tx.Data()[0] != byte(i) {
+			t.Fatalf("transaction %d: unexpected transaction to %s with gas %d and data %x", i, tx.To().Hex(), tx.Gas(), tx.Data())
+		}
+		if *tx.L1MessageSender() != testUserAddress || uint64(*tx.L1RollupTxId()) != uint64(enqueues[i].queueIndex) {
+			t.Fatalf("transaction %d: unexpected sender %s and L1 tx id %d", i, tx.L1MessageSender().Hex(), *tx.L1RollupTxId())
+		}
+		if tx.QueueOrigin().Int64() != int64(types.QueueOriginL1ToL2) {
+			t.Fatalf("transaction %d: unexpected queue origin %v", i, tx.QueueOrigin())
+		}
+	}
+}
+
+func TestL1IngestionSourceConfirmations(t *testing.T) {
+	backend := newFakeL1IngestionBackend(t)
+	source := startTestL1IngestionSource(t, rawdb.NewMemoryDatabase(), backend, 2)
+	defer source.Close()
+
+	enqueues := []testEnqueue{{0, common.HexToAddress("0x01")}, {1, common.HexToAddress("0x02")}}
+	backend.mine(enqueues...)
+	backend.mine()
+	assertNoSubmission(t, source)
+
+	backend.mine()
+	assertSubmission(t, waitForSubmission(t, source), 0, enqueues...)
+}
+
+func TestL1IngestionSourceReorg(t *testing.T) {
+	backend := newFakeL1IngestionBackend(t)
+	source := startTestL1IngestionSource(t, rawdb.NewMemoryDatabase(), backend, 1)
+	defer source.Close()
+
+	backend.mine(testEnqueue{0, common.HexToAddress("0x01")})
+	time.Sleep(10 * time.Millisecond)
+	backend.reorg(1)
+	canonical := testEnqueue{0, common.HexToAddress("0x02")}
+	backend.mine(canonical)
+	backend.mine()
+
+	submission := waitForSubmission(t, source)
+	assertSubmission(t, submission, 0, canonical)
+	if err := source.Acknowledge(context.Background(), submission); err != nil {
+		t.Fatalf("unable to acknowledge submission: %v", err)
+	}
+	assertNoSubmission(t, source)
+}
+
+func TestL1IngestionSourceResume(t *testing.T) {
+	var (
+		db       = rawdb.NewMemoryDatabase()
+		backend  = newFakeL1IngestionBackend(t)
+		enqueues = []testEnqueue{{0, common.HexToAddress("0x01")}, {1, common.HexToAddress("0x02")}, {2, common.HexToAddress("0x03")}}
+	)
+	backend.mine(enqueues[0])
+	backend.mine()
+	backend.mine(enqueues[1])
+
+	source := startTestL1IngestionSource(t, db, backend, 0)
+	submission := waitForSubmission(t, source)
+	assertSubmission(t, submission, 0, enqueues[0])
+	if err := source.Acknowledge(context.Background(), submission); err != nil {
+		t.Fatalf("unable to acknowledge submission: %v", err)
+	}
+	assertSubmission(t, waitForSubmission(t, source), 1, enqueues[1])
+	source.Close()
+
+	// The unacknowledged submission must be provided again after a restart.
+	source = startTestL1IngestionSource(t, db, backend, 0)
+	defer source.Close()
+	submission = waitForSubmission(t, source)
+	assertSubmission(t, submission, 1, enqueues[1])
+	if err := source.Acknowledge(context.Background(), submission); err != nil {
+		t.Fatalf("unable to acknowledge submission: %v", err)
+	}
+	backend.mine(enqueues[2])
+	assertSubmission(t, waitForSubmission(t, source), 2, enqueues[2])
+}
+
+// waitForHalt waits for the source to halt with ErrL1ReorgBeyondConfirmations.
+func waitForHalt(t *testing.T, source IngestionSource) {
+	if err := waitForSourceError(t, source); err != ErrL1ReorgBeyondConfirmations {
+		t.Fatalf("expected %v, got %v", ErrL1ReorgBeyondConfirmations, err)
+	}
+}
+
+// waitForSourceError waits for the source to halt, returning the error it halted with.
+func waitForSourceError(t *testing.T, source IngestionSource) error {
+	var err error
+	waitFor(t, time.Second, func() (bool, string) {
+		_, err = source.NextSubmission(context.Background())
+		return err != nil, "source to halt"
+	})
+	return err
+}
+
+func TestL1IngestionSourceDeepReorg(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	backend := newFakeL1IngestionBackend(t)
+	backend.mine(testEnqueue{0, common.HexToAddress("0x01")})
+
+	source := startTestL1IngestionSource(t, db, backend, 0)
+	submission := waitForSubmission(t, source)
+	if err := source.Acknowledge(context.Background(), submission); err != nil {
+		t.Fatalf("unable to acknowledge submission: %v", err)
+	}
+
+	// Reorging the acknowledged block out must halt the source, also after a restart.
+	backend.reorg(1)
+	backend.mine(testEnqueue{0, common.HexToAddress("0x02")})
+	waitForHalt(t, source)
+	source.Close()
+
+	source = startTestL1IngestionSource(t, db, backend, 0)
+	defer source.Close()
+	waitForHalt(t, source)
+}
+
+// Tests that enqueue logs are queried in bounded ranges, and that Submissions spread
+// over several of them are confirmed in order.
+func TestL1IngestionSourceLogQueryRange(t *testing.T) {
+	backend := newFakeL1IngestionBackend(t)
+	enqueues := []testEnqueue{{0, common.HexToAddress("0x01")}, {1, common.HexToAddress("0x02")}}
+	backend.mine(enqueues[0])
+	for i := 0; i < 2*maxL1LogQueryRange; i++ {
+		backend.mine()
+	}
+	backend.mine(enqueues[1])
+
+	source := startTestL1IngestionSource(t, rawdb.NewMemoryDatabase(), backend, 0)
+	defer source.Close()
+
+	submission := waitForSubmission(t, source)
+	assertSubmission(t, submission, 0, enqueues[0])
+	if err := source.Acknowledge(context.Background(), submission); err != nil {
+		t.Fatalf("unable to acknowledge submission: %v", err)
+	}
+	assertSubmission(t, waitForSubmission(t, source), 1, enqueues[1])
+}
+
+// Tests that the source halts on enqueued transactions out of queue order instead of
+// skipping or repeating any.
+func TestL1IngestionSourceQueueOrder(t *testing.T) {
+	tests := [][]testEnqueue{
+		{{1, common.HexToAddress("0x01")}},
+		{{0, common.HexToAddress("0x01")}, {2, common.HexToAddress("0x02")}},
+		{{0, common.HexToAddress("0x01")}, {0, common.HexToAddress("0x02")}},
+	}
+	for i, enqueues := range tests {
+		backend := newFakeL1IngestionBackend(t)
+		for _, enqueue := range enqueues {
+			backend.mine(enqueue)
+		}
+		source := startTestL1IngestionSource(t, rawdb.NewMemoryDatabase(), backend, 0)
+		if err := waitForSourceError(t, source); !strings.Contains(err.Error(), "out of order") {
+			t.Errorf("test %d: expected queue order error, got %v", i, err)
+		}
+		source.Close()
+	}
+}
+
+func TestNewEnqueuedTransactionQueueIndex(t *testing.T) {
+	event := l1ToL2TransactionQueueAbi.Events[l1ToL2TransactionEnqueuedEvent]
+	data, err := event.Inputs.NonIndexed().Pack(common.HexToAddress("0x01"), big.NewInt(1_000_000), []byte{})
+	if err != nil {
+		t.Fatalf("unable to pack enqueue event: %v", err)
+	}
+	tests := []struct {
+		index    *big.Int
+		expected uint64
+		valid    bool
+	}{
+		{big.NewInt(5), 5, true},
+		{big.NewInt(6), 5, false},
+		{new(big.Int).Lsh(common.Big1, 64), 0, false},
+	}
+	for i, tt := range tests {
+		l := types.Log{Topics: []common.Hash{event.ID(), common.BigToHash(tt.index), testUserAddress.Hash()}, Data: data}
+		tx, err := newEnqueuedTransaction(l, tt.expected)
+		if (err == nil) != tt.valid {
+			t.Fatalf("test %d: expected valid %v, got error %v", i, tt.valid, err)
+		}
+		if err == nil && uint64(*tx.L1RollupTxId()) != tt.expected {
+			t.Errorf("test %d: L1 tx id mismatch: have %d, want %d", i, *tx.L1RollupTxId(), tt.expected)
+		}
+	}
+}
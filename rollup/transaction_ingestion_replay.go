@@ -0,0 +1,143 @@
+package rollup
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ReplaySubmission is the JSON representation of a Submission in a replay file, which
+// holds one ReplaySubmission per line.
+type ReplaySubmission struct {
+	Index        hexutil.Uint64      `json:"index"`
+	Transactions []ReplayTransaction `json:"transactions"`
+}
+
+// ReplayTransaction is the JSON representation of an unsigned L1-to-L2 transaction.
+type ReplayTransaction struct {
+	Target          common.Address    `json:"target"`
+	Calldata        hexutil.Bytes     `json:"calldata"`
+	GasLimit        hexutil.Uint64    `json:"gasLimit"`
+	L1MessageSender common.Address    `json:"l1MessageSender"`
+	L1RollupTxId    hexutil.Uint64    `json:"l1RollupTxId"`
+	QueueOrigin     types.QueueOrigin `json:"queueOrigin"`
+}
+
+// NewReplaySubmission creates the ReplaySubmission of a Submission, to record it for
+// replaying it later.
+func NewReplaySubmission(submission *Submission) *ReplaySubmission {
+	replay := &ReplaySubmission{
+		Index:        hexutil.Uint64(submission.Index),
+		Transactions: make([]ReplayTransaction, len(submission.Transactions)),
+	}
+	for i, tx := range submission.Transactions {
+		replayTx := ReplayTransaction{
+			Calldata: tx.Data(),
+			GasLimit: hexutil.Uint64(tx.Gas()),
+		}
+		if to := tx.To(); to != nil {
+			replayTx.Target = *to
+		}
+		if sender := tx.L1MessageSender(); sender != nil {
+			replayTx.L1MessageSender = *sender
+		}
+		if id := tx.L1RollupTxId(); id != nil {
+			replayTx.L1RollupTxId = *id
+		}
+		if origin := tx.QueueOrigin(); origin != nil {
+			replayTx.QueueOrigin = types.QueueOrigin(origin.Int64())
+		}
+		replay.Transactions[i] = replayTx
+	}
+	return replay
+}
+
+func (r *ReplaySubmission) submission() *Submission {
+	submission := &Submission{
+		Index:        uint64(r.Index),
+		Transactions: make([]*types.Transaction, len(r.Transactions)),
+	}
+	for i, tx := range r.Transactions {
+		var (
+			sender = tx.L1MessageSender
+			id     = tx.L1RollupTxId
+		)
+		submission.Transactions[i] = types.NewTransaction(0, tx.Target, big.NewInt(0), uint64(tx.GasLimit), big.NewInt(0), tx.Calldata, &sender, &id, tx.QueueOrigin, types.SighashEIP155)
+	}
+	return submission
+}
+
+// ReplayIngestionSource is an IngestionSource replaying the Submissions of a JSONL file,
+// for deterministic local testing and replaying incidents without a database or L1.
+// Its position is only kept in memory, so every run replays the file from the start.
+type ReplayIngestionSource struct {
+	mu          sync.Mutex
+	submissions []*ReplaySubmission
+}
+
+// NewReplayIngestionSource loads the ReplaySubmissions of the file at path, which must
+// have ascending indices.
+func NewReplayIngestionSource(path string) (*ReplayIngestionSource, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var (
+		source  = new(ReplayIngestionSource)
+		scanner = bufio.NewScanner(file)
+	)
+	scanner.Buffer(nil, 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		submission := new(ReplaySubmission)
+		if err := json.Unmarshal(scanner.Bytes(), submission); err != nil {
+			return nil, fmt.Errorf("invalid submission on line %d of %s: %v", line, path, err)
+		}
+		if n := len(source.submissions); n > 0 && submission.Index <= source.submissions[n-1].Index {
+			return nil, fmt.Errorf("submission %d on line %d of %s is out of order", submission.Index, line, path)
+		}
+		source.submissions = append(source.submissions, submission)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return source, nil
+}
+
+func (s *ReplayIngestionSource) NextSubmission(ctx context.Context) (*Submission, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.submissions) == 0 {
+		return nil, nil
+	}
+	return s.submissions[0].submission(), nil
+}
+
+func (s *ReplayIngestionSource) Acknowledge(ctx context.Context, submission *Submission) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.submissions) == 0 || uint64(s.submissions[0].Index) != submission.Index {
+		return fmt.Errorf("submission %d is not the next submission", submission.Index)
+	}
+	s.submissions = s.submissions[1:]
+	return nil
+}
+
+func (s *ReplayIngestionSource) Close() error {
+	return nil
+}
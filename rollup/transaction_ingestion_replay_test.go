@@ -0,0 +1,173 @@
+package rollup
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// newTestReplaySubmission creates a ReplaySubmission of L1-to-L2 transactions calling
+// the provided targets.
+func newTestReplaySubmission(index uint64, targets ...common.Address) *ReplaySubmission {
+	submission := &ReplaySubmission{Index: hexutil.Uint64(index)}
+	for i, target := range targets {
+		submission.Transactions = append(submission.Transactions, ReplayTransaction{
+			Target:          target,
+			Calldata:        []byte{byte(i)},
+			GasLimit:        100_000,
+			L1MessageSender: testUserAddress,
+			L1RollupTxId:    hexutil.Uint64(index*10 + uint64(i)),
+			QueueOrigin:     types.QueueOriginL1ToL2,
+		})
+	}
+	return submission
+}
+
+// writeReplayFile writes the lines to a replay file, returning its path.
+func writeReplayFile(t *testing.T, lines ...string) string {
+	file, err := ioutil.TempFile("", "submissions-*.jsonl")
+	if err != nil {
+		t.Fatalf("unable to create replay file: %v", err)
+	}
+	defer file.Close()
+	if _, err := file.WriteString(strings.Join(lines, "\n")); err != nil {
+		t.Fatalf("unable to write replay file: %v", err)
+	}
+	return file.Name()
+}
+
+func encodeReplaySubmissions(t *testing.T, submissions ...*ReplaySubmission) []string {
+	lines := make([]string, len(submissions))
+	for i, submission := range submissions {
+		data, err := json.Marshal(submission)
+		if err != nil {
+			t.Fatalf("unable to encode submission: %v", err)
+		}
+		lines[i] = string(data)
+	}
+	return lines
+}
+
+func TestReplayIngestionSource(t *testing.T) {
+	submissions := []*ReplaySubmission{
+		newTestReplaySubmission(0, common.HexToAddress("0x01"), common.HexToAddress("0x02")),
+		newTestReplaySubmission(3, common.HexToAddress("0x03")),
+	}
+	path := writeReplayFile(t, append(encodeReplaySubmissions(t, submissions...), "")...)
+	defer os.Remove(path)
+
+	source, err := NewReplayIngestionSource(path)
+	if err != nil {
+		t.Fatalf("unable to load replay file: %v", err)
+	}
+	defer source.Close()
+
+	for _, expected := range submissions {
+		submission := waitForSubmission(t, source)
+		if submission.Index != uint64(expected.Index) {
+			t.Fatalf("expected submission %d, got %d", expected.Index, submission.Index)
+		}
+		// A Submission must survive recording and replaying it.
+		if replayed := NewReplaySubmission(submission); !equalJSON(t, replayed, expected) {
+			t.Fatalf("submission %d does not round trip", expected.Index)
+		}
+		if err := source.Acknowledge(context.Background(), &Submission{Index: uint64(expected.Index) + 1}); err == nil {
+			t.Fatalf("expected acknowledging a future submission to fail")
+		}
+		if err := source.Acknowledge(context.Background(), submission); err != nil {
+			t.Fatalf("unable to acknowledge submission: %v", err)
+		}
+	}
+	if submission, err := source.NextSubmission(context.Background()); submission != nil || err != nil {
+		t.Fatalf("expected replay to be done, got %v, %v", submission, err)
+	}
+}
+
+func equalJSON(t *testing.T, a, b interface{}) bool {
+	encodedA, err := json.Marshal(a)
+	if err != nil {
+		t.Fatalf("unable to encode %v: %v", a, err)
+	}
+	encodedB, err := json.Marshal(b)
+	if err != nil {
+		t.Fatalf("unable to encode %v: %v", b, err)
+	}
+	return string(encodedA) == string(encodedB)
+}
+
+func TestReplayIngestionSourceInvalidFile(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		err   string
+	}{
+		{"invalid json", []string{`{"index":`}, "line 1"},
+		{"out of order", encodeReplaySubmissions(t, newTestReplaySubmission(1), newTestReplaySubmission(1)), "out of order"},
+	}
+	for _, tt := range tests {
+		path := writeReplayFile(t, tt.lines...)
+		_, err := NewReplayIngestionSource(path)
+		os.Remove(path)
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("%s: expected error containing %q, got %v", tt.name, tt.err, err)
+		}
+	}
+}
+
+func TestTxIngestionReplay(t *testing.T) {
+	submissions := []*ReplaySubmission{
+		newTestReplaySubmission(0, common.HexToAddress("0x01"), common.HexToAddress("0x02")),
+		newTestReplaySubmission(1, common.HexToAddress("0x03")),
+	}
+	path := writeReplayFile(t, encodeReplaySubmissions(t, submissions...)...)
+	defer os.Remove(path)
+
+	cfg := Config{TxIngestionEnable: true, TxIngestionPollInterval: time.Millisecond, TxIngestionReplayFile: path, TxIngestionSignerKey: key}
+	source, err := NewIngestionSource(&cfg, rawdb.NewMemoryDatabase())
+	if err != nil {
+		t.Fatalf("unable to create ingestion source: %v", err)
+	}
+
+	db := rawdb.NewMemoryDatabase()
+	chain, txPool, stop := newTestTxPool(t, db)
+	defer stop()
+
+	txIngestion, err := NewTxIngestion(cfg, params.AllEthashProtocolChanges, chain, txPool, db, source)
+	if err != nil {
+		t.Fatalf("unable to create transaction ingestion: %v", err)
+	}
+	defer txIngestion.Stop()
+
+	// Submission 1 is applied once submission 0 is included.
+	var txs types.Transactions
+	for _, count := range []int{2, 1} {
+		waitForPendingTxs(t, txPool, count)
+		pending, err := txPool.Pending()
+		if err != nil {
+			t.Fatalf("unable to get pending transactions: %v", err)
+		}
+		if len(pending[addr]) != count {
+			t.Fatalf("expected %d transactions from the ingestion signer, got %d", count, len(pending[addr]))
+		}
+		txs = append(txs, pending[addr]...)
+		mineTestBlock(t, chain, db, txPool)
+	}
+	for i, target := range []common.Address{common.HexToAddress("0x01"), common.HexToAddress("0x02"), common.HexToAddress("0x03")} {
+		if *txs[i].To() != target || txs[i].Nonce() != uint64(i) {
+			t.Fatalf("transaction %d: expected call to %s with nonce %d, got %s with nonce %d", i, target.Hex(), i, txs[i].To().Hex(), txs[i].Nonce())
+		}
+		if txs[i].QueueOrigin().Int64() != int64(types.QueueOriginL1ToL2) {
+			t.Fatalf("transaction %d: unexpected queue origin %v", i, txs[i].QueueOrigin())
+		}
+	}
+}
@@ -1,15 +1,19 @@
 package rollup
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
 )
 
 const (
@@ -19,7 +23,7 @@ FROM geth_submission_queue`
 
 	SQLGetNextQueuedGethSubmission = `
 SELECT
-geth_submission_queue_index, target, calldata, block_timestamp, block_number, l1_tx_hash, l1_tx_index,
+id, geth_submission_queue_index, target, calldata, block_timestamp, block_number, l1_tx_hash, l1_tx_index,
 l1_tx_log_index, queue_origin, sender, l1_message_sender, gas_limit, nonce, signature
 FROM next_queued_geth_submission ORDER BY index_within_submission ASC`
 
@@ -29,6 +33,87 @@ SET status = $1
 WHERE queue_index = $2`
 )
 
+// SQLIngestionSource is an IngestionSource reading the Submissions indexed into Postgres
+// by an external L1 indexer.
+type SQLIngestionSource struct {
+	db *sqlx.DB
+}
+
+// NewSQLIngestionSource connects to the Postgres database configured by cfg.
+func NewSQLIngestionSource(cfg *Config) (*SQLIngestionSource, error) {
+	log.Info("Transaction ingestion connecting to database", "host", cfg.TxIngestionDBHost, "port", cfg.TxIngestionDBPort)
+
+	db, err := DBConnectWithRetry(context.Background(), cfg)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("TxIngestion connected to database")
+	return &SQLIngestionSource{db: db}, nil
+}
+
+func (s *SQLIngestionSource) NextSubmission(ctx context.Context) (*Submission, error) {
+	txs, index, err := GetMostRecentQueuedTransactions(s.db)
+	if err != nil || len(txs) == 0 {
+		return nil, err
+	}
+	return &Submission{Index: uint64(index), Transactions: txs}, nil
+}
+
+func (s *SQLIngestionSource) Acknowledge(ctx context.Context, submission *Submission) error {
+	return UpdateSentSubmissionStatus(s.db, "Sent", uint32(submission.Index))
+}
+
+func (s *SQLIngestionSource) Close() error {
+	return s.db.Close()
+}
+
+func DBConnectWithRetry(ctx context.Context, cfg *Config) (*sqlx.DB, error) {
+	connErrCh := make(chan error, 1)
+	defer close(connErrCh)
+
+	var db *sqlx.DB
+	var err error
+
+	go func() {
+		try := 0
+		for {
+			try++
+			db, err = DBConnect(cfg)
+			if err != nil {
+				log.Error("Cannot connect to postgres", "msg", err.Error(), "try", try)
+				select {
+				case <-ctx.Done():
+					break
+				case <-time.After(time.Second):
+					continue
+				}
+			}
+			break
+		}
+		connErrCh <- err
+	}()
+
+	select {
+	case err = <-connErrCh:
+		break
+	case <-time.After(time.Minute * 3):
+		return nil, errors.New("db connection timed out")
+	case <-ctx.Done():
+		return nil, errors.New("db connection cancelled")
+	}
+
+	return db, err
+}
+
+func DBConnect(cfg *Config) (*sqlx.DB, error) {
+	conn := DbConnectionString(cfg)
+	db, err := sqlx.Connect("postgres", conn)
+	if err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
 type QueuedTransaction struct {
 	Id                       uint64         `db:"id"`
 	GethSubmissionQueueIndex uint32         `db:"geth_submission_queue_index"`
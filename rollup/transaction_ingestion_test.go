@@ -1,15 +1,25 @@
 package rollup
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"math/big"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -35,7 +45,10 @@ func TestApplyTransaction(t *testing.T) {
 	chaincfg := params.ChainConfig{ChainID: chainId}
 
 	txPool := core.NewTxPool(core.TxPoolConfig{}, &chaincfg, chain)
-	txIngestion := NewTxIngestion(cfg, &chaincfg, txPool)
+	txIngestion, err := NewTxIngestion(cfg, &chaincfg, chain, txPool, db, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	signer := types.NewOVMSigner(chainId)
 	tx, err := types.SignTx(types.NewTransaction(0, addr, new(big.Int), 21000, new(big.Int), []byte{}, &addr, nil, types.QueueOriginL1ToL2, types.SighashEIP155), signer, key)
@@ -53,3 +66,414 @@ func TestApplyTransaction(t *testing.T) {
 		t.Fatal("Transaction not found in pool")
 	}
 }
+
+// newTestTxPool creates a transaction pool on top of an empty chain stored in db,
+// returning both along with a function stopping them.
+func newTestTxPool(t *testing.T, db ethdb.Database) (*core.BlockChain, *core.TxPool, func()) {
+	genesis := &core.Genesis{Config: params.AllEthashProtocolChanges, GasLimit: params.GenesisGasLimit}
+	genesis.MustCommit(db)
+	chain, err := core.NewBlockChain(db, nil, genesis.Config, ethash.NewFaker(), vm.Config{}, nil)
+	if err != nil {
+		t.Fatalf("unable to create blockchain: %v", err)
+	}
+	poolConfig := core.DefaultTxPoolConfig
+	poolConfig.Journal = ""
+	txPool := core.NewTxPool(poolConfig, genesis.Config, chain)
+	return chain, txPool, func() {
+		txPool.Stop()
+		chain.Stop()
+	}
+}
+
+// mineTestBlock inserts a block including the pending transactions of the pool into
+// the chain, returning the number of transactions included.
+func mineTestBlock(t *testing.T, chain *core.BlockChain, db ethdb.Database, txPool *core.TxPool) int {
+	pending, err := txPool.Pending()
+	if err != nil {
+		t.Fatalf("unable to get pending transactions: %v", err)
+	}
+	included := 0
+	blocks, _ := core.GenerateChain(chain.Config(), chain.CurrentBlock(), ethash.NewFaker(), db, 1, func(i int, gen *core.BlockGen) {
+		for _, txs := range pending {
+			for _, tx := range txs {
+				gen.AddTx(tx)
+				included++
+			}
+		}
+	})
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("unable to insert block: %v", err)
+	}
+	return included
+}
+
+// ingestedL1RollupTxIds returns the L1 tx ids of the transactions in the chain, in
+// order of inclusion.
+func ingestedL1RollupTxIds(chain *core.BlockChain, db ethdb.Database) []uint64 {
+	var ids []uint64
+	for n := uint64(1); n <= chain.CurrentBlock().NumberU64(); n++ {
+		for _, tx := range chain.GetBlockByNumber(n).Transactions() {
+			if meta := rawdb.ReadTransactionMeta(db, tx.Hash()); meta != nil && meta.L1RollupTxId != nil {
+				ids = append(ids, uint64(*meta.L1RollupTxId))
+			}
+		}
+	}
+	return ids
+}
+
+// testIngestionSource is an IngestionSource providing fixed Submissions, failing the
+// configured number of acknowledgements.
+type testIngestionSource struct {
+	mu          sync.Mutex
+	submissions []*Submission
+	ackFailures int
+	attempts    int
+}
+
+func (s *testIngestionSource) NextSubmission(ctx context.Context) (*Submission, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.submissions) == 0 {
+		return nil, nil
+	}
+	return s.submissions[0], nil
+}
+
+func (s *testIngestionSource) Acknowledge(ctx context.Context, submission *Submission) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.attempts++
+	if s.attempts <= s.ackFailures {
+		return errors.New("acknowledgement failed")
+	}
+	s.submissions = s.submissions[1:]
+	return nil
+}
+
+func (s *testIngestionSource) Close() error {
+	return nil
+}
+
+// newTestSubmission creates a Submission of L1-to-L2 transactions with the provided
+// gas limits, with L1 tx ids counting from the submission index times ten.
+func newTestSubmission(index uint64, gasLimits ...uint64) *Submission {
+	submission := &Submission{Index: index}
+	for i, gas := range gasLimits {
+		id := hexutil.Uint64(index*10 + uint64(i))
+		submission.Transactions = append(submission.Transactions, types.NewTransaction(0, common.HexToAddress("0x01"), big.NewInt(0), gas, big.NewInt(0), nil, &testUserAddress, &id, types.QueueOriginL1ToL2, types.SighashEIP155))
+	}
+	return submission
+}
+
+func startTestTxIngestion(t *testing.T, db ethdb.Database, chain *core.BlockChain, txPool *core.TxPool, source IngestionSource) *TxIngestion {
+	cfg := Config{TxIngestionPollInterval: time.Millisecond, TxIngestionSignerKey: key}
+	txIngestion, err := NewTxIngestion(cfg, params.AllEthashProtocolChanges, chain, txPool, db, source)
+	if err != nil {
+		t.Fatalf("unable to create transaction ingestion: %v", err)
+	}
+	return txIngestion
+}
+
+func waitForTxIngestionStatus(t *testing.T, txIngestion *TxIngestion, done func(status *TxIngestionStatus) bool) *TxIngestionStatus {
+	var status *TxIngestionStatus
+	waitFor(t, time.Second, func() (bool, string) {
+		var err error
+		if status, err = txIngestion.Status(); err != nil {
+			t.Fatalf("unable to get status: %v", err)
+		}
+		return done(status), fmt.Sprintf("transaction ingestion, status: %+v", status)
+	})
+	return status
+}
+
+// waitForPendingTxs waits for the pool to hold the provided number of pending
+// transactions.
+func waitForPendingTxs(t *testing.T, txPool *core.TxPool, count int) {
+	waitFor(t, time.Second, func() (bool, string) {
+		pending, queued := txPool.Stats()
+		return pending == count, fmt.Sprintf("%d pending transactions, got %d pending and %d queued", count, pending, queued)
+	})
+}
+
+func TestTxIngestionAcknowledgementRetry(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	chain, txPool, stop := newTestTxPool(t, db)
+	defer stop()
+
+	// Submissions must not be applied again while their acknowledgement is retried.
+	source := &testIngestionSource{submissions: []*Submission{newTestSubmission(0, 50_000, 50_000), newTestSubmission(1, 50_000)}, ackFailures: 3}
+	txIngestion := startTestTxIngestion(t, db, chain, txPool, source)
+	defer txIngestion.Stop()
+
+	waitForPendingTxs(t, txPool, 2)
+	if source.attempts != 0 {
+		t.Fatalf("expected submission 0 not to be acknowledged before its inclusion")
+	}
+	mineTestBlock(t, chain, db, txPool)
+	waitForTxIngestionStatus(t, txIngestion, func(status *TxIngestionStatus) bool { return status.NextSubmissionIndex == 1 })
+
+	waitForPendingTxs(t, txPool, 1)
+	mineTestBlock(t, chain, db, txPool)
+	status := waitForTxIngestionStatus(t, txIngestion, func(status *TxIngestionStatus) bool { return status.NextSubmissionIndex == 2 })
+
+	if ids := ingestedL1RollupTxIds(chain, db); !reflect.DeepEqual(ids, []uint64{0, 1, 10}) {
+		t.Fatalf("expected L1 txs [0 1 10] to be included, got %v", ids)
+	}
+	if status.LastL1RollupTxId == nil || *status.LastL1RollupTxId != 10 {
+		t.Fatalf("expected last L1 tx id 10, got %v", status.LastL1RollupTxId)
+	}
+	if source.attempts != 5 {
+		t.Fatalf("expected 5 acknowledgement attempts, got %d", source.attempts)
+	}
+}
+
+func TestTxIngestionResume(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	chain, txPool, stop := newTestTxPool(t, db)
+	defer stop()
+
+	// Resume after L1 tx 20 was included and L1 tx 30 was added to the pool, as after a
+	// restart following its application.
+	signer := types.NewOVMSigner(params.AllEthashProtocolChanges.ChainID)
+	for nonce, tx := range []*types.Transaction{newTestSubmission(2, 50_000).Transactions[0], newTestSubmission(3, 50_000).Transactions[0]} {
+		tx.SetNonce(uint64(nonce))
+		signed, _ := types.SignTx(tx, signer, key)
+		if err := txPool.AddLocal(signed); err != nil {
+			t.Fatalf("unable to add transaction: %v", err)
+		}
+		if nonce == 0 {
+			mineTestBlock(t, chain, db, txPool)
+		}
+	}
+	source := &testIngestionSource{submissions: []*Submission{newTestSubmission(2, 50_000), newTestSubmission(3, 50_000, 50_000)}}
+	txIngestion := startTestTxIngestion(t, db, chain, txPool, source)
+	defer txIngestion.Stop()
+
+	status := waitForTxIngestionStatus(t, txIngestion, func(status *TxIngestionStatus) bool { return status.NextSubmissionIndex == 3 })
+	waitForPendingTxs(t, txPool, 2)
+	pending, _ := txPool.Pending()
+	if txs := pending[addr]; len(txs) != 2 || *txs[0].L1RollupTxId() != 30 || *txs[1].L1RollupTxId() != 31 {
+		t.Fatalf("expected only L1 tx 31 to be applied, got %v", txs)
+	}
+	if status.NextElement != 0 || *status.LastL1RollupTxId != 20 {
+		t.Fatalf("expected to wait for the inclusion of L1 tx 30, got %+v", status)
+	}
+
+	mineTestBlock(t, chain, db, txPool)
+	status = waitForTxIngestionStatus(t, txIngestion, func(status *TxIngestionStatus) bool { return status.NextSubmissionIndex == 4 })
+	if ids := ingestedL1RollupTxIds(chain, db); !reflect.DeepEqual(ids, []uint64{20, 30, 31}) {
+		t.Fatalf("expected L1 txs [20 30 31] to be included, got %v", ids)
+	}
+	if *status.LastL1RollupTxId != 31 {
+		t.Fatalf("expected last L1 tx id 31, got %d", *status.LastL1RollupTxId)
+	}
+}
+
+func TestTxIngestionReappliesDroppedTransactions(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	chain, txPool, stop := newTestTxPool(t, db)
+	defer stop()
+
+	source := &testIngestionSource{submissions: []*Submission{newTestSubmission(0, 50_000, 50_000)}}
+	txIngestion := startTestTxIngestion(t, db, chain, txPool, source)
+	waitForPendingTxs(t, txPool, 2)
+	txIngestion.Stop()
+
+	// Restart with a pool that lost the applied transactions.
+	txPool.Stop()
+	poolConfig := core.DefaultTxPoolConfig
+	poolConfig.Journal = ""
+	txPool = core.NewTxPool(poolConfig, chain.Config(), chain)
+	defer txPool.Stop()
+
+	txIngestion = startTestTxIngestion(t, db, chain, txPool, source)
+	defer txIngestion.Stop()
+
+	waitForPendingTxs(t, txPool, 2)
+	mineTestBlock(t, chain, db, txPool)
+	waitForTxIngestionStatus(t, txIngestion, func(status *TxIngestionStatus) bool { return status.NextSubmissionIndex == 1 })
+	if ids := ingestedL1RollupTxIds(chain, db); !reflect.DeepEqual(ids, []uint64{0, 1}) {
+		t.Fatalf("expected L1 txs [0 1] to be included, got %v", ids)
+	}
+}
+
+func TestTxIngestionHaltsOnFailedElement(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	chain, txPool, stop := newTestTxPool(t, db)
+	defer stop()
+
+	// The second element exceeds the block gas limit and cannot enter the pool.
+	source := &testIngestionSource{submissions: []*Submission{newTestSubmission(0, 50_000, params.GenesisGasLimit+1, 50_000)}}
+	txIngestion := startTestTxIngestion(t, db, chain, txPool, source)
+
+	status := waitForTxIngestionStatus(t, txIngestion, func(status *TxIngestionStatus) bool { return status.State == TxIngestionHalted })
+	txIngestion.Stop()
+
+	if status.Failure.SubmissionIndex != 0 || status.Failure.Element != 1 || status.Failure.Error != core.ErrGasLimit.Error() {
+		t.Fatalf("expected element 1 of submission 0 to fail with %v, got %+v", core.ErrGasLimit, status.Failure)
+	}
+	if status.NextSubmissionIndex != 0 || status.NextElement != 0 || status.LastL1RollupTxId != nil {
+		t.Fatalf("expected to halt before the inclusion of element 0, got %+v", status)
+	}
+	if pending, _ := txPool.Stats(); pending != 1 {
+		t.Fatalf("expected 1 pending transaction, got %d", pending)
+	}
+	if source.attempts != 0 {
+		t.Fatalf("expected the failed submission not to be acknowledged")
+	}
+
+	// The failure must survive a restart.
+	failure := *status.Failure
+	txIngestion = startTestTxIngestion(t, db, chain, txPool, source)
+	defer txIngestion.Stop()
+	if status, _ := txIngestion.Status(); status.State != TxIngestionHalted || *status.Failure != failure {
+		t.Fatalf("expected transaction ingestion to stay halted, got %+v", status)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if pending, _ := txPool.Stats(); pending != 1 {
+		t.Fatalf("expected 1 pending transaction after restart, got %d", pending)
+	}
+}
+
+func TestTxIngestionResumesHaltedIngestion(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	chain, txPool, stop := newTestTxPool(t, db)
+	defer stop()
+
+	source := &testIngestionSource{submissions: []*Submission{newTestSubmission(0, 50_000, params.GenesisGasLimit+1)}}
+	txIngestion := startTestTxIngestion(t, db, chain, txPool, source)
+	defer txIngestion.Stop()
+
+	if err := txIngestion.Resume(); err != ErrTxIngestionRunning {
+		t.Fatalf("expected %v resuming running ingestion, got %v", ErrTxIngestionRunning, err)
+	}
+	waitForTxIngestionStatus(t, txIngestion, func(status *TxIngestionStatus) bool { return status.State == TxIngestionHalted })
+
+	// Fix the failed element at the source, as an operator would, and resume.
+	source.mu.Lock()
+	source.submissions[0] = newTestSubmission(0, 50_000, 50_000)
+	source.mu.Unlock()
+	if err := txIngestion.Resume(); err != nil {
+		t.Fatalf("unable to resume transaction ingestion: %v", err)
+	}
+	if data, _ := db.Get(TxIngestionFailureDBKey); len(data) != 0 {
+		t.Fatalf("expected the failure to be cleared from the database")
+	}
+	waitForPendingTxs(t, txPool, 2)
+	mineTestBlock(t, chain, db, txPool)
+	status := waitForTxIngestionStatus(t, txIngestion, func(status *TxIngestionStatus) bool { return status.NextSubmissionIndex == 1 })
+	if status.State != TxIngestionIngesting || status.Failure != nil {
+		t.Fatalf("expected transaction ingestion to be resumed, got %+v", status)
+	}
+	if ids := ingestedL1RollupTxIds(chain, db); !reflect.DeepEqual(ids, []uint64{0, 1}) {
+		t.Fatalf("expected L1 txs [0 1] to be included, got %v", ids)
+	}
+}
+
+func TestTxIngestionRetriesTransientPoolErrors(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	chain, txPool, stop := newTestTxPool(t, db)
+	defer stop()
+
+	// Replace the pool with one treating transactions as remote, rejecting the free
+	// transactions of the submission as underpriced.
+	txPool.Stop()
+	poolConfig := core.DefaultTxPoolConfig
+	poolConfig.Journal = ""
+	poolConfig.NoLocals = true
+	txPool = core.NewTxPool(poolConfig, chain.Config(), chain)
+	defer txPool.Stop()
+
+	source := &testIngestionSource{submissions: []*Submission{newTestSubmission(0, 50_000)}}
+	txIngestion := startTestTxIngestion(t, db, chain, txPool, source)
+	defer txIngestion.Stop()
+
+	status := waitForTxIngestionStatus(t, txIngestion, func(status *TxIngestionStatus) bool { return status.LastError != "" })
+	if status.State != TxIngestionIngesting || !strings.HasSuffix(status.LastError, core.ErrUnderpriced.Error()) {
+		t.Fatalf("expected ingestion to retry the underpriced transaction, got %+v", status)
+	}
+	txPool.SetGasPrice(big.NewInt(0))
+	waitForPendingTxs(t, txPool, 1)
+	mineTestBlock(t, chain, db, txPool)
+	status = waitForTxIngestionStatus(t, txIngestion, func(status *TxIngestionStatus) bool { return status.NextSubmissionIndex == 1 })
+	if status.State != TxIngestionIngesting || status.Failure != nil {
+		t.Fatalf("expected transaction ingestion not to halt, got %+v", status)
+	}
+	if data, _ := db.Get(TxIngestionFailureDBKey); len(data) != 0 {
+		t.Fatalf("expected no failure to be recorded")
+	}
+}
+
+func TestTxIngestionHaltsOnNonIncreasingL1RollupTxIds(t *testing.T) {
+	tests := []struct {
+		name        string
+		submissions []*Submission
+		failed      uint64 // Index of the submission failing at its last element
+	}{
+		{"repeated id within a submission", []*Submission{newTestSubmission(0, 50_000, 50_000)}, 0},
+		{"zero ids across submissions", []*Submission{newTestSubmission(0, 50_000), newTestSubmission(1, 50_000)}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := rawdb.NewMemoryDatabase()
+			chain, txPool, stop := newTestTxPool(t, db)
+			defer stop()
+
+			// Every transaction has L1 tx id 0, as if the source does not provide ids.
+			for _, submission := range tt.submissions {
+				for _, tx := range submission.Transactions {
+					meta := tx.GetMeta()
+					id := hexutil.Uint64(0)
+					meta.L1RollupTxId = &id
+					tx.SetTransactionMeta(meta)
+				}
+			}
+			source := &testIngestionSource{submissions: tt.submissions}
+			txIngestion := startTestTxIngestion(t, db, chain, txPool, source)
+			defer txIngestion.Stop()
+
+			waitForPendingTxs(t, txPool, 1)
+			mineTestBlock(t, chain, db, txPool)
+			status := waitForTxIngestionStatus(t, txIngestion, func(status *TxIngestionStatus) bool { return status.State == TxIngestionHalted })
+
+			failed := tt.submissions[len(tt.submissions)-1]
+			if status.Failure.SubmissionIndex != hexutil.Uint64(tt.failed) || status.Failure.Element != hexutil.Uint64(len(failed.Transactions)-1) {
+				t.Fatalf("expected the last element of submission %d to fail, got %+v", tt.failed, status.Failure)
+			}
+			if !strings.HasPrefix(status.Failure.Error, ErrL1RollupTxIdOrder.Error()) {
+				t.Fatalf("expected %v, got %s", ErrL1RollupTxIdOrder, status.Failure.Error)
+			}
+			if acknowledged := uint64(source.attempts); acknowledged != tt.failed {
+				t.Fatalf("expected %d acknowledged submissions, got %d", tt.failed, acknowledged)
+			}
+		})
+	}
+}
+
+func TestTxIngestionPrunesIncludedTransactions(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	chain, txPool, stop := newTestTxPool(t, db)
+	defer stop()
+
+	source := &testIngestionSource{submissions: []*Submission{newTestSubmission(0, 50_000, 50_000)}}
+	txIngestion := startTestTxIngestion(t, db, chain, txPool, source)
+	defer txIngestion.Stop()
+
+	waitForPendingTxs(t, txPool, 2)
+	waitFor(t, time.Second, func() (bool, string) {
+		pooled := len(txIngestion.pooledL1RollupTxIds())
+		return pooled == 2, fmt.Sprintf("2 tracked pool transactions, got %d", pooled)
+	})
+	mineTestBlock(t, chain, db, txPool)
+	waitFor(t, time.Second, func() (bool, string) {
+		txIngestion.pooledMu.Lock()
+		tracked := len(txIngestion.pooled)
+		txIngestion.pooledMu.Unlock()
+		return tracked == 0, fmt.Sprintf("included transactions to be pruned, %d tracked", tracked)
+	})
+	if id := rawdb.ReadBlockL1RollupTxId(db, chain.CurrentBlock().Hash()); id == nil || *id != 1 {
+		t.Fatalf("expected last included L1 tx id 1 to be stored with the head block, got %v", id)
+	}
+}
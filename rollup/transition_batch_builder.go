@@ -1,29 +1,42 @@
 package rollup
 
 import (
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/log"
-	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 var (
 	logger                     = log.New(TransitionBatchBuilder{})
 	ErrTransactionLimitReached = errors.New("transaction limit reached")
-	ErrMoreThanOneTxInBlock    = errors.New("block contains more than one transaction")
+	ErrBlockTooLarge           = errors.New("block exceeds transition batch limits")
+	ErrJournaledBlockReorged   = errors.New("block of a journaled transition batch reorged")
 	LastProcessedDBKey         = []byte("lastProcessedRollupBlock")
 )
 
+// Health states of a RollupTransitionBatchBuilder, as reported by its BuilderStatus.
+const (
+	BuilderHealthy  = "healthy"
+	BuilderRetrying = "retrying"
+	BuilderHalted   = "halted"
+	BuilderDisabled = "disabled"
+)
+
 type RollupTransitionBatchBuilder interface {
 	Stop()
 	NewBlock(block *types.Block)
+	Status() *BuilderStatus
 }
 
 type DummyBatchBuilder struct{}
@@ -31,6 +44,54 @@ type DummyBatchBuilder struct{}
 func NewDummyBatchBuilder() *DummyBatchBuilder           { return &DummyBatchBuilder{} }
 func (d *DummyBatchBuilder) Stop()                       {}
 func (d *DummyBatchBuilder) NewBlock(block *types.Block) {}
+func (d *DummyBatchBuilder) Status() *BuilderStatus {
+	return &BuilderStatus{State: BuilderDisabled}
+}
+
+// BuilderStatus reports the health and progress of a RollupTransitionBatchBuilder.
+type BuilderStatus struct {
+	State                     string         `json:"state"`
+	LastProcessedBlock        hexutil.Uint64 `json:"lastProcessedBlock"`
+	NextBatchIndex            hexutil.Uint64 `json:"nextBatchIndex"`
+	NextUnconfirmedBatchIndex hexutil.Uint64 `json:"nextUnconfirmedBatchIndex"`
+	ConsecutiveFailures       uint64         `json:"consecutiveFailures"`
+	LastError                 string         `json:"lastError,omitempty"`
+	LastErrorTime             *time.Time     `json:"lastErrorTime,omitempty"`
+}
+
+// Backoff configures the exponential backoff between retries of failed
+// TransitionBatchBuilder operations.
+type Backoff struct {
+	Min time.Duration // Delay before the first retry
+	Max time.Duration // Upper bound of the delay between retries
+}
+
+// delay returns the backoff before the retry following the provided number of
+// consecutive failures.
+func (b Backoff) delay(failures uint64) time.Duration {
+	delay := b.Min
+	for i := uint64(1); i < failures && delay < b.Max; i++ {
+		delay *= 2
+	}
+	if delay > b.Max {
+		delay = b.Max
+	}
+	return delay
+}
+
+// isUnrecoverable returns whether retrying after the provided error cannot succeed
+// without manual intervention.
+func isUnrecoverable(err error) bool {
+	switch err {
+	case ErrBlockTooLarge, ErrJournaledBlockReorged, ErrBatchSubmissionReverted, ErrCorruptBatchJournal:
+		return true
+	}
+	return false
+}
+
+// maxFutureBlocks is the maximum number of Geth Blocks received ahead of the next expected
+// Block that are buffered. Blocks beyond that are dropped and later fetched from the BlockStore.
+const maxFutureBlocks = 1024
 
 type ActiveBatch struct {
 	firstBlockNumber uint64
@@ -38,77 +99,222 @@ type ActiveBatch struct {
 	gasUsed          uint64
 
 	transitionBatch *TransitionBatch
+	blocks          []*types.Block // Every Geth Block processed into this batch, including empty ones
 }
 
 func newActiveBatch(defaultTxCapacity int) *ActiveBatch {
 	return &ActiveBatch{
 		firstBlockNumber: 0,
 		lastBlockNumber:  0,
-		gasUsed:          TransitionBatchGasBuffer,
+		gasUsed:          TransitionBatchFixedGas,
 		transitionBatch:  NewTransitionBatch(defaultTxCapacity),
 	}
 }
 
-// addBlock adds a Geth Block to the ActiveBatch in question, only if it fits.
+// addBlock adds a Geth Block to the ActiveBatch in question, only if it fits. The
+// transactions of the Block are added as Transitions committing to the provided
+// intermediate state roots, all or none of them.
 // Cases in which it would not fit are if it would put the block above the configured
 // max number of transactions or max block gas, resulting in
-// ErrTransactionLimitReached and core.ErrGasLimitReached, respectively.
-func (b *ActiveBatch) addBlock(block *types.Block, maxBlockGas uint64, maxBlockTransactions int) error {
-	if maxBlockTransactions < len(b.transitionBatch.transitions)+1 {
-		return ErrTransactionLimitReached
+// ErrTransactionLimitReached and core.ErrGasLimitReached, respectively. Gas is
+// accounted for using the size of the batch encoded with the provided BatchCodec.
+func (b *ActiveBatch) addBlock(block *types.Block, roots []common.Hash, codec BatchCodec, maxBlockGas uint64, maxBlockTransactions int) error {
+	txCount := len(block.Transactions())
+	if len(roots) != txCount {
+		return fmt.Errorf("block %d has %d transactions but %d intermediate roots", block.NumberU64(), txCount, len(roots))
 	}
-	blockGasCost := GetBlockRollupGasUsage(block)
-	if maxBlockGas < b.gasUsed+blockGasCost {
-		return core.ErrGasLimitReached
+	transitionCount := len(b.transitionBatch.transitions)
+	if maxBlockTransactions < transitionCount+txCount {
+		return ErrTransactionLimitReached
 	}
 
-	b.transitionBatch.addBlock(block)
-	b.gasUsed += blockGasCost
+	b.transitionBatch.addBlock(block, roots)
+	gasUsed, err := TransitionBatchGasUsage(codec, b.transitionBatch)
+	if err == nil && maxBlockGas < gasUsed {
+		err = core.ErrGasLimitReached
+	}
+	if err != nil {
+		b.transitionBatch.transitions = b.transitionBatch.transitions[:transitionCount]
+		return err
+	}
+	b.gasUsed = gasUsed
 	if b.firstBlockNumber == 0 {
 		b.firstBlockNumber = block.NumberU64()
 	}
 	b.lastBlockNumber = block.NumberU64()
+	b.blocks = append(b.blocks, block)
+
+	return nil
+}
+
+// BlockBatch bounds a Geth Block being mined to what fits in an empty TransitionBatch
+// within the configured limits, as a Block that does not fit halts the
+// TransitionBatchBuilder. Its transactions are added to the ActiveBatch of the Block one
+// by one, and the batch is only encoded once a bound of its gas exceeds the limit.
+type BlockBatch struct {
+	batch   *ActiveBatch
+	context TransitionContext
+	rlpSize uint64 // Total size of the RLP encodings of the transitions of the batch
+
+	codec                BatchCodec
+	maxBlockGas          uint64
+	maxBlockTransactions int
+}
+
+// NewBlockBatch creates the BlockBatch of a Geth Block with the provided header.
+func NewBlockBatch(header *types.Header, codec BatchCodec, maxBlockGas uint64, maxBlockTransactions int) *BlockBatch {
+	return &BlockBatch{
+		batch:                newActiveBatch(0),
+		context:              newTransitionContext(header),
+		codec:                codec,
+		maxBlockGas:          maxBlockGas,
+		maxBlockTransactions: maxBlockTransactions,
+	}
+}
+
+// Add adds the transaction with the provided post-state root to the Block if it still
+// fits in the TransitionBatch, reporting whether it does.
+func (b *BlockBatch) Add(tx *types.Transaction, root common.Hash) bool {
+	transitions := b.batch.transitionBatch.transitions
+	if b.maxBlockTransactions <= len(transitions) {
+		return false
+	}
+	transition := newTransition(tx, root, b.context)
+	encoded, err := rlp.EncodeToBytes(newEncodedTransition(transition))
+	if err != nil {
+		return false
+	}
+	rlpSize := b.rlpSize + uint64(len(encoded))
+	b.batch.transitionBatch.transitions = append(transitions, transition)
+
+	gasUsed := transitionBatchGasBound(len(transitions)+1, rlpSize)
+	if b.maxBlockGas < gasUsed {
+		// The bound may be far off for compressible batches, determine the actual gas
+		if gasUsed, err = TransitionBatchGasUsage(b.codec, b.batch.transitionBatch); err != nil || b.maxBlockGas < gasUsed {
+			b.batch.transitionBatch.transitions = transitions
+			return false
+		}
+	}
+	b.batch.gasUsed, b.rlpSize = gasUsed, rlpSize
+	return true
+}
+
+// isEmpty returns whether no transactions were added to the ActiveBatch yet.
+func (b *ActiveBatch) isEmpty() bool {
+	return len(b.transitionBatch.transitions) == 0
+}
 
+// addEmptyBlock records a processed Geth Block without transactions so that it can be
+// detected as replaced in case of a reorg.
+func (b *ActiveBatch) addEmptyBlock(block *types.Block) {
+	b.blocks = append(b.blocks, block)
+}
+
+// processedBlock returns the Geth Block with the provided number that was processed into
+// this batch, or nil if there is none.
+func (b *ActiveBatch) processedBlock(number uint64) *types.Block {
+	for i := len(b.blocks) - 1; i >= 0; i-- {
+		if b.blocks[i].NumberU64() == number {
+			return b.blocks[i]
+		}
+	}
 	return nil
 }
 
+// truncate removes the Geth Blocks from the provided number onwards, along with their
+// Transitions, from the ActiveBatch.
+func (b *ActiveBatch) truncate(number uint64, codec BatchCodec) {
+	blocks := b.blocks
+	for i, block := range blocks {
+		if block.NumberU64() >= number {
+			blocks = blocks[:i]
+			break
+		}
+	}
+	transitions := b.transitionBatch.transitions
+	for i, transition := range transitions {
+		if transition.context.BlockNumber >= number {
+			transitions = transitions[:i]
+			break
+		}
+	}
+	b.blocks, b.transitionBatch.transitions = blocks, transitions
+
+	b.firstBlockNumber, b.lastBlockNumber, b.gasUsed = 0, 0, TransitionBatchFixedGas
+	if len(transitions) == 0 {
+		return
+	}
+	b.firstBlockNumber = transitions[0].context.BlockNumber
+	b.lastBlockNumber = transitions[len(transitions)-1].context.BlockNumber
+	if gasUsed, err := TransitionBatchGasUsage(codec, b.transitionBatch); err == nil {
+		b.gasUsed = gasUsed
+	} else {
+		// Cannot happen, the transitions were encoded before the unwind.
+		logger.Error("unable to estimate gas of unwound transition batch", "error", err)
+	}
+}
+
 type TransitionBatchBuilder struct {
 	db                   ethdb.Database
 	blockProvider        BlockStore
 	rollupBatchSubmitter RollupTransitionBatchSubmitter
+	codec                BatchCodec
 	pendingMu            sync.RWMutex
 
 	newBlockCh chan *types.Block
+	submitCh   chan struct{} // Signals the submit loop that a TransitionBatch was journaled
+	halted     chan struct{} // Closed once the TransitionBatchBuilder halts
+	haltOnce   sync.Once
+	quit       chan struct{}
 
 	maxTransitionBatchTime         time.Duration
 	maxTransitionBatchGas          uint64
 	maxTransitionBatchTransactions int
+	backoff                        Backoff
 
 	lastProcessedBlockNumber uint64
 	activeBatch              *ActiveBatch
+	futureBlocks             map[uint64]*types.Block // Blocks received ahead of lastProcessedBlockNumber + 1
+	nextBatchIndex           uint64
+
+	statusMu      sync.RWMutex
+	state         string
+	failures      uint64
+	lastErr       error
+	lastErrorTime time.Time
 }
 
-func NewTransitionBatchBuilder(db ethdb.Database, blockStore interface{}, rollupBlockSubmitter interface{}, maxBlockTime time.Duration, maxBlockGas uint64, maxBlockTransactions int) (*TransitionBatchBuilder, error) {
+func NewTransitionBatchBuilder(db ethdb.Database, blockStore interface{}, rollupBlockSubmitter interface{}, codec BatchCodec, maxBlockTime time.Duration, maxBlockGas uint64, maxBlockTransactions int, backoff Backoff) (*TransitionBatchBuilder, error) {
 	lastBlock, err := fetchLastProcessedBlockNumber(db)
 	if err != nil {
 		return nil, err
 	}
+	_, next := ReadBatchJournalIndices(db)
 
 	builder := &TransitionBatchBuilder{
 		db:                   db,
 		blockProvider:        blockStore.(BlockStore),
 		rollupBatchSubmitter: rollupBlockSubmitter.(RollupTransitionBatchSubmitter),
+		codec:                codec,
 		newBlockCh:           make(chan *types.Block, 10_000),
+		submitCh:             make(chan struct{}, 1),
+		halted:               make(chan struct{}),
+		quit:                 make(chan struct{}),
 
 		maxTransitionBatchTime:         maxBlockTime,
 		maxTransitionBatchGas:          maxBlockGas,
 		maxTransitionBatchTransactions: maxBlockTransactions,
+		backoff:                        backoff,
 
 		lastProcessedBlockNumber: lastBlock,
 		activeBatch:              newActiveBatch(maxBlockTransactions),
+		futureBlocks:             make(map[uint64]*types.Block),
+		nextBatchIndex:           next,
+		state:                    BuilderHealthy,
 	}
 
 	go builder.buildLoop(maxBlockTime)
+	go builder.submitLoop()
 
 	return builder, nil
 }
@@ -121,84 +327,429 @@ func (b *TransitionBatchBuilder) NewBlock(block *types.Block) {
 
 // Stop handles graceful shutdown of the TransitionBatchBuilder.
 func (b *TransitionBatchBuilder) Stop() {
+	close(b.quit)
 	close(b.newBlockCh)
 }
 
-// buildLoop initiates TransitionBatch production and submission either based on
-// a new Geth Block being received or the maxBlockTime being reached.
+// Status returns the current health and progress of the TransitionBatchBuilder.
+func (b *TransitionBatchBuilder) Status() *BuilderStatus {
+	b.pendingMu.RLock()
+	lastProcessed := b.lastProcessedBlockNumber
+	b.pendingMu.RUnlock()
+	nextUnconfirmed, next := ReadBatchJournalIndices(b.db)
+
+	b.statusMu.RLock()
+	defer b.statusMu.RUnlock()
+
+	status := &BuilderStatus{
+		State:                     b.state,
+		LastProcessedBlock:        hexutil.Uint64(lastProcessed),
+		NextBatchIndex:            hexutil.Uint64(next),
+		NextUnconfirmedBatchIndex: hexutil.Uint64(nextUnconfirmed),
+		ConsecutiveFailures:       b.failures,
+	}
+	if b.lastErr != nil {
+		errorTime := b.lastErrorTime
+		status.LastError = b.lastErr.Error()
+		status.LastErrorTime = &errorTime
+	}
+	return status
+}
+
+// buildLoop initiates TransitionBatch production either based on a new Geth Block
+// being received or the maxBlockTime being reached. Built TransitionBatches are
+// journaled and left to the submit loop, so that L1 latency never delays the
+// handling of new Blocks. Failures are retried by the supervisor in recoverFrom;
+// the loop only exits when the builder is stopped or has halted, after which new
+// Blocks are discarded until the builder is stopped, so as not to block the caller
+// of NewBlock.
 func (b *TransitionBatchBuilder) buildLoop(maxBlockTime time.Duration) {
+	defer b.discardBlocks()
+
 	lastProcessed := b.lastProcessedBlockNumber
 
-	if err := b.sync(); err != nil {
-		panic(fmt.Errorf("error syncing: %+v", err))
+	if err := b.resume(); err != nil && !b.recoverFrom(err, b.resume) {
+		return
 	}
 
 	timer := time.NewTimer(maxBlockTime)
+	defer timer.Stop()
 
 	for {
 		select {
 		case block, ok := <-b.newBlockCh:
 			if !ok {
-				timer.Stop()
 				logger.Info("Closing transition batch builder new block channel. If not shutting down, this is an error")
 				return
 			}
+			if b.isHalted() {
+				return
+			}
 
 			built, err := b.handleNewBlock(block)
 			if err != nil {
-				panic(fmt.Errorf("error handling new block. Error: %v. Block: %+v", err, block))
+				logger.Error("error handling new block", "error", err, "block number", block.NumberU64())
+				if !b.recoverFrom(err, b.resume) {
+					return
+				}
 			}
-			if timer != nil && built {
+			if built {
 				timer.Reset(b.maxTransitionBatchTime)
 			}
 		case <-timer.C:
 			if lastProcessed != b.lastProcessedBlockNumber && b.activeBatch.firstBlockNumber != 0 {
 				if _, err := b.buildRollupBlock(true); err != nil {
-					panic(fmt.Errorf("error building block: %v", err))
+					logger.Error("error building transition batch", "error", err)
+					if !b.recoverFrom(err, b.resume) {
+						return
+					}
 				}
 			}
 
 			lastProcessed = b.lastProcessedBlockNumber
 			timer.Reset(maxBlockTime)
+		case <-b.halted:
+			return
+		}
+	}
+}
+
+// submitLoop submits the journaled TransitionBatches to L1 in the order they were
+// journaled, whenever the build loop journals a new one. Unconfirmed TransitionBatches
+// journaled before a restart are submitted right away.
+func (b *TransitionBatchBuilder) submitLoop() {
+	for {
+		if err := b.submitJournaledBatches(); err != nil && !b.recoverFrom(err, b.submitJournaledBatches) {
+			return
+		}
+		select {
+		case <-b.submitCh:
+		case <-b.halted:
+			return
+		case <-b.quit:
+			return
+		}
+	}
+}
+
+// notifySubmitter wakes the submit loop up after a TransitionBatch was journaled.
+func (b *TransitionBatchBuilder) notifySubmitter() {
+	select {
+	case b.submitCh <- struct{}{}:
+	default:
+		// A wake up is already pending, which covers this batch too.
+	}
+}
+
+// resume catches the TransitionBatchBuilder up from its persisted state by building the
+// active TransitionBatch if it is full and syncing the Geth Blocks it has not processed
+// yet. Every step is safe to repeat, so resume is also used to retry after a failure.
+func (b *TransitionBatchBuilder) resume() error {
+	if _, err := b.tryBuildRollupBlock(); err != nil {
+		return err
+	}
+	return b.sync()
+}
+
+// recoverFrom handles an error returned by the build or submit loop by calling retry with
+// exponential backoff until it succeeds. It returns false if the loop must exit, either
+// because the TransitionBatchBuilder was stopped or halted or because the error is
+// unrecoverable, in which case the TransitionBatchBuilder is halted.
+func (b *TransitionBatchBuilder) recoverFrom(err error, retry func() error) bool {
+	for err != nil {
+		if err == ErrBatchSubmitterStopped {
+			logger.Info("Transition batch submitter stopped, exiting transition batch submit loop")
+			return false
+		}
+		failures := b.recordFailure(err)
+		if isUnrecoverable(err) {
+			b.halt(err)
+			return false
 		}
+
+		delay := b.backoff.delay(failures)
+		logger.Warn("transition batch builder failed, retrying", "error", err, "failures", failures, "backoff", delay)
+		select {
+		case <-b.quit:
+			return false
+		case <-b.halted:
+			return false
+		case <-time.After(delay):
+		}
+		batchBuilderRetryMeter.Mark(1)
+		err = retry()
+	}
+	b.recordRecovery()
+	return true
+}
+
+// recordFailure records a failed build loop operation, returning the number of
+// consecutive failures.
+func (b *TransitionBatchBuilder) recordFailure(err error) uint64 {
+	b.statusMu.Lock()
+	defer b.statusMu.Unlock()
+
+	b.state = BuilderRetrying
+	b.failures++
+	b.lastErr, b.lastErrorTime = err, time.Now()
+
+	batchBuilderErrorMeter.Mark(1)
+	batchBuilderFailureGauge.Update(int64(b.failures))
+	return b.failures
+}
+
+// recordRecovery marks the TransitionBatchBuilder healthy again after a failure.
+func (b *TransitionBatchBuilder) recordRecovery() {
+	b.statusMu.Lock()
+	defer b.statusMu.Unlock()
+
+	logger.Info("transition batch builder recovered", "failures", b.failures)
+	b.state = BuilderHealthy
+	b.failures = 0
+	batchBuilderFailureGauge.Update(0)
+}
+
+// halt stops TransitionBatch production and submission after an unrecoverable error.
+// Progress is persisted, so a restart after manual intervention resumes where the
+// TransitionBatchBuilder halted.
+func (b *TransitionBatchBuilder) halt(err error) {
+	b.haltOnce.Do(func() {
+		close(b.halted)
+
+		b.statusMu.Lock()
+		b.state = BuilderHalted
+		b.statusMu.Unlock()
+
+		batchBuilderHaltedGauge.Update(1)
+		logger.Error("Transition batch builder halted on unrecoverable error, manual intervention required", "error", err)
+	})
+}
+
+// isHalted returns whether the TransitionBatchBuilder has halted.
+func (b *TransitionBatchBuilder) isHalted() bool {
+	select {
+	case <-b.halted:
+		return true
+	default:
+		return false
+	}
+}
+
+// discardBlocks drops new Geth Blocks until the TransitionBatchBuilder is stopped.
+func (b *TransitionBatchBuilder) discardBlocks() {
+	for range b.newBlockCh {
 	}
 }
 
-// handleNewBlock processes a newly received Geth Block, ignoring old / future blocks
-// and building and submitting TransitionBatches if the pending TransitionBatch is full.
+// handleNewBlock processes a newly received Geth Block, building TransitionBatches
+// if the pending TransitionBatch is full. Future blocks are buffered until the Blocks in between
+// are received or fetched from the BlockStore, and a Block replacing one in the pending
+// TransitionBatch unwinds the pending TransitionBatch to before the replaced Block. A Block
+// replacing one of a journaled TransitionBatch results in ErrJournaledBlockReorged.
 func (b *TransitionBatchBuilder) handleNewBlock(block *types.Block) (bool, error) {
 	if block == nil {
 		return false, errors.New("Cannot handle nil block")
 	}
 	logger.Debug("handling new block in transition batch builder", "block number", block.NumberU64(), "hash", block.Header().Hash().Hex())
 	if block.NumberU64() <= b.lastProcessedBlockNumber {
-		logger.Debug("handling old block -- ignoring", "block number", block.NumberU64(), "last processed", b.lastProcessedBlockNumber)
-		return false, nil
+		reorg, err := b.isReorg(block)
+		if err != nil {
+			return false, err
+		}
+		if !reorg {
+			logger.Debug("handling old block -- ignoring", "block number", block.NumberU64(), "last processed", b.lastProcessedBlockNumber)
+			return false, nil
+		}
+		b.unwind(block.NumberU64())
 	}
 	if block.NumberU64() > b.lastProcessedBlockNumber+1 {
-		logger.Error("received future block", "block number", block.NumberU64(), "expected number", b.lastProcessedBlockNumber+1)
-		// TODO: add to queue and/or try to fetch blocks in between.
+		logger.Debug("received future block, filling gap", "block number", block.NumberU64(), "expected number", b.lastProcessedBlockNumber+1)
+		b.bufferFutureBlock(block)
+		return b.fillGap()
+	}
+
+	built, err := b.processBlock(block)
+	if err != nil {
+		return false, err
+	}
+	filled, err := b.fillGap()
+	return built || filled, err
+}
+
+// isReorg returns whether the provided Geth Block replaces a different Block with the same
+// number in the pending TransitionBatch. Blocks that are not canonical according to the
+// BlockStore are not considered to replace anything. Blocks of journaled TransitionBatches
+// cannot be unwound, as they may already be submitted, so replacing one of them results
+// in ErrJournaledBlockReorged.
+func (b *TransitionBatchBuilder) isReorg(block *types.Block) (bool, error) {
+	processed := b.activeBatch.processedBlock(block.NumberU64())
+	if processed != nil && processed.Hash() == block.Hash() {
+		return false, nil
+	}
+	canonical := b.blockProvider.GetBlockByNumber(block.NumberU64())
+	if canonical == nil || canonical.Hash() != block.Hash() {
+		logger.Debug("ignoring non-canonical block", "block number", block.NumberU64(), "hash", block.Hash().Hex())
+		return false, nil
+	}
+	if processed != nil {
+		return true, nil
+	}
+	replaced, err := b.replacesJournaledBlock(block)
+	if err != nil {
+		return false, err
+	}
+	if replaced {
+		logger.Error("reorg of a journaled transition batch detected", "block number", block.NumberU64(), "hash", block.Hash().Hex())
+		return false, ErrJournaledBlockReorged
+	}
+	return false, nil
+}
+
+// replacesJournaledBlock returns whether the provided Geth Block differs from the Block
+// with the same number whose Transitions were journaled, if any.
+func (b *TransitionBatchBuilder) replacesJournaledBlock(block *types.Block) (bool, error) {
+	number := block.NumberU64()
+	for index := b.nextBatchIndex; index > 0; index-- {
+		journaled, err := ReadJournaledBatch(b.db, index-1)
+		if err != nil {
+			return false, err
+		}
+		if journaled == nil {
+			return false, ErrCorruptBatchJournal
+		}
+		if journaled.LastBlockNumber < number {
+			return false, nil
+		}
+		if journaled.FirstBlockNumber > number {
+			continue
+		}
+		var transitions []encodedTransition
+		for _, transition := range journaled.Transitions {
+			if transition.Context.BlockNumber == number {
+				transitions = append(transitions, transition)
+			}
+		}
+		txs := block.Transactions()
+		if len(transitions) != len(txs) {
+			return true, nil
+		}
+		context := newTransitionContext(block.Header())
+		for i, transition := range transitions {
+			if transition.Transaction.Hash() != txs[i].Hash() || !transition.Context.equal(context) {
+				return true, nil
+			}
+		}
+		if len(txs) == 0 {
+			return false, nil
+		}
+		// Only the state roots are left to tell the Blocks apart
+		roots, err := b.blockProvider.IntermediateRoots(block)
+		if err != nil {
+			return false, err
+		}
+		for i, transition := range transitions {
+			if transition.PostState != roots[i] {
+				return true, nil
+			}
+		}
 		return false, nil
 	}
+	return false, nil
+}
+
+// unwind removes the Geth Blocks from the provided number onwards from the pending
+// TransitionBatch, so that the Blocks replacing them can be processed.
+func (b *TransitionBatchBuilder) unwind(number uint64) {
+	b.pendingMu.Lock()
+	defer b.pendingMu.Unlock()
+
+	logger.Warn("reorg detected, unwinding transition batch in progress", "block number", number, "last processed", b.lastProcessedBlockNumber)
+	batchBuilderReorgMeter.Mark(1)
+
+	b.activeBatch.truncate(number, b.codec)
+	b.lastProcessedBlockNumber = number - 1
+	// Buffered future blocks may belong to the replaced chain.
+	b.futureBlocks = make(map[uint64]*types.Block)
+}
+
+// bufferFutureBlock stores a Geth Block received ahead of the next expected Block.
+func (b *TransitionBatchBuilder) bufferFutureBlock(block *types.Block) {
+	if len(b.futureBlocks) >= maxFutureBlocks {
+		logger.Warn("future block buffer full, dropping block", "block number", block.NumberU64())
+		return
+	}
+	b.futureBlocks[block.NumberU64()] = block
+}
+
+// fillGap processes the Geth Blocks following the last processed Block, fetching them from the
+// BlockStore or, if not available there yet, from the buffered future Blocks. It stops at the
+// first Block that is available from neither.
+func (b *TransitionBatchBuilder) fillGap() (bool, error) {
+	built := false
+	for {
+		number := b.lastProcessedBlockNumber + 1
+		block := b.blockProvider.GetBlockByNumber(number)
+		if block == nil {
+			block = b.futureBlocks[number]
+		}
+		if block == nil {
+			b.pruneFutureBlocks()
+			if len(b.futureBlocks) > 0 {
+				logger.Debug("waiting for missing block", "block number", number, "buffered", len(b.futureBlocks))
+			}
+			return built, nil
+		}
+		delete(b.futureBlocks, number)
+
+		blockBuilt, err := b.processBlock(block)
+		if err != nil {
+			return built, err
+		}
+		built = built || blockBuilt
+		logger.Debug("successfully processed block", "number", number, "last processed", b.lastProcessedBlockNumber)
+	}
+}
+
+// pruneFutureBlocks drops buffered future Blocks that have since been processed.
+func (b *TransitionBatchBuilder) pruneFutureBlocks() {
+	for number := range b.futureBlocks {
+		if number <= b.lastProcessedBlockNumber {
+			delete(b.futureBlocks, number)
+		}
+	}
+}
 
-	if txCount := len(block.Transactions()); txCount > 1 {
-		// should never happen
-		logger.Error("received block with more than one transaction", "tx count", len(block.Transactions()))
-		return false, ErrMoreThanOneTxInBlock
-	} else if txCount == 0 {
+// processBlock adds the next expected Geth Block to the pending TransitionBatch, building it if
+// it is full.
+func (b *TransitionBatchBuilder) processBlock(block *types.Block) (bool, error) {
+	if len(block.Transactions()) == 0 {
 		logger.Debug("handling empty block -- ignoring", "hash", block.Header().Hash().Hex())
+		b.pendingMu.Lock()
+		b.activeBatch.addEmptyBlock(block)
 		b.lastProcessedBlockNumber = block.NumberU64()
+		b.pendingMu.Unlock()
 		return false, nil
 	}
 
-	switch err := b.addBlock(block); err {
+	roots, err := b.blockProvider.IntermediateRoots(block)
+	if err != nil {
+		logger.Error("unable to compute intermediate state roots", "block number", block.NumberU64(), "error", err)
+		return false, err
+	}
+	switch err := b.addBlock(block, roots); err {
 	case core.ErrGasLimitReached, ErrTransactionLimitReached:
-		if _, e := b.buildRollupBlock(false); e != nil {
+		if b.activeBatch.isEmpty() {
+			logger.Error("block does not fit in an empty transition batch", "block number", block.NumberU64(), "tx count", len(block.Transactions()), "error", err)
+			return false, ErrBlockTooLarge
+		}
+		if _, e := b.buildRollupBlock(true); e != nil {
 			logger.Error("unable to build transition batch", "error", e, "transition batch", b.activeBatch)
 			return false, e
 		}
-		if addErr := b.addBlock(block); addErr != nil {
-			// TODO: Retry and whatnot instead of instant panic
+		if addErr := b.addBlock(block, roots); addErr != nil {
+			if addErr == core.ErrGasLimitReached || addErr == ErrTransactionLimitReached {
+				addErr = ErrBlockTooLarge
+			}
 			logger.Error("unable to build transition batch", "error", addErr, "transition batch", b.activeBatch)
 			return false, addErr
 		}
@@ -221,40 +772,30 @@ func (b *TransitionBatchBuilder) handleNewBlock(block *types.Block) (bool, error
 }
 
 // sync catches the TransitionBatchBuilder up to the Geth chain by fetching all Geth Blocks between
-// its last processed Block and the current Block, building and submitting RollupBlocks if/when
-// they are full.
+// its last processed Block and the current Block, building RollupBlocks if/when they are full.
 func (b *TransitionBatchBuilder) sync() error {
 	logger.Info("syncing blocks in transition batch builder", "starting block", b.lastProcessedBlockNumber)
 
-	for {
-		blockNum := b.lastProcessedBlockNumber + uint64(1)
-		block := b.blockProvider.GetBlockByNumber(blockNum)
-		logger.Info("got block number", "number", blockNum, "block", block)
-		if block == nil {
-			logger.Info("done syncing blocks in transition batch builder", "number", b.lastProcessedBlockNumber)
-			return nil
-		}
-		if _, err := b.handleNewBlock(block); err != nil {
-			logger.Error("Error handling new block", "error", err)
-			return err
-		} else {
-			logger.Debug("successfully synced block", "number", blockNum, "last processed", b.lastProcessedBlockNumber)
-		}
+	if _, err := b.fillGap(); err != nil {
+		logger.Error("Error syncing blocks", "error", err)
+		return err
 	}
+	logger.Info("done syncing blocks in transition batch builder", "number", b.lastProcessedBlockNumber)
+	return nil
 }
 
 // addBlock adds a Geth Block to the TransitionBatch if it fits. If not, it will return an error.
-func (b *TransitionBatchBuilder) addBlock(block *types.Block) error {
+func (b *TransitionBatchBuilder) addBlock(block *types.Block, roots []common.Hash) error {
 	b.pendingMu.Lock()
 	defer b.pendingMu.Unlock()
-	if err := b.activeBatch.addBlock(block, b.maxTransitionBatchGas, b.maxTransitionBatchTransactions); err != nil {
+	if err := b.activeBatch.addBlock(block, roots, b.codec, b.maxTransitionBatchGas, b.maxTransitionBatchTransactions); err != nil {
 		return err
 	}
 	b.lastProcessedBlockNumber = block.NumberU64()
 	return nil
 }
 
-// tryBuildRollupBlock builds and submits a TransitionBatch if the pending TransitionBatch is full.
+// tryBuildRollupBlock builds a TransitionBatch if the pending TransitionBatch is full.
 func (b *TransitionBatchBuilder) tryBuildRollupBlock() (bool, error) {
 	txCount := len(b.activeBatch.transitionBatch.transitions)
 	gasAfterOneMoreTx := b.activeBatch.gasUsed + MinTxGas
@@ -268,51 +809,162 @@ func (b *TransitionBatchBuilder) tryBuildRollupBlock() (bool, error) {
 }
 
 // buildRollupBlock builds a TransitionBatch if the pending TransitionBatch is full or if force is true
-// and the pending TransitionBatch is not empty.
+// and the pending TransitionBatch is not empty, handing it to the submit loop once journaled.
 func (b *TransitionBatchBuilder) buildRollupBlock(force bool) (bool, error) {
-	var toSubmit *ActiveBatch
+	built, err := b.journalActiveBatch(force)
+	if built == nil || err != nil {
+		return false, err
+	}
+	b.notifySubmitter()
+	logger.Debug("successfully built transition batch", "lastBlockNumber", built.lastBlockNumber)
+
+	return true, nil
+}
+
+// journalActiveBatch journals the pending TransitionBatch and replaces it with an empty one if it
+// is full or if force is true and it is not empty, returning the journaled batch if any.
+func (b *TransitionBatchBuilder) journalActiveBatch(force bool) (*ActiveBatch, error) {
 	b.pendingMu.Lock()
 	defer b.pendingMu.Unlock()
 
 	txCount := len(b.activeBatch.transitionBatch.transitions)
 
-	if force && txCount == 0 {
-		logger.Debug("transition batch is empty so not finalizing it, even though force = true")
-		return false, nil
+	if txCount == 0 {
+		logger.Debug("transition batch is empty so not finalizing it", "force", force)
+		return nil, nil
 	}
 	if !force && txCount < b.maxTransitionBatchTransactions && b.activeBatch.gasUsed+MinTxGas <= b.maxTransitionBatchGas {
 		logger.Debug("transition batch is not full, so not finalizing it")
-		return false, nil
+		return nil, nil
 	}
 	logger.Debug("building transition batch")
 
-	toSubmit = b.activeBatch
+	journaled := b.activeBatch
+	if err := b.journalBatch(journaled); err != nil {
+		logger.Error("error journaling transition batch", "lastBlockNumber", journaled.lastBlockNumber, "error", err)
+		return nil, err
+	}
 	b.activeBatch = newActiveBatch(b.maxTransitionBatchTransactions)
+	return journaled, nil
+}
 
-	if err := b.submitBlock(toSubmit); err != nil {
-		logger.Error("error submitting transition batch", "lastBlockNumber", toSubmit.lastBlockNumber, "error", err)
-		return false, err
+// journalBatch journals a TransitionBatch, updating the DB to indicate the last processed Geth
+// Block included in it. Journaling before submission means that a crash at any point results in
+// the TransitionBatch being resumed on restart rather than being rebuilt or lost.
+func (b *TransitionBatchBuilder) journalBatch(block *ActiveBatch) error {
+	logger.Debug("journaling transition batch", "block", block)
+
+	firstTransitionIndex, err := nextTransitionIndex(b.db, b.nextBatchIndex)
+	if err != nil {
+		logger.Error("unable to read previous journaled transition batch", "index", b.nextBatchIndex, "error", err)
+		return ErrCorruptBatchJournal
 	}
-	logger.Debug("successfully built transition batch", "lastBlockNumber", toSubmit.lastBlockNumber)
+	journaled := newJournaledBatch(block.transitionBatch, BatchPending, block.firstBlockNumber, block.lastBlockNumber, firstTransitionIndex)
 
-	return true, nil
+	batch := b.db.NewBatch()
+	if err := writeJournaledBatch(batch, b.nextBatchIndex, journaled); err != nil {
+		return err
+	}
+	if err := batch.Put(NextBatchIndexDBKey, SerializeBlockNumber(b.nextBatchIndex+1)); err != nil {
+		return err
+	}
+	if err := batch.Put(LastProcessedDBKey, SerializeBlockNumber(block.lastBlockNumber)); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	b.nextBatchIndex++
+	return nil
+}
+
+// submitJournaledBatches submits every journaled TransitionBatch that has not been
+// confirmed yet, oldest first. It is only called from the submit loop.
+func (b *TransitionBatchBuilder) submitJournaledBatches() error {
+	nextUnconfirmed, next := ReadBatchJournalIndices(b.db)
+	for ; nextUnconfirmed < next; nextUnconfirmed++ {
+		if err := b.submitJournaledBatch(nextUnconfirmed); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// submitBlock submits a TransitionBatch to the RollupTransitionBatchSubmitter and updates the DB
-// to indicate the last processed Geth Block included in the TransitionBatch.
-func (b *TransitionBatchBuilder) submitBlock(block *ActiveBatch) error {
-	// TODO: Submit to chain & get hash
-	logger.Debug("submitting transition batch", "block", block)
+// submitJournaledBatch submits the journaled TransitionBatch with the provided index to the
+// RollupTransitionBatchSubmitter, recording its progress in the journal. A batch whose
+// submission was already prepared is resubmitted with the same submissions so that it is
+// never included on L1 twice.
+func (b *TransitionBatchBuilder) submitJournaledBatch(index uint64) error {
+	journaled, err := ReadJournaledBatch(b.db, index)
+	if err != nil {
+		logger.Error("unable to decode journaled transition batch", "index", index, "error", err)
+		return ErrCorruptBatchJournal
+	}
+	if journaled == nil {
+		logger.Error("journaled transition batch not found", "index", index)
+		return ErrCorruptBatchJournal
+	}
+	transitionBatch, err := journaled.transitionBatch()
+	if err != nil {
+		logger.Error("unable to decode journaled transition batch", "index", index, "error", err)
+		return ErrCorruptBatchJournal
+	}
 
-	if err := b.rollupBatchSubmitter.submit(block.transitionBatch); err != nil {
-		return err
+	switch journaled.Status {
+	case BatchPending:
+		submission, err := b.rollupBatchSubmitter.prepare(transitionBatch)
+		if err != nil {
+			return err
+		}
+		journaled.Status, journaled.Submission = BatchSubmitted, submission
+		if err := writeJournaledBatch(b.db, index, journaled); err != nil {
+			logger.Error("error journaling prepared transition batch", "index", index, "error", err)
+			return err
+		}
+	case BatchSubmitted:
+		logger.Info("resuming submission of journaled transition batch", "index", index, "last block number", journaled.LastBlockNumber)
+	}
+
+	// Submissions that are not mined in time are replaced, journaling the replacement
+	// before it is sent.
+	for journaled.Status != BatchConfirmed {
+		err := b.rollupBatchSubmitter.submit(transitionBatch, journaled.submissions())
+		if err == nil {
+			journaled.Status = BatchConfirmed
+			break
+		}
+		if err != ErrBatchSubmissionTimedOut {
+			return err
+		}
+		submissions := journaled.submissions()
+		replacement, err := b.rollupBatchSubmitter.replace(transitionBatch, submissions)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(replacement, submissions[len(submissions)-1]) {
+			continue
+		}
+		journaled.Replacements = append(journaled.Replacements, replacement)
+		if err := writeJournaledBatch(b.db, index, journaled); err != nil {
+			logger.Error("error journaling replaced transition batch submission", "index", index, "error", err)
+			return err
+		}
+		batchReplacedMeter.Mark(1)
 	}
 
-	if err := b.db.Put(LastProcessedDBKey, SerializeBlockNumber(block.lastBlockNumber)); err != nil {
-		logger.Error("error saving last processed transition batch", "block", block)
-		// TODO: Something here
+	batch := b.db.NewBatch()
+	if err := writeJournaledBatch(batch, index, journaled); err != nil {
+		return err
+	}
+	if err := batch.Put(NextUnconfirmedBatchIndexDBKey, SerializeBlockNumber(index+1)); err != nil {
+		return err
 	}
-	logger.Debug("transition batch submitted", "block", block)
+	if err := batch.Write(); err != nil {
+		logger.Error("error journaling confirmed transition batch", "index", index, "error", err)
+		return err
+	}
+	batchConfirmedMeter.Mark(1)
+	logger.Debug("transition batch confirmed", "index", index, "last block number", journaled.LastBlockNumber)
 	return nil
 }
 
@@ -349,9 +1001,3 @@ func SerializeBlockNumber(blockNumber uint64) []byte {
 func DeserializeBlockNumber(blockNumber []byte) uint64 {
 	return binary.LittleEndian.Uint64(blockNumber)
 }
-
-// GetBlockRollupGasUsage determines the amount of L1 gas the provided Geth Block will use
-// when submitted to mainnet.
-func GetBlockRollupGasUsage(block *types.Block) uint64 {
-	return params.SstoreSetGas + uint64(len(block.Transactions()[0].Data()))*params.TxDataNonZeroGasEIP2028
-}
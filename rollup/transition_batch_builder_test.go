@@ -1,10 +1,14 @@
 package rollup
 
 import (
+	"errors"
+	"fmt"
 	"math/big"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/ethereum/go-ethereum/core/rawdb"
@@ -24,6 +28,11 @@ var (
 	testUserKey, _  = crypto.GenerateKey()
 	testUserAddress = crypto.PubkeyToAddress(testUserKey.PublicKey)
 	testRollupTxId  = hexutil.Uint64(2)
+
+	testPreparedSubmission    = []byte("prepared")
+	testReplacementSubmission = []byte("replacement")
+	testBackoff               = Backoff{Min: time.Millisecond, Max: 4 * time.Millisecond}
+	testCodec                 = rlpBatchCodec{}
 )
 
 func init() {
@@ -35,6 +44,7 @@ func init() {
 }
 
 type TestBlockStore struct {
+	mu     sync.RWMutex
 	blocks map[uint64]*types.Block
 }
 
@@ -48,15 +58,48 @@ func newTestBlockStore(blocks []*types.Block) *TestBlockStore {
 }
 
 func (t *TestBlockStore) GetBlockByNumber(number uint64) *types.Block {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
 	if block, found := t.blocks[number]; found {
 		return block
 	}
 	return nil
 }
 
+func (t *TestBlockStore) IntermediateRoots(block *types.Block) ([]common.Hash, error) {
+	return testIntermediateRoots(block), nil
+}
+
+// testIntermediateRoots returns distinct synthetic state roots for the transactions of the
+// block, the last of which is the block root.
+func testIntermediateRoots(block *types.Block) []common.Hash {
+	roots := make([]common.Hash, len(block.Transactions()))
+	for i := range roots {
+		roots[i] = crypto.Keccak256Hash(block.Root().Bytes(), []byte{byte(i)})
+	}
+	if len(roots) > 0 {
+		roots[len(roots)-1] = block.Root()
+	}
+	return roots
+}
+
+// setBlocks makes the provided blocks canonical, replacing any existing ones with the same number.
+func (t *TestBlockStore) setBlocks(blocks ...*types.Block) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, block := range blocks {
+		t.blocks[block.NumberU64()] = block
+	}
+}
+
 type TestTransitionBatchSubmitter struct {
 	submittedTransitions []*TransitionBatch
+	submissions          [][]byte // Last submission of each submitted batch
 	submitCh             chan *TransitionBatch
+
+	// submitErr is returned by the next submitFailures submissions.
+	submitErr      error
+	submitFailures int
 }
 
 func newTestBlockSubmitter(submittedBlocks []*TransitionBatch, submitCh chan *TransitionBatch) *TestTransitionBatchSubmitter {
@@ -66,8 +109,21 @@ func newTestBlockSubmitter(submittedBlocks []*TransitionBatch, submitCh chan *Tr
 	}
 }
 
-func (t *TestTransitionBatchSubmitter) submit(block *TransitionBatch) error {
+func (t *TestTransitionBatchSubmitter) prepare(block *TransitionBatch) ([]byte, error) {
+	return testPreparedSubmission, nil
+}
+
+func (t *TestTransitionBatchSubmitter) replace(block *TransitionBatch, submissions [][]byte) ([]byte, error) {
+	return testReplacementSubmission, nil
+}
+
+func (t *TestTransitionBatchSubmitter) submit(block *TransitionBatch, submissions [][]byte) error {
+	if t.submitFailures > 0 {
+		t.submitFailures--
+		return t.submitErr
+	}
 	t.submittedTransitions = append(t.submittedTransitions, block)
+	t.submissions = append(t.submissions, submissions[len(submissions)-1])
 	t.submitCh <- block
 	return nil
 }
@@ -87,6 +143,37 @@ func createBlocks(number int, startIndex int, withTx bool) types.Blocks {
 	return blocks
 }
 
+// createMultiTxBlock creates a block with the provided number of transactions.
+func createMultiTxBlock(number int, txCount int) *types.Block {
+	header := &types.Header{Number: big.NewInt(int64(number)), Root: common.BytesToHash(append([]byte("root"), byte(number)))}
+	txs := make(types.Transactions, txCount)
+	for i := range txs {
+		txs[i], _ = types.SignTx(types.NewTransaction(uint64(i), testUserAddress, big.NewInt(1), params.TxGas, big.NewInt(0), nil, &testUserAddress, &testRollupTxId, types.QueueOriginSequencer, types.SighashEIP155), types.HomesteadSigner{}, testBankKey)
+	}
+	return types.NewBlock(header, txs, make([]*types.Header, 0), make([]*types.Receipt, 0))
+}
+
+// createReorgBlocks creates blocks with the same numbers and transactions as the provided ones,
+// but different hashes and post state roots.
+func createReorgBlocks(blocks types.Blocks) types.Blocks {
+	reorged := make(types.Blocks, len(blocks))
+	for i, block := range blocks {
+		header := types.CopyHeader(block.Header())
+		header.Root = common.BytesToHash(append([]byte("reorg"), block.Number().Bytes()...))
+		reorged[i] = types.NewBlock(header, block.Transactions(), make([]*types.Header, 0), make([]*types.Receipt, 0))
+	}
+	return reorged
+}
+
+// batchGasUsage returns the L1 gas used by a TransitionBatch of the provided blocks.
+func batchGasUsage(t *testing.T, blocks types.Blocks) uint64 {
+	gas, err := TransitionBatchGasUsage(testCodec, newTestTransitionBatch(blocks))
+	if err != nil {
+		t.Fatalf("unable to compute batch gas usage: %v", err)
+	}
+	return gas
+}
+
 func assertTransitionFromBlock(t *testing.T, transition *Transition, block *types.Block) {
 	if transition.postState != block.Root() {
 		t.Fatal("expecting transitionBatch postState to equal block root", "postState", transition.postState, "block.Hash()", block.Root())
@@ -96,6 +183,24 @@ func assertTransitionFromBlock(t *testing.T, transition *Transition, block *type
 	}
 }
 
+func assertTransitionsFromBlock(t *testing.T, transitions []*Transition, block *types.Block) {
+	if len(transitions) != len(block.Transactions()) {
+		t.Fatalf("expected %d transitions, got %d", len(block.Transactions()), len(transitions))
+	}
+	roots := testIntermediateRoots(block)
+	for i, transition := range transitions {
+		if transition.postState != roots[i] {
+			t.Fatalf("transition %d: postState mismatch: have %x, want %x", i, transition.postState, roots[i])
+		}
+		if transition.transaction.Hash() != block.Transactions()[i].Hash() {
+			t.Fatalf("transition %d: tx hash mismatch: have %x, want %x", i, transition.transaction.Hash(), block.Transactions()[i].Hash())
+		}
+		if transition.context.BlockNumber != block.NumberU64() {
+			t.Fatalf("transition %d: block number mismatch: have %d, want %d", i, transition.context.BlockNumber, block.NumberU64())
+		}
+	}
+}
+
 func newTestTransitionBatchBuilder(blockStore *TestBlockStore, batchSubmitter *TestTransitionBatchSubmitter, lastProcessedBlock uint64, maxBlockTime time.Duration, maxBlockGas uint64, maxBlockTransactions int) (*TransitionBatchBuilder, error) {
 	db := rawdb.NewMemoryDatabase()
 
@@ -105,7 +210,33 @@ func newTestTransitionBatchBuilder(blockStore *TestBlockStore, batchSubmitter *T
 		}
 	}
 
-	return NewTransitionBatchBuilder(db, blockStore, batchSubmitter, maxBlockTime, maxBlockGas, maxBlockTransactions)
+	return NewTransitionBatchBuilder(db, blockStore, batchSubmitter, testCodec, maxBlockTime, maxBlockGas, maxBlockTransactions, testBackoff)
+}
+
+// waitFor polls the condition until it holds, failing the test with the description
+// returned by the condition if it does not hold within the timeout.
+func waitFor(t *testing.T, timeout time.Duration, condition func() (bool, string)) {
+	deadline := time.After(timeout)
+	for {
+		done, description := condition()
+		if done {
+			return
+		}
+		select {
+		case <-deadline:
+			t.Fatalf("timed out waiting for %s", description)
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+func waitForLastProcessed(t *testing.T, builder *TransitionBatchBuilder, number uint64) {
+	waitFor(t, timeoutDuration, func() (bool, string) {
+		builder.pendingMu.RLock()
+		lastProcessed := builder.lastProcessedBlockNumber
+		builder.pendingMu.RUnlock()
+		return lastProcessed == number, fmt.Sprintf("block %d to be processed, last processed: %d", number, lastProcessed)
+	})
 }
 
 func getSubmitChBlockStoreAndSubmitter() (chan *TransitionBatch, *TestBlockStore, *TestTransitionBatchSubmitter) {
@@ -167,7 +298,7 @@ func TestBatchSubmissionMaxGas(t *testing.T) {
 	batchSubmitCh, blockStore, batchSubmitter := getSubmitChBlockStoreAndSubmitter()
 
 	blocks := createBlocks(1, 1, true)
-	gasLimit := GetBlockRollupGasUsage(blocks[0]) + TransitionBatchGasBuffer
+	gasLimit := batchGasUsage(t, blocks[:1])
 
 	blockBuilder, err := newTestTransitionBatchBuilder(blockStore, batchSubmitter, 0, time.Minute*1, gasLimit, 2)
 	if err != nil {
@@ -192,7 +323,7 @@ func TestBlockLessThanMaxGas(t *testing.T) {
 	batchSubmitCh, blockStore, batchSubmitter := getSubmitChBlockStoreAndSubmitter()
 
 	blocks := createBlocks(1, 1, true)
-	gasLimit := GetBlockRollupGasUsage(blocks[0]) + TransitionBatchGasBuffer + MinTxGas
+	gasLimit := batchGasUsage(t, blocks[:1]) + MinTxGas
 
 	blockBuilder, err := newTestTransitionBatchBuilder(blockStore, batchSubmitter, 0, time.Minute*1, gasLimit, 2)
 	if err != nil {
@@ -226,14 +357,15 @@ func TestMultipleBatchSubmissionMaxTransactions(t *testing.T) {
 	select {
 	case transitionBatch := <-batchSubmitCh:
 		assertTransitionFromBlock(t, transitionBatch.transitions[0], blocks[0])
-		time.Sleep(time.Microsecond * 10)
-		if len(batchSubmitter.submittedTransitions) != 2 {
-			t.Fatal("Expected 2 batch to have been submitted", "numSubmitted", len(batchSubmitter.submittedTransitions))
-		}
-		assertTransitionFromBlock(t, batchSubmitter.submittedTransitions[1].transitions[0], blocks[1])
 	case <-timeout:
 		t.Fatalf("test timeout")
 	}
+	select {
+	case transitionBatch := <-batchSubmitCh:
+		assertTransitionFromBlock(t, transitionBatch.transitions[0], blocks[1])
+	case <-timeout:
+		t.Fatalf("test timeout waiting for second batch")
+	}
 }
 
 func TestMultipleBlocksLessThanMaxTransactions(t *testing.T) {
@@ -259,7 +391,11 @@ func TestMultipleBatchSubmissionMaxGas(t *testing.T) {
 	batchSubmitCh, blockStore, batchSubmitter := getSubmitChBlockStoreAndSubmitter()
 
 	blocks := createBlocks(2, 1, true)
-	gasLimit := GetBlockRollupGasUsage(blocks[0]) + TransitionBatchGasBuffer
+	// Each block fits in a batch on its own, as long as the other block is not in it.
+	gasLimit := batchGasUsage(t, blocks[:1])
+	if gas := batchGasUsage(t, blocks[1:]); gas > gasLimit {
+		gasLimit = gas
+	}
 
 	blockBuilder, err := newTestTransitionBatchBuilder(blockStore, batchSubmitter, 0, time.Minute*1, gasLimit, 3)
 	if err != nil {
@@ -273,21 +409,22 @@ func TestMultipleBatchSubmissionMaxGas(t *testing.T) {
 	select {
 	case transitionBatch := <-batchSubmitCh:
 		assertTransitionFromBlock(t, transitionBatch.transitions[0], blocks[0])
-		time.Sleep(time.Microsecond * 10)
-		if len(batchSubmitter.submittedTransitions) != 2 {
-			t.Fatal("Expected 2 batch to have been submitted", "numSubmitted", len(batchSubmitter.submittedTransitions))
-		}
-		assertTransitionFromBlock(t, batchSubmitter.submittedTransitions[1].transitions[0], blocks[1])
 	case <-timeout:
 		t.Fatalf("test timeout")
 	}
+	select {
+	case transitionBatch := <-batchSubmitCh:
+		assertTransitionFromBlock(t, transitionBatch.transitions[0], blocks[1])
+	case <-timeout:
+		t.Fatalf("test timeout waiting for second batch")
+	}
 }
 
 func TestMultipleBlocksLessThanMaxGas(t *testing.T) {
 	batchSubmitCh, blockStore, batchSubmitter := getSubmitChBlockStoreAndSubmitter()
 
 	blocks := createBlocks(2, 1, true)
-	gasLimit := 2 * (GetBlockRollupGasUsage(blocks[0]) + TransitionBatchGasBuffer + MinTxGas)
+	gasLimit := batchGasUsage(t, blocks) + MinTxGas
 
 	blockBuilder, err := newTestTransitionBatchBuilder(blockStore, batchSubmitter, 0, time.Minute*1, gasLimit, 3)
 	if err != nil {
@@ -489,3 +626,447 @@ func TestBatchSubmissionWithExistingDataNewBlocks(t *testing.T) {
 
 	}
 }
+
+/******************************
+ * Gap Filling and Reorg Tests *
+ ******************************/
+
+func TestBatchSubmissionOutOfOrderBlocks(t *testing.T) {
+	blocks := createBlocks(3, 1, true)
+
+	tests := []struct {
+		name   string
+		stored types.Blocks // Blocks added to the BlockStore before any block is received
+		sent   types.Blocks // Blocks received by the builder, in order
+	}{
+		{"in order", nil, blocks},
+		{"reversed", nil, types.Blocks{blocks[2], blocks[1], blocks[0]}},
+		{"shuffled", nil, types.Blocks{blocks[1], blocks[0], blocks[2]}},
+		{"gap fetched from store", blocks[:2], types.Blocks{blocks[2]}},
+		{"gap partially fetched from store", blocks[1:2], types.Blocks{blocks[2], blocks[0]}},
+		{"duplicates ignored", nil, types.Blocks{blocks[1], blocks[1], blocks[0], blocks[0], blocks[2]}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batchSubmitCh, blockStore, batchSubmitter := getSubmitChBlockStoreAndSubmitter()
+			blockBuilder, err := newTestTransitionBatchBuilder(blockStore, batchSubmitter, 0, time.Minute*1, 1_000_000_000, len(blocks))
+			if err != nil {
+				t.Fatalf("unable to make test batch builder, error: %v", err)
+			}
+			defer blockBuilder.Stop()
+
+			blockStore.setBlocks(tt.stored...)
+			for _, block := range tt.sent {
+				blockBuilder.NewBlock(block)
+			}
+
+			select {
+			case transitionBatch := <-batchSubmitCh:
+				if len(transitionBatch.transitions) != len(blocks) {
+					t.Fatalf("expected %d transitions, got %d", len(blocks), len(transitionBatch.transitions))
+				}
+				for i, block := range blocks {
+					assertTransitionFromBlock(t, transitionBatch.transitions[i], block)
+				}
+			case <-time.After(timeoutDuration):
+				t.Fatalf("test timeout")
+			}
+		})
+	}
+}
+
+func TestBatchSubmissionAfterReorg(t *testing.T) {
+	blocks := createBlocks(4, 1, true)
+	reorged := createReorgBlocks(blocks)
+
+	tests := []struct {
+		name      string
+		before    types.Blocks // Blocks received before the reorg
+		canonical types.Blocks // Blocks made canonical by the reorg
+		after     types.Blocks // Blocks received after the reorg
+		expected  types.Blocks // Blocks expected in the submitted batch
+	}{
+		{
+			name:      "last block replaced",
+			before:    blocks[:2],
+			canonical: reorged[1:2],
+			after:     types.Blocks{reorged[1], blocks[2], blocks[3]},
+			expected:  types.Blocks{blocks[0], reorged[1], blocks[2], blocks[3]},
+		},
+		{
+			name:      "multiple blocks replaced",
+			before:    blocks[:3],
+			canonical: reorged[1:3],
+			after:     types.Blocks{reorged[1], blocks[3]},
+			expected:  types.Blocks{blocks[0], reorged[1], reorged[2], blocks[3]},
+		},
+		{
+			name:      "first block replaced",
+			before:    blocks[:3],
+			canonical: reorged[:3],
+			after:     types.Blocks{reorged[0], blocks[3]},
+			expected:  types.Blocks{reorged[0], reorged[1], reorged[2], blocks[3]},
+		},
+		{
+			name:     "non-canonical block ignored",
+			before:   blocks[:2],
+			after:    types.Blocks{reorged[1], blocks[2], blocks[3]},
+			expected: blocks,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batchSubmitCh, blockStore, batchSubmitter := getSubmitChBlockStoreAndSubmitter()
+			blockBuilder, err := newTestTransitionBatchBuilder(blockStore, batchSubmitter, 0, time.Minute*1, 1_000_000_000, len(blocks))
+			if err != nil {
+				t.Fatalf("unable to make test batch builder, error: %v", err)
+			}
+			defer blockBuilder.Stop()
+
+			blockStore.setBlocks(tt.before...)
+			for _, block := range tt.before {
+				blockBuilder.NewBlock(block)
+			}
+			waitForLastProcessed(t, blockBuilder, tt.before[len(tt.before)-1].NumberU64())
+
+			blockStore.setBlocks(tt.canonical...)
+			for _, block := range tt.after {
+				blockBuilder.NewBlock(block)
+			}
+
+			select {
+			case transitionBatch := <-batchSubmitCh:
+				if len(transitionBatch.transitions) != len(tt.expected) {
+					t.Fatalf("expected %d transitions, got %d", len(tt.expected), len(transitionBatch.transitions))
+				}
+				for i, block := range tt.expected {
+					assertTransitionFromBlock(t, transitionBatch.transitions[i], block)
+				}
+			case <-time.After(timeoutDuration):
+				t.Fatalf("test timeout")
+			}
+		})
+	}
+}
+
+/*****************
+ * Failure Tests *
+ *****************/
+
+func waitForBuilderState(t *testing.T, builder *TransitionBatchBuilder, state string) *BuilderStatus {
+	var status *BuilderStatus
+	waitFor(t, timeoutDuration, func() (bool, string) {
+		status = builder.Status()
+		return status.State == state, fmt.Sprintf("builder state %q, last status: %+v", state, status)
+	})
+	return status
+}
+
+func TestBackoffDelay(t *testing.T) {
+	backoff := Backoff{Min: time.Second, Max: 5 * time.Second}
+	tests := []struct {
+		failures uint64
+		want     time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{4, 5 * time.Second},
+		{100, 5 * time.Second},
+	}
+	for _, tt := range tests {
+		if have := backoff.delay(tt.failures); have != tt.want {
+			t.Errorf("failures %d: delay mismatch: have %v, want %v", tt.failures, have, tt.want)
+		}
+	}
+}
+
+func TestBatchSubmissionRetriedAfterFailure(t *testing.T) {
+	batchSubmitCh, blockStore, batchSubmitter := getSubmitChBlockStoreAndSubmitter()
+	batchSubmitter.submitErr, batchSubmitter.submitFailures = errors.New("L1 unavailable"), 3
+
+	blocks := createBlocks(2, 1, true)
+	for _, block := range blocks {
+		blockStore.blocks[block.NumberU64()] = block
+	}
+	blockBuilder, err := newTestTransitionBatchBuilder(blockStore, batchSubmitter, 0, time.Minute*1, 1_000_000_000, 1)
+	if err != nil {
+		t.Fatalf("unable to make test batch builder, error: %v", err)
+	}
+	defer blockBuilder.Stop()
+
+	for i, block := range blocks {
+		select {
+		case transitionBatch := <-batchSubmitCh:
+			assertTransitionFromBlock(t, transitionBatch.transitions[0], block)
+		case <-time.After(timeoutDuration):
+			t.Fatalf("test timeout waiting for batch %d", i)
+		}
+	}
+
+	status := waitForBuilderState(t, blockBuilder, BuilderHealthy)
+	if status.ConsecutiveFailures != 0 {
+		t.Fatalf("expected failures to be reset, got %d", status.ConsecutiveFailures)
+	}
+	if status.LastError != batchSubmitter.submitErr.Error() {
+		t.Fatalf("expected last error %q, got %q", batchSubmitter.submitErr, status.LastError)
+	}
+	if status.NextUnconfirmedBatchIndex != 2 || status.LastProcessedBlock != 2 {
+		t.Fatalf("unexpected progress after recovery: %+v", status)
+	}
+}
+
+func TestBuilderHaltsOnUnrecoverableError(t *testing.T) {
+	batchSubmitCh, blockStore, batchSubmitter := getSubmitChBlockStoreAndSubmitter()
+	batchSubmitter.submitErr, batchSubmitter.submitFailures = ErrBatchSubmissionReverted, 1
+
+	blockBuilder, err := newTestTransitionBatchBuilder(blockStore, batchSubmitter, 0, time.Minute*1, 1_000_000_000, 1)
+	if err != nil {
+		t.Fatalf("unable to make test batch builder, error: %v", err)
+	}
+	defer blockBuilder.Stop()
+
+	blocks := createBlocks(2, 1, true)
+	blockBuilder.NewBlock(blocks[0])
+
+	status := waitForBuilderState(t, blockBuilder, BuilderHalted)
+	if status.LastError != ErrBatchSubmissionReverted.Error() {
+		t.Fatalf("expected last error %q, got %q", ErrBatchSubmissionReverted, status.LastError)
+	}
+
+	// Blocks received after halting are neither built nor submitted
+	blockBuilder.NewBlock(blocks[1])
+	select {
+	case <-batchSubmitCh:
+		t.Fatalf("no batch should be submitted after halting")
+	case <-time.After(timeoutDuration):
+	}
+	if status := blockBuilder.Status(); status.NextBatchIndex != 1 || status.NextUnconfirmedBatchIndex != 0 {
+		t.Fatalf("expected the failed batch to remain journaled, got %+v", status)
+	}
+}
+
+func TestBuilderHaltsOnJournaledBlockReorg(t *testing.T) {
+	batchSubmitCh, blockStore, batchSubmitter := getSubmitChBlockStoreAndSubmitter()
+	blockBuilder, err := newTestTransitionBatchBuilder(blockStore, batchSubmitter, 0, time.Minute*1, 1_000_000_000, 1)
+	if err != nil {
+		t.Fatalf("unable to make test batch builder, error: %v", err)
+	}
+	defer blockBuilder.Stop()
+
+	blocks := createBlocks(2, 1, true)
+	blockStore.setBlocks(blocks...)
+	for i, block := range blocks {
+		blockBuilder.NewBlock(block)
+		select {
+		case <-batchSubmitCh:
+		case <-time.After(timeoutDuration):
+			t.Fatalf("test timeout waiting for batch %d", i)
+		}
+	}
+
+	// Journaled blocks received again and non-canonical replacements are ignored
+	reorged := createReorgBlocks(blocks)
+	blockBuilder.NewBlock(blocks[0])
+	blockBuilder.NewBlock(reorged[0])
+	time.Sleep(timeoutDuration)
+	if status := blockBuilder.Status(); status.State != BuilderHealthy {
+		t.Fatalf("expected builder to remain healthy, got %+v", status)
+	}
+
+	// A canonical replacement of a journaled block cannot be unwound
+	blockStore.setBlocks(reorged[0])
+	blockBuilder.NewBlock(reorged[0])
+
+	status := waitForBuilderState(t, blockBuilder, BuilderHalted)
+	if status.LastError != ErrJournaledBlockReorged.Error() {
+		t.Fatalf("expected last error %q, got %q", ErrJournaledBlockReorged, status.LastError)
+	}
+}
+
+// blockingBatchSubmitter is a TestTransitionBatchSubmitter whose submissions block
+// until released, signalling on submitting when one starts.
+type blockingBatchSubmitter struct {
+	*TestTransitionBatchSubmitter
+	submitting chan struct{}
+	release    chan struct{}
+}
+
+func (b *blockingBatchSubmitter) submit(block *TransitionBatch, submissions [][]byte) error {
+	b.submitting <- struct{}{}
+	<-b.release
+	return b.TestTransitionBatchSubmitter.submit(block, submissions)
+}
+
+func TestStatusWhileSubmissionBlocked(t *testing.T) {
+	batchSubmitCh, blockStore, testSubmitter := getSubmitChBlockStoreAndSubmitter()
+	batchSubmitter := &blockingBatchSubmitter{
+		TestTransitionBatchSubmitter: testSubmitter,
+		submitting:                   make(chan struct{}, 1),
+		release:                      make(chan struct{}),
+	}
+	blockBuilder, err := NewTransitionBatchBuilder(rawdb.NewMemoryDatabase(), blockStore, batchSubmitter, testCodec, time.Minute*1, 1_000_000_000, 1, testBackoff)
+	if err != nil {
+		t.Fatalf("unable to make test batch builder, error: %v", err)
+	}
+	defer blockBuilder.Stop()
+
+	blocks := createBlocks(1, 1, true)
+	blockBuilder.NewBlock(blocks[0])
+	select {
+	case <-batchSubmitter.submitting:
+	case <-time.After(timeoutDuration):
+		t.Fatalf("test timeout waiting for submission")
+	}
+
+	statusCh := make(chan *BuilderStatus, 1)
+	go func() { statusCh <- blockBuilder.Status() }()
+	select {
+	case status := <-statusCh:
+		if status.State != BuilderHealthy || status.LastProcessedBlock != 1 || status.NextBatchIndex != 1 || status.NextUnconfirmedBatchIndex != 0 {
+			t.Fatalf("unexpected status during submission: %+v", status)
+		}
+	case <-time.After(timeoutDuration):
+		t.Fatalf("status blocked by in-flight submission")
+	}
+
+	close(batchSubmitter.release)
+	select {
+	case transitionBatch := <-batchSubmitCh:
+		assertTransitionFromBlock(t, transitionBatch.transitions[0], blocks[0])
+	case <-time.After(timeoutDuration):
+		t.Fatalf("test timeout waiting for batch")
+	}
+}
+
+func TestNewBlocksBuiltWhileSubmissionBlocked(t *testing.T) {
+	batchSubmitCh, blockStore, testSubmitter := getSubmitChBlockStoreAndSubmitter()
+	batchSubmitter := &blockingBatchSubmitter{
+		TestTransitionBatchSubmitter: testSubmitter,
+		submitting:                   make(chan struct{}, 2),
+		release:                      make(chan struct{}),
+	}
+	blockBuilder, err := NewTransitionBatchBuilder(rawdb.NewMemoryDatabase(), blockStore, batchSubmitter, testCodec, time.Minute*1, 1_000_000_000, 1, testBackoff)
+	if err != nil {
+		t.Fatalf("unable to make test batch builder, error: %v", err)
+	}
+	defer blockBuilder.Stop()
+
+	blocks := createBlocks(2, 1, true)
+	for _, block := range blocks {
+		blockBuilder.NewBlock(block)
+	}
+	// Both batches are journaled while the first one is still being submitted
+	waitFor(t, timeoutDuration, func() (bool, string) {
+		status := blockBuilder.Status()
+		return status.NextBatchIndex == 2 && status.NextUnconfirmedBatchIndex == 0, fmt.Sprintf("both batches to be journaled, last status: %+v", status)
+	})
+
+	close(batchSubmitter.release)
+	for i, block := range blocks {
+		select {
+		case transitionBatch := <-batchSubmitCh:
+			assertTransitionFromBlock(t, transitionBatch.transitions[0], block)
+		case <-time.After(timeoutDuration):
+			t.Fatalf("test timeout waiting for batch %d", i)
+		}
+	}
+}
+
+/*********************************
+ * Multi-Transaction Block Tests *
+ *********************************/
+
+func TestMultiTransactionBlockSubmission(t *testing.T) {
+	batchSubmitCh, blockStore, batchSubmitter := getSubmitChBlockStoreAndSubmitter()
+	blockBuilder, err := newTestTransitionBatchBuilder(blockStore, batchSubmitter, 0, time.Minute*1, 1_000_000_000, 3)
+	if err != nil {
+		t.Fatalf("unable to make test batch builder, error: %v", err)
+	}
+	defer blockBuilder.Stop()
+
+	block := createMultiTxBlock(1, 3)
+	blockBuilder.NewBlock(block)
+
+	select {
+	case transitionBatch := <-batchSubmitCh:
+		assertTransitionsFromBlock(t, transitionBatch.transitions, block)
+	case <-time.After(timeoutDuration):
+		t.Fatalf("test timeout")
+	}
+}
+
+func TestMultiTransactionBlockNotSplitAcrossBatches(t *testing.T) {
+	batchSubmitCh, blockStore, batchSubmitter := getSubmitChBlockStoreAndSubmitter()
+	blockBuilder, err := newTestTransitionBatchBuilder(blockStore, batchSubmitter, 0, time.Minute*1, 1_000_000_000, 3)
+	if err != nil {
+		t.Fatalf("unable to make test batch builder, error: %v", err)
+	}
+	defer blockBuilder.Stop()
+
+	first, second := createMultiTxBlock(1, 2), createMultiTxBlock(2, 2)
+	blockBuilder.NewBlock(first)
+	blockBuilder.NewBlock(second)
+
+	// The second block does not fit next to the first, which is submitted on its own.
+	select {
+	case transitionBatch := <-batchSubmitCh:
+		assertTransitionsFromBlock(t, transitionBatch.transitions, first)
+	case <-time.After(timeoutDuration):
+		t.Fatalf("test timeout")
+	}
+	waitForLastProcessed(t, blockBuilder, 2)
+
+	blockBuilder.pendingMu.RLock()
+	pending := blockBuilder.activeBatch.transitionBatch.transitions
+	blockBuilder.pendingMu.RUnlock()
+	assertTransitionsFromBlock(t, pending, second)
+}
+
+func TestBuilderHaltsOnBlockTooLarge(t *testing.T) {
+	batchSubmitCh, blockStore, batchSubmitter := getSubmitChBlockStoreAndSubmitter()
+	blockBuilder, err := newTestTransitionBatchBuilder(blockStore, batchSubmitter, 0, time.Minute*1, 1_000_000_000, 2)
+	if err != nil {
+		t.Fatalf("unable to make test batch builder, error: %v", err)
+	}
+	defer blockBuilder.Stop()
+
+	blockBuilder.NewBlock(createMultiTxBlock(1, 3))
+
+	status := waitForBuilderState(t, blockBuilder, BuilderHalted)
+	if status.LastError != ErrBlockTooLarge.Error() {
+		t.Fatalf("expected last error %q, got %q", ErrBlockTooLarge, status.LastError)
+	}
+	select {
+	case <-batchSubmitCh:
+		t.Fatalf("no batch should be submitted for a block exceeding the batch limits")
+	case <-time.After(timeoutDuration):
+	}
+}
+
+func TestBlockBatch(t *testing.T) {
+	block := createMultiTxBlock(1, 3)
+	gas := batchGasUsage(t, types.Blocks{block})
+
+	tests := []struct {
+		maxGas uint64
+		maxTxs int
+		fits   int
+	}{
+		{gas, 3, 3},
+		{gas - 1, 3, 2},
+		{gas, 2, 2},
+	}
+	for i, tt := range tests {
+		batch := NewBlockBatch(block.Header(), testCodec, tt.maxGas, tt.maxTxs)
+		roots := testIntermediateRoots(block)
+		for j, tx := range block.Transactions() {
+			if have, want := batch.Add(tx, roots[j]), j < tt.fits; have != want {
+				t.Errorf("test %d: transaction %d fits mismatch: have %t, want %t", i, j, have, want)
+			}
+		}
+		if have := len(batch.batch.transitionBatch.transitions); have != tt.fits {
+			t.Errorf("test %d: transitions mismatch: have %d, want %d", i, have, tt.fits)
+		}
+	}
+}
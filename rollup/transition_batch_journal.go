@@ -0,0 +1,179 @@
+package rollup
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// BatchStatus is the submission state of a journaled TransitionBatch.
+type BatchStatus uint8
+
+const (
+	// BatchPending is a built TransitionBatch that has not been prepared for L1 submission.
+	BatchPending BatchStatus = iota
+	// BatchSubmitted is a TransitionBatch whose L1 submission has been prepared and possibly sent.
+	BatchSubmitted
+	// BatchConfirmed is a TransitionBatch whose L1 submission has been confirmed.
+	BatchConfirmed
+)
+
+func (s BatchStatus) String() string {
+	switch s {
+	case BatchPending:
+		return "pending"
+	case BatchSubmitted:
+		return "submitted"
+	case BatchConfirmed:
+		return "confirmed"
+	default:
+		return "unknown"
+	}
+}
+
+var (
+	ErrCorruptBatchJournal = errors.New("transition batch journal is corrupt")
+
+	// BatchJournalPrefix + index (uint64 big endian) -> RLP(JournaledBatch)
+	BatchJournalPrefix = []byte("rollupBatchJournal")
+	// NextBatchIndexDBKey tracks the index the next built TransitionBatch will be journaled under.
+	NextBatchIndexDBKey = []byte("rollupNextBatchIndex")
+	// NextUnconfirmedBatchIndexDBKey tracks the index of the oldest journaled TransitionBatch that is not confirmed.
+	NextUnconfirmedBatchIndexDBKey = []byte("rollupNextUnconfirmedBatchIndex")
+)
+
+// JournaledBatch is the on-disk representation of a built TransitionBatch and its
+// L1 submission progress.
+type JournaledBatch struct {
+	Status               BatchStatus
+	FirstBlockNumber     uint64
+	LastBlockNumber      uint64
+	FirstTransitionIndex uint64 // Index of the first Transition of the batch across all batches
+	Transitions          []encodedTransition
+	Submission           []byte   // Submitter specific L1 submission, set once Status is BatchSubmitted
+	Replacements         [][]byte `rlp:"tail"` // Submissions replacing Submission, in the order they were sent
+}
+
+func newJournaledBatch(batch *TransitionBatch, status BatchStatus, firstBlockNumber, lastBlockNumber, firstTransitionIndex uint64) *JournaledBatch {
+	journaled := &JournaledBatch{
+		Status:               status,
+		FirstBlockNumber:     firstBlockNumber,
+		LastBlockNumber:      lastBlockNumber,
+		FirstTransitionIndex: firstTransitionIndex,
+		Transitions:          make([]encodedTransition, len(batch.transitions)),
+	}
+	for i, transition := range batch.transitions {
+		journaled.Transitions[i] = newEncodedTransition(transition)
+	}
+	return journaled
+}
+
+// submissions returns the L1 submission of the batch followed by its replacements.
+func (j *JournaledBatch) submissions() [][]byte {
+	return append([][]byte{j.Submission}, j.Replacements...)
+}
+
+// transitionBatch reconstructs the TransitionBatch that was journaled.
+func (j *JournaledBatch) transitionBatch() (*TransitionBatch, error) {
+	batch := NewTransitionBatch(len(j.Transitions))
+	for _, encoded := range j.Transitions {
+		transition, err := encoded.transition()
+		if err != nil {
+			return nil, err
+		}
+		batch.transitions = append(batch.transitions, transition)
+	}
+	return batch, nil
+}
+
+// batchJournalKey = BatchJournalPrefix + index (uint64 big endian)
+func batchJournalKey(index uint64) []byte {
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, index)
+	return append(append([]byte{}, BatchJournalPrefix...), enc...)
+}
+
+// ReadJournaledBatch retrieves the journaled TransitionBatch with the provided index,
+// returning nil if it does not exist.
+func ReadJournaledBatch(db ethdb.KeyValueReader, index uint64) (*JournaledBatch, error) {
+	data, _ := db.Get(batchJournalKey(index))
+	if len(data) == 0 {
+		return nil, nil
+	}
+	batch := new(JournaledBatch)
+	if err := rlp.DecodeBytes(data, batch); err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
+
+// writeJournaledBatch stores the journaled TransitionBatch under the provided index.
+func writeJournaledBatch(db ethdb.KeyValueWriter, index uint64, batch *JournaledBatch) error {
+	data, err := rlp.EncodeToBytes(batch)
+	if err != nil {
+		return err
+	}
+	return db.Put(batchJournalKey(index), data)
+}
+
+// readJournalIndex reads one of the journal indices, defaulting to 0.
+func readJournalIndex(db ethdb.KeyValueReader, key []byte) uint64 {
+	data, _ := db.Get(key)
+	if len(data) != 8 {
+		return 0
+	}
+	return DeserializeBlockNumber(data)
+}
+
+// nextTransitionIndex returns the index the first Transition of the TransitionBatch
+// journaled under the provided index will have.
+func nextTransitionIndex(db ethdb.KeyValueReader, nextBatchIndex uint64) (uint64, error) {
+	if nextBatchIndex == 0 {
+		return 0, nil
+	}
+	previous, err := ReadJournaledBatch(db, nextBatchIndex-1)
+	if err != nil {
+		return 0, err
+	}
+	if previous == nil {
+		return 0, ErrCorruptBatchJournal
+	}
+	return previous.FirstTransitionIndex + uint64(len(previous.Transitions)), nil
+}
+
+// FindJournaledTransition finds the journaled TransitionBatch holding the Transition with
+// the provided index, returning the index of the batch along with it. Nil is returned if
+// no such Transition has been journaled.
+func FindJournaledTransition(db ethdb.KeyValueReader, transitionIndex uint64) (uint64, *JournaledBatch, error) {
+	_, next := ReadBatchJournalIndices(db)
+	// Binary search for the last batch starting at or before the transition.
+	lo, hi := uint64(0), next
+	var found *JournaledBatch
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		batch, err := ReadJournaledBatch(db, mid)
+		if err != nil {
+			return 0, nil, err
+		}
+		if batch == nil {
+			return 0, nil, ErrCorruptBatchJournal
+		}
+		if batch.FirstTransitionIndex <= transitionIndex {
+			lo, found = mid+1, batch
+		} else {
+			hi = mid
+		}
+	}
+	if found == nil || transitionIndex >= found.FirstTransitionIndex+uint64(len(found.Transitions)) {
+		return 0, nil, nil
+	}
+	return lo - 1, found, nil
+}
+
+// ReadBatchJournalIndices returns the index of the oldest unconfirmed journaled
+// TransitionBatch and the index the next TransitionBatch will be journaled under.
+func ReadBatchJournalIndices(db ethdb.KeyValueReader) (nextUnconfirmed uint64, next uint64) {
+	return readJournalIndex(db, NextUnconfirmedBatchIndexDBKey), readJournalIndex(db, NextBatchIndexDBKey)
+}
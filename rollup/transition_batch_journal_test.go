@@ -0,0 +1,210 @@
+package rollup
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func assertJournaledBatchStatus(t *testing.T, db ethdb.KeyValueReader, index uint64, status BatchStatus) {
+	journaled, err := ReadJournaledBatch(db, index)
+	if err != nil {
+		t.Fatalf("unable to read journaled batch %d: %v", index, err)
+	}
+	if journaled == nil {
+		t.Fatalf("journaled batch %d not found", index)
+	}
+	if journaled.Status != status {
+		t.Fatalf("expected journaled batch %d to be %s, got %s", index, status, journaled.Status)
+	}
+}
+
+func TestBatchJournaledOnSubmission(t *testing.T) {
+	batchSubmitCh, blockStore, batchSubmitter := getSubmitChBlockStoreAndSubmitter()
+	blockBuilder, err := newTestTransitionBatchBuilder(blockStore, batchSubmitter, 0, time.Minute*1, 1_000_000_000, 1)
+	if err != nil {
+		t.Fatalf("unable to make test batch builder, error: %v", err)
+	}
+
+	blocks := createBlocks(1, 1, true)
+	blockBuilder.NewBlock(blocks[0])
+
+	select {
+	case <-batchSubmitCh:
+	case <-time.After(timeoutDuration):
+		t.Fatalf("test timeout")
+	}
+	time.Sleep(time.Millisecond * 10)
+
+	assertJournaledBatchStatus(t, blockBuilder.db, 0, BatchConfirmed)
+	if nextUnconfirmed, next := ReadBatchJournalIndices(blockBuilder.db); nextUnconfirmed != 1 || next != 1 {
+		t.Fatalf("expected journal indices 1 and 1, got %d and %d", nextUnconfirmed, next)
+	}
+	if lastProcessed, _ := fetchLastProcessedBlockNumber(blockBuilder.db); lastProcessed != 1 {
+		t.Fatalf("expected last processed block 1, got %d", lastProcessed)
+	}
+}
+
+func TestJournaledBatchResumedOnRestart(t *testing.T) {
+	journaledSubmission := []byte("journaled")
+
+	tests := []struct {
+		name               string
+		status             BatchStatus
+		expectedSubmission []byte
+	}{
+		{"pending batch is prepared and submitted", BatchPending, testPreparedSubmission},
+		{"submitted batch is resubmitted unchanged", BatchSubmitted, journaledSubmission},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blocks := createBlocks(2, 1, true)
+			batchSubmitCh := make(chan *TransitionBatch, 10)
+			blockStore, batchSubmitter := newTestBlockStore(blocks), newTestBlockSubmitter(make([]*TransitionBatch, 0), batchSubmitCh)
+
+			// Simulate a crash after both blocks were journaled in a single batch.
+			active := newActiveBatch(2)
+			for _, block := range blocks {
+				if err := active.addBlock(block, testIntermediateRoots(block), testCodec, 1_000_000_000, 2); err != nil {
+					t.Fatalf("unable to add block: %v", err)
+				}
+			}
+			journaled := newJournaledBatch(active.transitionBatch, tt.status, active.firstBlockNumber, active.lastBlockNumber, 0)
+			if tt.status == BatchSubmitted {
+				journaled.Submission = journaledSubmission
+			}
+
+			db := rawdb.NewMemoryDatabase()
+			if err := writeJournaledBatch(db, 0, journaled); err != nil {
+				t.Fatalf("unable to journal batch: %v", err)
+			}
+			db.Put(NextBatchIndexDBKey, SerializeBlockNumber(1))
+			db.Put(LastProcessedDBKey, SerializeBlockNumber(2))
+
+			if _, err := NewTransitionBatchBuilder(db, blockStore, batchSubmitter, testCodec, time.Minute*1, 1_000_000_000, 1, testBackoff); err != nil {
+				t.Fatalf("unable to make test batch builder, error: %v", err)
+			}
+
+			select {
+			case transitionBatch := <-batchSubmitCh:
+				if len(transitionBatch.transitions) != 2 {
+					t.Fatalf("expected 2 transitions, got %d", len(transitionBatch.transitions))
+				}
+				for i, block := range blocks {
+					assertTransitionFromBlock(t, transitionBatch.transitions[i], block)
+				}
+			case <-time.After(timeoutDuration):
+				t.Fatalf("test timeout")
+			}
+
+			// The journaled blocks must not be rebuilt into new batches.
+			select {
+			case <-batchSubmitCh:
+				t.Fatalf("journaled blocks should not have been resubmitted")
+			case <-time.After(timeoutDuration):
+			}
+
+			if !bytes.Equal(batchSubmitter.submissions[0], tt.expectedSubmission) {
+				t.Fatalf("expected submission %q, got %q", tt.expectedSubmission, batchSubmitter.submissions[0])
+			}
+			assertJournaledBatchStatus(t, db, 0, BatchConfirmed)
+			if nextUnconfirmed, _ := ReadBatchJournalIndices(db); nextUnconfirmed != 1 {
+				t.Fatalf("expected next unconfirmed batch 1, got %d", nextUnconfirmed)
+			}
+		})
+	}
+}
+
+func TestTimedOutSubmissionReplaced(t *testing.T) {
+	batchSubmitCh, blockStore, batchSubmitter := getSubmitChBlockStoreAndSubmitter()
+	batchSubmitter.submitErr, batchSubmitter.submitFailures = ErrBatchSubmissionTimedOut, 1
+	blockBuilder, err := newTestTransitionBatchBuilder(blockStore, batchSubmitter, 0, time.Minute*1, 1_000_000_000, 1)
+	if err != nil {
+		t.Fatalf("unable to make test batch builder, error: %v", err)
+	}
+	blockBuilder.NewBlock(createBlocks(1, 1, true)[0])
+
+	select {
+	case <-batchSubmitCh:
+	case <-time.After(timeoutDuration):
+		t.Fatalf("test timeout")
+	}
+	time.Sleep(time.Millisecond * 10)
+
+	if !bytes.Equal(batchSubmitter.submissions[0], testReplacementSubmission) {
+		t.Fatalf("expected submission %q, got %q", testReplacementSubmission, batchSubmitter.submissions[0])
+	}
+	journaled, err := ReadJournaledBatch(blockBuilder.db, 0)
+	if err != nil || journaled == nil {
+		t.Fatalf("unable to read journaled batch: %v", err)
+	}
+	if journaled.Status != BatchConfirmed || !bytes.Equal(journaled.Submission, testPreparedSubmission) || len(journaled.Replacements) != 1 {
+		t.Fatalf("unexpected journaled batch: status %s, submission %q, %d replacements", journaled.Status, journaled.Submission, len(journaled.Replacements))
+	}
+	if status := blockBuilder.Status(); status.ConsecutiveFailures != 0 {
+		t.Fatalf("timed out submissions counted as %d failures", status.ConsecutiveFailures)
+	}
+}
+
+// Tests that batches journaled before submissions could be replaced still decode.
+func TestJournaledBatchWithoutReplacements(t *testing.T) {
+	legacy := struct {
+		Status               BatchStatus
+		FirstBlockNumber     uint64
+		LastBlockNumber      uint64
+		FirstTransitionIndex uint64
+		Transitions          []encodedTransition
+		Submission           []byte
+	}{BatchSubmitted, 1, 1, 0, nil, testPreparedSubmission}
+	data, err := rlp.EncodeToBytes(&legacy)
+	if err != nil {
+		t.Fatalf("unable to encode journaled batch: %v", err)
+	}
+	db := rawdb.NewMemoryDatabase()
+	db.Put(batchJournalKey(0), data)
+
+	journaled, err := ReadJournaledBatch(db, 0)
+	if err != nil {
+		t.Fatalf("unable to decode journaled batch: %v", err)
+	}
+	if submissions := journaled.submissions(); len(submissions) != 1 || !bytes.Equal(submissions[0], testPreparedSubmission) {
+		t.Fatalf("unexpected submissions %q", submissions)
+	}
+}
+
+// Tests that batches journaled before TransitionContexts held the L1 block number still decode.
+func TestJournaledBatchWithV0Contexts(t *testing.T) {
+	blocks := createBlocks(2, 1, true)
+	batch := newTestTransitionBatch(blocks)
+	legacy := struct {
+		Status               BatchStatus
+		FirstBlockNumber     uint64
+		LastBlockNumber      uint64
+		FirstTransitionIndex uint64
+		Transitions          []v0EncodedTransition
+		Submission           []byte
+	}{BatchConfirmed, 1, 2, 0, newV0EncodedTransitions(batch), testPreparedSubmission}
+	data, err := rlp.EncodeToBytes(&legacy)
+	if err != nil {
+		t.Fatalf("unable to encode journaled batch: %v", err)
+	}
+	db := rawdb.NewMemoryDatabase()
+	db.Put(batchJournalKey(0), data)
+
+	journaled, err := ReadJournaledBatch(db, 0)
+	if err != nil {
+		t.Fatalf("unable to decode journaled batch: %v", err)
+	}
+	decoded, err := journaled.transitionBatch()
+	if err != nil {
+		t.Fatalf("unable to decode journaled transitions: %v", err)
+	}
+	for i, block := range blocks {
+		assertTransitionFromBlock(t, decoded.transitions[i], block)
+	}
+}
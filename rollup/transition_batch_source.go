@@ -0,0 +1,155 @@
+package rollup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// TransitionBatchSource provides the TransitionBatches appended to the canonical
+// transition chain, in the order they were appended.
+type TransitionBatchSource interface {
+	// Batch returns the TransitionBatch with the provided index, or nil if it is not
+	// available yet. Indices are requested in increasing order.
+	Batch(ctx context.Context, index uint64) (*TransitionBatch, error)
+}
+
+// InvalidBatchError is returned by a TransitionBatchSource for an appended
+// TransitionBatch that cannot be decoded.
+type InvalidBatchError struct {
+	Index uint64
+	Err   error
+}
+
+func (e *InvalidBatchError) Error() string {
+	return fmt.Sprintf("invalid transition batch %d: %v", e.Index, e.Err)
+}
+
+// decodeSourceBatch decodes the encoded TransitionBatch with the provided index.
+func decodeSourceBatch(index uint64, encoded []byte) (*TransitionBatch, error) {
+	batch, err := DecodeTransitionBatch(encoded)
+	if err != nil {
+		return nil, &InvalidBatchError{Index: index, Err: err}
+	}
+	return batch, nil
+}
+
+// FileBatchSource is a TransitionBatchSource backed by a fixture file holding a JSON
+// array of hex encoded TransitionBatches, as produced by EncodeTransitionBatch.
+type FileBatchSource struct {
+	batches []hexutil.Bytes
+}
+
+// NewFileBatchSource loads the TransitionBatches of the fixture file at the provided path.
+func NewFileBatchSource(path string) (*FileBatchSource, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	source := new(FileBatchSource)
+	if err := json.Unmarshal(data, &source.batches); err != nil {
+		return nil, fmt.Errorf("invalid transition batch fixture %s: %v", path, err)
+	}
+	return source, nil
+}
+
+func (s *FileBatchSource) Batch(ctx context.Context, index uint64) (*TransitionBatch, error) {
+	if index >= uint64(len(s.batches)) {
+		return nil, nil
+	}
+	return decodeSourceBatch(index, s.batches[index])
+}
+
+// L1BatchReader is the L1 chain access needed by the L1BatchSource.
+// It is satisfied by both *ethclient.Client and *backends.SimulatedBackend.
+type L1BatchReader interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// L1BatchSource is a TransitionBatchSource reading the TransitionBatches appended to
+// the L1 canonical transition chain contract. It scans confirmed L1 blocks for
+// successful transactions calling appendTransitionBatch on the contract directly and
+// numbers the appended TransitionBatches from 0, starting at the provided L1 block.
+// It is not safe for concurrent use.
+type L1BatchSource struct {
+	backend       L1BatchReader
+	contract      common.Address
+	confirmations uint64
+
+	nextBlock  uint64   // Next L1 block to scan
+	firstIndex uint64   // Index of the first scanned TransitionBatch that was not requested
+	batches    [][]byte // Scanned TransitionBatches, starting at firstIndex
+}
+
+func NewL1BatchSource(backend L1BatchReader, contract common.Address, startBlock uint64, confirmations uint64) *L1BatchSource {
+	return &L1BatchSource{
+		backend:       backend,
+		contract:      contract,
+		confirmations: confirmations,
+		nextBlock:     startBlock,
+	}
+}
+
+func (s *L1BatchSource) Batch(ctx context.Context, index uint64) (*TransitionBatch, error) {
+	if index < s.firstIndex {
+		return nil, fmt.Errorf("transition batch %d is no longer available, next is %d", index, s.firstIndex)
+	}
+	for index >= s.firstIndex+uint64(len(s.batches)) {
+		scanned, err := s.scanNextBlock(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !scanned {
+			return nil, nil
+		}
+	}
+	s.batches = s.batches[index-s.firstIndex:]
+	s.firstIndex = index
+	return decodeSourceBatch(index, s.batches[0])
+}
+
+// scanNextBlock collects the TransitionBatches appended in the next L1 block, returning
+// false if that block does not have enough confirmations yet.
+func (s *L1BatchSource) scanNextBlock(ctx context.Context) (bool, error) {
+	head, err := s.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	if head.Number.Uint64() < s.nextBlock+s.confirmations {
+		return false, nil
+	}
+	block, err := s.backend.BlockByNumber(ctx, new(big.Int).SetUint64(s.nextBlock))
+	if err != nil {
+		return false, err
+	}
+	method := canonicalTransitionChainAbi.Methods[appendTransitionBatchMethod]
+	for _, tx := range block.Transactions() {
+		if tx.To() == nil || *tx.To() != s.contract || len(tx.Data()) < 4 || !bytes.Equal(tx.Data()[:4], method.ID()) {
+			continue
+		}
+		receipt, err := s.backend.TransactionReceipt(ctx, tx.Hash())
+		if err != nil {
+			return false, err
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			continue
+		}
+		var encoded []byte
+		if err := method.Inputs.Unpack(&encoded, tx.Data()[4:]); err != nil {
+			// The contract accepted the call, so the batch is part of the chain regardless.
+			logger.Warn("Unable to unpack L1 transition batch submission", "tx", tx.Hash().Hex(), "error", err)
+		}
+		s.batches = append(s.batches, encoded)
+	}
+	s.nextBlock++
+	return true, nil
+}
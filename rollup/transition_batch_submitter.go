@@ -1,14 +1,325 @@
 package rollup
 
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	// RawCanonicalTransitionChainAbi is the ABI of the L1 contract that TransitionBatches are appended to.
+	RawCanonicalTransitionChainAbi = `[{"inputs":[{"internalType":"bytes","name":"_batch","type":"bytes"}],"name":"appendTransitionBatch","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+	appendTransitionBatchMethod = "appendTransitionBatch"
+)
+
+var (
+	canonicalTransitionChainAbi abi.ABI
+
+	ErrBatchSubmitterStopped        = errors.New("transition batch submitter stopped")
+	ErrBatchSubmissionReverted      = errors.New("transition batch submission reverted on L1")
+	ErrBatchSubmissionTimedOut      = errors.New("transition batch submission not included on L1 in time")
+	ErrBatchSubmitterMissingKey     = errors.New("transition batch submitter requires a signing key")
+	ErrBatchSubmitterMissingChainID = errors.New("transition batch submitter requires the L1 chain ID")
+)
+
+// replacementPriceBump is the percentage by which the gas price of a replaced L1
+// submission is at least raised, above the minimum bump of the default transaction
+// pool.
+const replacementPriceBump = 12
+
+func init() {
+	var err error
+	canonicalTransitionChainAbi, err = abi.JSON(strings.NewReader(RawCanonicalTransitionChainAbi))
+	if err != nil {
+		panic(fmt.Sprintf("Error reading CanonicalTransitionChainAbi! Error: %s", err))
+	}
+}
+
+// RollupTransitionBatchSubmitter submits TransitionBatches to L1 in two steps so that
+// a submission can be journaled before it is sent. Of all the submissions prepared
+// for a TransitionBatch, at most one may ever be included on L1.
 type RollupTransitionBatchSubmitter interface {
-	submit(block *TransitionBatch) error
+	// prepare creates the L1 submission for the TransitionBatch without sending it.
+	prepare(batch *TransitionBatch) ([]byte, error)
+	// replace creates an L1 submission replacing the provided submissions of the
+	// TransitionBatch without sending it. If one of them was included on L1 in the
+	// meantime, the last submission is returned unchanged.
+	replace(batch *TransitionBatch, submissions [][]byte) ([]byte, error)
+	// submit sends the last of the submissions of the TransitionBatch, which replaces
+	// all earlier ones, and blocks until one of them is confirmed. Submitting the same
+	// submissions more than once must result in at most one L1 inclusion. If none of
+	// them is included in time, ErrBatchSubmissionTimedOut is returned.
+	submit(batch *TransitionBatch, submissions [][]byte) error
 }
 
+// TransitionBatchSubmitter is a RollupTransitionBatchSubmitter that drops every
+// TransitionBatch. It is used when no L1 endpoint is configured.
 type TransitionBatchSubmitter struct{}
 
 func NewBlockSubmitter() *TransitionBatchSubmitter {
 	return &TransitionBatchSubmitter{}
 }
-func (d *TransitionBatchSubmitter) submit(block *TransitionBatch) error {
+func (d *TransitionBatchSubmitter) prepare(batch *TransitionBatch) ([]byte, error) {
+	return nil, nil
+}
+func (d *TransitionBatchSubmitter) replace(batch *TransitionBatch, submissions [][]byte) ([]byte, error) {
+	return nil, nil
+}
+func (d *TransitionBatchSubmitter) submit(batch *TransitionBatch, submissions [][]byte) error {
 	return nil
 }
+
+// L1Backend is the L1 chain access needed by the L1TransitionBatchSubmitter.
+// It is satisfied by both *ethclient.Client and *backends.SimulatedBackend.
+type L1Backend interface {
+	bind.ContractBackend
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// L1TransitionBatchSubmitter submits TransitionBatches to the L1 canonical
+// transition chain contract and waits for the submission to be confirmed.
+// Its submissions are signed, RLP-encoded L1 transactions. Replacements reuse
+// the nonce of the replaced submission, so that only one of them can be mined.
+type L1TransitionBatchSubmitter struct {
+	backend         L1Backend
+	contract        common.Address
+	codec           BatchCodec
+	transactOpts    *bind.TransactOpts
+	signer          types.Signer
+	confirmations   uint64
+	pollInterval    time.Duration
+	resubmitTimeout time.Duration // Time after which a submission that is not mined is replaced
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewL1TransitionBatchSubmitter creates a submitter that sends TransitionBatches encoded with
+// the provided BatchCodec to the contract at the provided address, signed by the provided key
+// for the L1 chain with the provided ID. A submission is only considered successful once its
+// L1 block has the provided number of blocks on top of it, and is replaced if it is not mined
+// within the provided timeout.
+func NewL1TransitionBatchSubmitter(backend L1Backend, contract common.Address, key *ecdsa.PrivateKey, chainID *big.Int, codec BatchCodec, confirmations uint64, pollInterval, resubmitTimeout time.Duration) (*L1TransitionBatchSubmitter, error) {
+	if key == nil {
+		return nil, ErrBatchSubmitterMissingKey
+	}
+	if chainID == nil {
+		return nil, ErrBatchSubmitterMissingChainID
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &L1TransitionBatchSubmitter{
+		backend:         backend,
+		contract:        contract,
+		codec:           codec,
+		transactOpts:    bind.NewKeyedTransactor(key),
+		signer:          types.NewEIP155Signer(chainID),
+		confirmations:   confirmations,
+		pollInterval:    pollInterval,
+		resubmitTimeout: resubmitTimeout,
+		ctx:             ctx,
+		cancel:          cancel,
+	}, nil
+}
+
+// Stop aborts any in-flight submission.
+func (s *L1TransitionBatchSubmitter) Stop() {
+	s.cancel()
+}
+
+// prepare encodes the TransitionBatch and returns the signed L1 transaction
+// appending it to the canonical transition chain contract.
+func (s *L1TransitionBatchSubmitter) prepare(batch *TransitionBatch) ([]byte, error) {
+	encoded, err := EncodeTransitionBatch(s.codec, batch)
+	if err != nil {
+		return nil, err
+	}
+	input, err := canonicalTransitionChainAbi.Pack(appendTransitionBatchMethod, encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := s.backend.PendingNonceAt(s.ctx, s.transactOpts.From)
+	if err != nil {
+		return nil, s.wrapErr(fmt.Errorf("failed to retrieve account nonce: %v", err))
+	}
+	gasPrice, err := s.backend.SuggestGasPrice(s.ctx)
+	if err != nil {
+		return nil, s.wrapErr(fmt.Errorf("failed to suggest gas price: %v", err))
+	}
+	gasLimit, err := s.backend.EstimateGas(s.ctx, ethereum.CallMsg{From: s.transactOpts.From, To: &s.contract, GasPrice: gasPrice, Data: input})
+	if err != nil {
+		return nil, s.wrapErr(fmt.Errorf("failed to estimate gas needed: %v", err))
+	}
+
+	tx, err := s.sign(nonce, gasLimit, gasPrice, input)
+	if err != nil {
+		return nil, err
+	}
+	logger.Debug("prepared transition batch submission", "hash", tx.Hash().Hex(), "nonce", nonce, "transitions", len(batch.transitions), "encoding", s.codec.Name(), "size", len(encoded))
+	return rlp.EncodeToBytes(tx)
+}
+
+// replace returns the signed L1 transaction replacing the provided submissions. It
+// reuses their nonce with a raised gas price, unless the nonce was taken by another
+// transaction, in which case the TransitionBatch is prepared afresh.
+func (s *L1TransitionBatchSubmitter) replace(batch *TransitionBatch, submissions [][]byte) ([]byte, error) {
+	txs, err := decodeSubmissions(submissions)
+	if err != nil {
+		return nil, err
+	}
+	last := txs[len(txs)-1]
+
+	// The nonce is read before the receipts, so that a submission mined in between
+	// is not mistaken for another transaction taking the nonce.
+	nonce, err := s.backend.NonceAt(s.ctx, s.transactOpts.From, nil)
+	if err != nil {
+		return nil, s.wrapErr(fmt.Errorf("failed to retrieve account nonce: %v", err))
+	}
+	// Only a submission known not to be mined may be prepared afresh, so failed lookups
+	// are retried rather than mistaken for missing receipts.
+	for _, tx := range txs {
+		receipt, err := s.backend.TransactionReceipt(s.ctx, tx.Hash())
+		if err != nil && err != ethereum.NotFound {
+			return nil, s.wrapErr(fmt.Errorf("failed to retrieve transition batch submission receipt: %v", err))
+		}
+		if receipt != nil {
+			logger.Info("transition batch submission mined, not replacing it", "hash", tx.Hash().Hex())
+			return submissions[len(submissions)-1], nil
+		}
+	}
+	if nonce > last.Nonce() {
+		logger.Warn("transition batch submission nonce taken by another transaction, preparing it afresh", "nonce", last.Nonce(), "account nonce", nonce)
+		return s.prepare(batch)
+	}
+
+	gasPrice, err := s.backend.SuggestGasPrice(s.ctx)
+	if err != nil {
+		return nil, s.wrapErr(fmt.Errorf("failed to suggest gas price: %v", err))
+	}
+	bumped := new(big.Int).Mul(last.GasPrice(), big.NewInt(100+replacementPriceBump))
+	bumped.Div(bumped, big.NewInt(100))
+	bumped.Add(bumped, common.Big1)
+	if bumped.Cmp(gasPrice) > 0 {
+		gasPrice = bumped
+	}
+	tx, err := s.sign(last.Nonce(), last.Gas(), gasPrice, last.Data())
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("replacing transition batch submission", "hash", tx.Hash().Hex(), "replaced", last.Hash().Hex(), "nonce", last.Nonce(), "gas price", gasPrice)
+	return rlp.EncodeToBytes(tx)
+}
+
+// sign creates the signed L1 transaction sending the provided input to the contract.
+func (s *L1TransitionBatchSubmitter) sign(nonce, gasLimit uint64, gasPrice *big.Int, input []byte) (*types.Transaction, error) {
+	rawTx := types.NewTransaction(nonce, s.contract, new(big.Int), gasLimit, gasPrice, input, nil, nil, types.QueueOriginSequencer, types.SighashEIP155)
+	return s.transactOpts.Signer(s.signer, s.transactOpts.From, rawTx)
+}
+
+// decodeSubmissions decodes the L1 transactions of the provided submissions.
+func decodeSubmissions(submissions [][]byte) ([]*types.Transaction, error) {
+	if len(submissions) == 0 {
+		return nil, errors.New("no transition batch submission")
+	}
+	txs := make([]*types.Transaction, len(submissions))
+	for i, submission := range submissions {
+		txs[i] = new(types.Transaction)
+		if err := rlp.DecodeBytes(submission, txs[i]); err != nil {
+			return nil, fmt.Errorf("invalid transition batch submission: %v", err)
+		}
+	}
+	return txs, nil
+}
+
+// submit sends the last of the submitted L1 transactions, unless L1 already knows
+// about it, and blocks until one of them is mined with the configured number of
+// confirmations.
+func (s *L1TransitionBatchSubmitter) submit(batch *TransitionBatch, submissions [][]byte) error {
+	txs, err := decodeSubmissions(submissions)
+	if err != nil {
+		return err
+	}
+	tx := txs[len(txs)-1]
+
+	if known, _, err := s.backend.TransactionByHash(s.ctx, tx.Hash()); err == nil && known != nil {
+		logger.Info("transition batch submission already known to L1", "hash", tx.Hash().Hex())
+	} else if err := s.backend.SendTransaction(s.ctx, tx); err != nil {
+		return s.wrapErr(err)
+	} else {
+		logger.Info("submitted transition batch to L1", "hash", tx.Hash().Hex(), "transitions", len(batch.transitions))
+	}
+
+	receipt, err := s.waitForConfirmations(txs)
+	if err != nil {
+		return err
+	}
+	if receipt.Status == types.ReceiptStatusFailed {
+		logger.Error("transition batch submission reverted", "hash", receipt.TxHash.Hex(), "block number", receipt.BlockNumber)
+		return ErrBatchSubmissionReverted
+	}
+	logger.Info("transition batch confirmed on L1", "hash", receipt.TxHash.Hex(), "block number", receipt.BlockNumber)
+	return nil
+}
+
+// wrapErr returns ErrBatchSubmitterStopped in place of errors caused by Stop.
+func (s *L1TransitionBatchSubmitter) wrapErr(err error) error {
+	if s.ctx.Err() != nil {
+		return ErrBatchSubmitterStopped
+	}
+	return err
+}
+
+// waitForConfirmations polls L1 until one of the provided transactions has been
+// mined and the configured number of blocks have been built on top of it. The
+// receipts are re-fetched on every poll so that an L1 reorg removing the mined
+// transaction restarts the wait. ErrBatchSubmissionTimedOut is returned if none
+// of them is mined within the resubmission timeout.
+func (s *L1TransitionBatchSubmitter) waitForConfirmations(txs []*types.Transaction) (*types.Receipt, error) {
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	unminedSince := time.Now()
+	for {
+		var receipt *types.Receipt
+		for _, tx := range txs {
+			if receipt, _ = s.backend.TransactionReceipt(s.ctx, tx.Hash()); receipt != nil && receipt.BlockNumber != nil {
+				break
+			}
+		}
+		if receipt != nil && receipt.BlockNumber != nil {
+			unminedSince = time.Now()
+			head, err := s.backend.HeaderByNumber(s.ctx, nil)
+			if err != nil {
+				logger.Warn("unable to fetch L1 head", "error", err)
+			} else if head.Number.Uint64() >= receipt.BlockNumber.Uint64()+s.confirmations {
+				return receipt, nil
+			}
+		} else if time.Since(unminedSince) >= s.resubmitTimeout {
+			logger.Warn("transition batch submission not mined in time", "hash", txs[len(txs)-1].Hash().Hex(), "timeout", s.resubmitTimeout)
+			return nil, ErrBatchSubmissionTimedOut
+		} else {
+			logger.Debug("transition batch submission not yet mined", "hash", txs[len(txs)-1].Hash().Hex())
+		}
+
+		select {
+		case <-s.ctx.Done():
+			return nil, ErrBatchSubmitterStopped
+		case <-ticker.C:
+		}
+	}
+}
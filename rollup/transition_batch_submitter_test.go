@@ -0,0 +1,306 @@
+package rollup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+var (
+	testL1Key, _            = crypto.GenerateKey()
+	testL1Address           = crypto.PubkeyToAddress(testL1Key.PublicKey)
+	testCanonicalChainAddr  = common.HexToAddress("0x00000000000000000000000000000000c0ffee01")
+	testSubmitPollInterval  = time.Millisecond
+	testSubmitConfirmations = uint64(3)
+)
+
+func newTestL1Backend() *backends.SimulatedBackend {
+	return backends.NewSimulatedBackend(core.GenesisAlloc{
+		testL1Address:          {Balance: big.NewInt(1_000_000_000_000_000_000)},
+		testCanonicalChainAddr: {Code: []byte{0x00}, Balance: big.NewInt(0)},
+	}, 10_000_000)
+}
+
+func newTestTransitionBatch(blocks types.Blocks) *TransitionBatch {
+	batch := NewTransitionBatch(len(blocks))
+	for _, block := range blocks {
+		batch.addBlock(block, testIntermediateRoots(block))
+	}
+	return batch
+}
+
+// waitForPendingSubmission waits for the submitter to send its L1 transaction to the pool.
+func waitForPendingSubmission(t *testing.T, sim *backends.SimulatedBackend, nonce uint64) {
+	waitFor(t, time.Second, func() (bool, string) {
+		pending, err := sim.PendingNonceAt(context.Background(), testL1Address)
+		if err != nil {
+			t.Fatalf("unable to fetch pending nonce: %v", err)
+		}
+		return pending > nonce, "submission"
+	})
+}
+
+func TestL1SubmissionWaitsForConfirmations(t *testing.T) {
+	sim := newTestL1Backend()
+	defer sim.Close()
+
+	submitter, err := NewL1TransitionBatchSubmitter(sim, testCanonicalChainAddr, testL1Key, sim.Blockchain().Config().ChainID, testCodec, testSubmitConfirmations, testSubmitPollInterval, time.Minute)
+	if err != nil {
+		t.Fatalf("unable to create submitter: %v", err)
+	}
+	defer submitter.Stop()
+
+	blocks := createBlocks(2, 1, true)
+	batch := newTestTransitionBatch(blocks)
+
+	submission, err := submitter.prepare(batch)
+	if err != nil {
+		t.Fatalf("unable to prepare submission: %v", err)
+	}
+	errCh := make(chan error, 1)
+	go func() { errCh <- submitter.submit(batch, [][]byte{submission}) }()
+
+	waitForPendingSubmission(t, sim, 0)
+	sim.Commit()
+	minedAt := sim.Blockchain().CurrentBlock().NumberU64()
+
+	for i := uint64(0); i < testSubmitConfirmations; i++ {
+		select {
+		case err := <-errCh:
+			t.Fatalf("submission returned after %d of %d confirmations, error: %v", i, testSubmitConfirmations, err)
+		case <-time.After(timeoutDuration / 2):
+		}
+		sim.Commit()
+	}
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Fatalf("unexpected submission error: %v", err)
+		}
+	case <-time.After(timeoutDuration):
+		t.Fatalf("test timeout")
+	}
+
+	// Ensure the L1 transaction carries the ABI-encoded batch and is replay protected.
+	txs := sim.Blockchain().GetBlockByNumber(minedAt).Transactions()
+	if len(txs) != 1 {
+		t.Fatalf("expected 1 L1 transaction, got %d", len(txs))
+	}
+	if !txs[0].Protected() || txs[0].ChainId().Cmp(sim.Blockchain().Config().ChainID) != 0 {
+		t.Fatalf("expected submission protected for chain %v, got chain %v", sim.Blockchain().Config().ChainID, txs[0].ChainId())
+	}
+	if *txs[0].To() != testCanonicalChainAddr {
+		t.Fatalf("expected submission to %s, got %s", testCanonicalChainAddr.Hex(), txs[0].To().Hex())
+	}
+	var encoded []byte
+	if err := canonicalTransitionChainAbi.Methods[appendTransitionBatchMethod].Inputs.Unpack(&encoded, txs[0].Data()[4:]); err != nil {
+		t.Fatalf("unable to decode submission calldata: %v", err)
+	}
+	decoded, err := DecodeTransitionBatch(encoded)
+	if err != nil {
+		t.Fatalf("unable to decode submitted batch: %v", err)
+	}
+	if len(decoded.transitions) != len(blocks) {
+		t.Fatalf("expected %d transitions, got %d", len(blocks), len(decoded.transitions))
+	}
+	for i, block := range blocks {
+		assertTransitionFromBlock(t, decoded.transitions[i], block)
+	}
+
+	// Resubmitting a confirmed submission must not send it again.
+	if err := submitter.submit(batch, [][]byte{submission}); err != nil {
+		t.Fatalf("unexpected resubmission error: %v", err)
+	}
+	if nonce, _ := sim.PendingNonceAt(context.Background(), testL1Address); nonce != 1 {
+		t.Fatalf("expected 1 L1 transaction to have been sent, got %d", nonce)
+	}
+}
+
+func TestL1SubmissionStop(t *testing.T) {
+	sim := newTestL1Backend()
+	defer sim.Close()
+
+	submitter, err := NewL1TransitionBatchSubmitter(sim, testCanonicalChainAddr, testL1Key, sim.Blockchain().Config().ChainID, testCodec, testSubmitConfirmations, testSubmitPollInterval, time.Minute)
+	if err != nil {
+		t.Fatalf("unable to create submitter: %v", err)
+	}
+
+	batch := newTestTransitionBatch(createBlocks(1, 1, true))
+	submission, err := submitter.prepare(batch)
+	if err != nil {
+		t.Fatalf("unable to prepare submission: %v", err)
+	}
+	errCh := make(chan error, 1)
+	go func() { errCh <- submitter.submit(batch, [][]byte{submission}) }()
+
+	waitForPendingSubmission(t, sim, 0)
+	submitter.Stop()
+
+	select {
+	case err := <-errCh:
+		if err != ErrBatchSubmitterStopped {
+			t.Fatalf("expected %v, got %v", ErrBatchSubmitterStopped, err)
+		}
+	case <-time.After(timeoutDuration):
+		t.Fatalf("test timeout")
+	}
+}
+
+func TestL1SubmitterRequiresKey(t *testing.T) {
+	sim := newTestL1Backend()
+	defer sim.Close()
+
+	if _, err := NewL1TransitionBatchSubmitter(sim, testCanonicalChainAddr, nil, sim.Blockchain().Config().ChainID, testCodec, 0, testSubmitPollInterval, time.Minute); err != ErrBatchSubmitterMissingKey {
+		t.Fatalf("expected %v, got %v", ErrBatchSubmitterMissingKey, err)
+	}
+	if _, err := NewL1TransitionBatchSubmitter(sim, testCanonicalChainAddr, testL1Key, nil, testCodec, 0, testSubmitPollInterval, time.Minute); err != ErrBatchSubmitterMissingChainID {
+		t.Fatalf("expected %v, got %v", ErrBatchSubmitterMissingChainID, err)
+	}
+}
+
+func decodeTestSubmission(t *testing.T, submission []byte) *types.Transaction {
+	tx := new(types.Transaction)
+	if err := rlp.DecodeBytes(submission, tx); err != nil {
+		t.Fatalf("unable to decode submission: %v", err)
+	}
+	return tx
+}
+
+func TestL1SubmissionReplacedWhenDropped(t *testing.T) {
+	sim := newTestL1Backend()
+	defer sim.Close()
+
+	submitter, err := NewL1TransitionBatchSubmitter(sim, testCanonicalChainAddr, testL1Key, sim.Blockchain().Config().ChainID, testCodec, 0, testSubmitPollInterval, 50*time.Millisecond)
+	if err != nil {
+		t.Fatalf("unable to create submitter: %v", err)
+	}
+	defer submitter.Stop()
+
+	batch := newTestTransitionBatch(createBlocks(1, 1, true))
+	submission, err := submitter.prepare(batch)
+	if err != nil {
+		t.Fatalf("unable to prepare submission: %v", err)
+	}
+	errCh := make(chan error, 1)
+	go func() { errCh <- submitter.submit(batch, [][]byte{submission}) }()
+
+	// Drop the submission from L1, it must time out instead of waiting forever.
+	waitForPendingSubmission(t, sim, 0)
+	sim.Rollback()
+	select {
+	case err := <-errCh:
+		if err != ErrBatchSubmissionTimedOut {
+			t.Fatalf("expected %v, got %v", ErrBatchSubmissionTimedOut, err)
+		}
+	case <-time.After(timeoutDuration):
+		t.Fatalf("test timeout")
+	}
+
+	// The replacement reuses the nonce with a higher gas price.
+	replacement, err := submitter.replace(batch, [][]byte{submission})
+	if err != nil {
+		t.Fatalf("unable to replace submission: %v", err)
+	}
+	original, replaced := decodeTestSubmission(t, submission), decodeTestSubmission(t, replacement)
+	if replaced.Nonce() != original.Nonce() || replaced.GasPrice().Cmp(original.GasPrice()) <= 0 {
+		t.Fatalf("expected replacement with nonce %d and gas price above %v, got nonce %d and gas price %v", original.Nonce(), original.GasPrice(), replaced.Nonce(), replaced.GasPrice())
+	}
+	go func() { errCh <- submitter.submit(batch, [][]byte{submission, replacement}) }()
+	waitForPendingSubmission(t, sim, 0)
+	sim.Commit()
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Fatalf("unexpected submission error: %v", err)
+		}
+	case <-time.After(timeoutDuration):
+		t.Fatalf("test timeout")
+	}
+	if receipt, _ := sim.TransactionReceipt(context.Background(), replaced.Hash()); receipt == nil {
+		t.Fatalf("replacement not mined")
+	}
+
+	// Replacing a mined submission returns it unchanged.
+	if again, err := submitter.replace(batch, [][]byte{submission, replacement}); err != nil || !bytes.Equal(again, replacement) {
+		t.Fatalf("expected mined submission to be kept, got %x, error %v", again, err)
+	}
+}
+
+func TestL1SubmissionReplacedWhenNonceTaken(t *testing.T) {
+	sim := newTestL1Backend()
+	defer sim.Close()
+
+	submitter, err := NewL1TransitionBatchSubmitter(sim, testCanonicalChainAddr, testL1Key, sim.Blockchain().Config().ChainID, testCodec, 0, testSubmitPollInterval, time.Minute)
+	if err != nil {
+		t.Fatalf("unable to create submitter: %v", err)
+	}
+	defer submitter.Stop()
+
+	batch := newTestTransitionBatch(createBlocks(1, 1, true))
+	submission, err := submitter.prepare(batch)
+	if err != nil {
+		t.Fatalf("unable to prepare submission: %v", err)
+	}
+
+	// Another transaction of the submitter account takes the nonce of the submission.
+	other, _ := types.SignTx(types.NewTransaction(0, testL1Address, new(big.Int), 21000, big.NewInt(1), nil, nil, nil, types.QueueOriginSequencer, types.SighashEIP155), types.NewEIP155Signer(sim.Blockchain().Config().ChainID), testL1Key)
+	if err := sim.SendTransaction(context.Background(), other); err != nil {
+		t.Fatalf("unable to send transaction: %v", err)
+	}
+	sim.Commit()
+
+	replacement, err := submitter.replace(batch, [][]byte{submission})
+	if err != nil {
+		t.Fatalf("unable to replace submission: %v", err)
+	}
+	if nonce := decodeTestSubmission(t, replacement).Nonce(); nonce != 1 {
+		t.Fatalf("expected replacement with fresh nonce 1, got %d", nonce)
+	}
+}
+
+// receiptFailingBackend is a simulated L1 backend failing to retrieve receipts.
+type receiptFailingBackend struct {
+	*backends.SimulatedBackend
+}
+
+func (b receiptFailingBackend) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return nil, errors.New("receipt unavailable")
+}
+
+func TestL1SubmissionNotPreparedAfreshWhenReceiptsFail(t *testing.T) {
+	sim := newTestL1Backend()
+	defer sim.Close()
+
+	submitter, err := NewL1TransitionBatchSubmitter(receiptFailingBackend{sim}, testCanonicalChainAddr, testL1Key, sim.Blockchain().Config().ChainID, testCodec, 0, testSubmitPollInterval, time.Minute)
+	if err != nil {
+		t.Fatalf("unable to create submitter: %v", err)
+	}
+	defer submitter.Stop()
+
+	batch := newTestTransitionBatch(createBlocks(1, 1, true))
+	submission, err := submitter.prepare(batch)
+	if err != nil {
+		t.Fatalf("unable to prepare submission: %v", err)
+	}
+	// The submission is mined, moving the account nonce past it, but its receipt
+	// cannot be retrieved.
+	if err := sim.SendTransaction(context.Background(), decodeTestSubmission(t, submission)); err != nil {
+		t.Fatalf("unable to send submission: %v", err)
+	}
+	sim.Commit()
+
+	if replacement, err := submitter.replace(batch, [][]byte{submission}); err == nil {
+		t.Fatalf("expected receipt error, got replacement with nonce %d", decodeTestSubmission(t, replacement).Nonce())
+	}
+}
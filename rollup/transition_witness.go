@@ -0,0 +1,374 @@
+package rollup
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+var (
+	ErrTransitionNotFound = errors.New("transition not found")
+	ErrPreStateMismatch   = errors.New("pre-state root does not match the post-state root of the previous transition")
+)
+
+// TransitionWitness holds everything needed to re-execute a single Transition without
+// access to the full state: the pre-state root, Merkle proofs against it for every
+// account and storage slot the transaction accesses, and the committed post-state
+// root. Its RLP encoding is the format consumed by on-chain verification.
+type TransitionWitness struct {
+	BatchIndex      hexutil.Uint64    `json:"batchIndex"`
+	TransitionIndex hexutil.Uint64    `json:"transitionIndex"`
+	Transaction     hexutil.Bytes     `json:"transaction"`     // RLP encoded signed transaction
+	TransactionMeta hexutil.Bytes     `json:"transactionMeta"` // Encoded types.TransactionMeta
+	Context         TransitionContext `json:"context"`
+	PreStateRoot    common.Hash       `json:"preStateRoot"`
+	PostStateRoot   common.Hash       `json:"postStateRoot"`
+	Accounts        []AccountWitness  `json:"accounts"`
+}
+
+// AccountWitness proves an account of the pre-state and the storage slots of it that
+// are accessed. Storage proofs are omitted for accounts proven not to exist.
+type AccountWitness struct {
+	Address common.Address   `json:"address"`
+	Proof   []hexutil.Bytes  `json:"proof"` // Account trie nodes from the pre-state root to the account
+	Storage []StorageWitness `json:"storage"`
+}
+
+// StorageWitness proves a storage slot of an account.
+type StorageWitness struct {
+	Key   common.Hash     `json:"key"`
+	Proof []hexutil.Bytes `json:"proof"` // Storage trie nodes from the storage root to the slot
+}
+
+// EncodeTransitionWitness encodes the TransitionWitness for on-chain verification.
+func EncodeTransitionWitness(witness *TransitionWitness) ([]byte, error) {
+	return rlp.EncodeToBytes(witness)
+}
+
+// DecodeTransitionWitness decodes a TransitionWitness encoded by EncodeTransitionWitness.
+func DecodeTransitionWitness(data []byte) (*TransitionWitness, error) {
+	witness := new(TransitionWitness)
+	if err := rlp.DecodeBytes(data, witness); err != nil {
+		return nil, err
+	}
+	return witness, nil
+}
+
+// NewTransitionWitness creates the TransitionWitness of the journaled Transition with the
+// provided index. The pre-state is the state of the parent of its Geth Block in the chain,
+// with the journaled Transitions preceding it in the Geth Block applied, so that it does
+// not depend on the Geth Block being in the chain, which it is not after a verifier
+// mismatch. The pre-state root must match the post-state root committed by the previous
+// Transition.
+func NewTransitionWitness(db ethdb.KeyValueReader, chain *core.BlockChain, transitionIndex uint64) (*TransitionWitness, error) {
+	batchIndex, journaled, err := FindJournaledTransition(db, transitionIndex)
+	if err != nil {
+		return nil, err
+	}
+	if journaled == nil {
+		return nil, ErrTransitionNotFound
+	}
+	transition, err := journaled.Transitions[transitionIndex-journaled.FirstTransitionIndex].transition()
+	if err != nil {
+		return nil, err
+	}
+	blockCtx := transition.context
+	if blockCtx.BlockNumber == 0 {
+		return nil, errors.New("transition in genesis block")
+	}
+	parent := chain.GetHeaderByNumber(blockCtx.BlockNumber - 1)
+	if parent == nil {
+		return nil, fmt.Errorf("missing parent block %d", blockCtx.BlockNumber-1)
+	}
+	preceding, previous, err := precedingTransitions(db, batchIndex, journaled, transitionIndex, blockCtx.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	header := blockCtx.header(chain.Config(), parent)
+	statedb, err := preState(chain, parent, header, preceding)
+	if err != nil {
+		return nil, err
+	}
+	preStateRoot := statedb.IntermediateRoot(chain.Config().IsEIP158(header.Number))
+	// The state may change between Geth Blocks without Transitions, e.g. by block
+	// rewards, so only the post-state root of the parent Block can be checked across
+	// Blocks.
+	if previous != nil && previous.context.BlockNumber+1 >= blockCtx.BlockNumber && previous.postState != preStateRoot {
+		return nil, fmt.Errorf("%v: have %x, want %x", ErrPreStateMismatch, preStateRoot, previous.postState)
+	}
+	accessed, err := accessedState(chain, header, statedb.Copy(), transition)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := rlp.EncodeToBytes(transition.transaction)
+	if err != nil {
+		return nil, err
+	}
+	witness := &TransitionWitness{
+		BatchIndex:      hexutil.Uint64(batchIndex),
+		TransitionIndex: hexutil.Uint64(transitionIndex),
+		Transaction:     tx,
+		TransactionMeta: types.TxMetaEncode(transition.transaction.GetMeta()),
+		Context:         blockCtx,
+		PreStateRoot:    preStateRoot,
+		PostStateRoot:   transition.postState,
+	}
+	for _, addr := range accessed.addresses() {
+		proof, err := statedb.GetProof(addr)
+		if err != nil {
+			return nil, err
+		}
+		account := AccountWitness{Address: addr, Proof: toHexProof(proof)}
+		if statedb.Exist(addr) {
+			for _, key := range accessed.keys(addr) {
+				proof, err := statedb.GetStorageProof(addr, key)
+				if err != nil {
+					return nil, err
+				}
+				account.Storage = append(account.Storage, StorageWitness{Key: key, Proof: toHexProof(proof)})
+			}
+		}
+		witness.Accounts = append(witness.Accounts, account)
+	}
+	return witness, nil
+}
+
+// precedingTransitions returns the journaled Transitions preceding the one with the
+// provided index in the Geth Block with the provided number, in order, along with the Transition right before it,
+// which is nil for the first Transition. The Transition is in the provided journaled
+// TransitionBatch; the preceding ones may be in earlier TransitionBatches.
+func precedingTransitions(db ethdb.KeyValueReader, batchIndex uint64, journaled *JournaledBatch, transitionIndex uint64, number uint64) ([]*Transition, *Transition, error) {
+	var (
+		preceding []*Transition
+		previous  *Transition
+		err       error
+	)
+	for index := transitionIndex; index > 0; {
+		index--
+		for index < journaled.FirstTransitionIndex {
+			batchIndex--
+			if journaled, err = ReadJournaledBatch(db, batchIndex); err != nil {
+				return nil, nil, err
+			}
+			if journaled == nil {
+				return nil, nil, ErrCorruptBatchJournal
+			}
+		}
+		transition, err := journaled.Transitions[index-journaled.FirstTransitionIndex].transition()
+		if err != nil {
+			return nil, nil, err
+		}
+		if transition.context.BlockNumber != number {
+			previous = transition
+			break
+		}
+		preceding = append(preceding, transition)
+	}
+	// The Transitions were collected backwards.
+	for i, j := 0, len(preceding)-1; i < j; i, j = i+1, j-1 {
+		preceding[i], preceding[j] = preceding[j], preceding[i]
+	}
+	if len(preceding) > 0 {
+		previous = preceding[len(preceding)-1]
+	}
+	return preceding, previous, nil
+}
+
+// preState returns the state a Transition is executed on: the state of the parent
+// header with the provided Transitions preceding it in its Geth Block applied.
+func preState(chain *core.BlockChain, parent *types.Header, header *types.Header, preceding []*Transition) (*state.StateDB, error) {
+	statedb, err := chain.StateAt(parent.Root)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		config = chain.Config()
+		gp     = new(core.GasPool).AddGas(header.GasLimit)
+	)
+	for i, transition := range preceding {
+		tx := transition.transaction
+		statedb.Prepare(tx.Hash(), common.Hash{}, i)
+		if _, err := core.ApplyTransaction(config, chain, nil, gp, statedb, header, tx, &header.GasUsed, vm.Config{}); err != nil {
+			return nil, err
+		}
+	}
+	return statedb, nil
+}
+
+// accessedState re-executes the Transition on top of the provided pre-state, recording
+// the accounts and storage slots it accesses.
+func accessedState(chain *core.BlockChain, header *types.Header, statedb *state.StateDB, transition *Transition) (*accessRecorder, error) {
+	var (
+		config   = chain.Config()
+		recorder = newAccessRecorder(statedb)
+	)
+	msg, err := transition.transaction.AsMessage(types.MakeSigner(config, header.Number))
+	if err != nil {
+		return nil, err
+	}
+	// Fees are paid to the coinbase even if the transaction fails.
+	recorder.touch(header.Coinbase)
+
+	vmenv := vm.NewEVM(core.NewEVMContext(msg, header, chain, nil), recorder, config, vm.Config{})
+	if _, _, _, err := core.ApplyMessage(vmenv, msg, new(core.GasPool).AddGas(header.GasLimit-header.GasUsed)); err != nil {
+		return nil, err
+	}
+	return recorder, nil
+}
+
+func toHexProof(proof [][]byte) []hexutil.Bytes {
+	nodes := make([]hexutil.Bytes, len(proof))
+	for i, node := range proof {
+		nodes[i] = node
+	}
+	return nodes
+}
+
+// accessRecorder is a vm.StateDB recording the accounts and storage slots accessed
+// through it.
+type accessRecorder struct {
+	*state.StateDB
+	accessed map[common.Address]map[common.Hash]struct{}
+}
+
+func newAccessRecorder(statedb *state.StateDB) *accessRecorder {
+	return &accessRecorder{
+		StateDB:  statedb,
+		accessed: make(map[common.Address]map[common.Hash]struct{}),
+	}
+}
+
+func (r *accessRecorder) touch(addr common.Address) {
+	if _, ok := r.accessed[addr]; !ok {
+		r.accessed[addr] = make(map[common.Hash]struct{})
+	}
+}
+
+func (r *accessRecorder) touchSlot(addr common.Address, key common.Hash) {
+	r.touch(addr)
+	r.accessed[addr][key] = struct{}{}
+}
+
+// addresses returns the accessed accounts, in ascending order.
+func (r *accessRecorder) addresses() []common.Address {
+	addrs := make([]common.Address, 0, len(r.accessed))
+	for addr := range r.accessed {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i][:], addrs[j][:]) < 0 })
+	return addrs
+}
+
+// keys returns the accessed storage slots of the account, in ascending order.
+func (r *accessRecorder) keys(addr common.Address) []common.Hash {
+	keys := make([]common.Hash, 0, len(r.accessed[addr]))
+	for key := range r.accessed[addr] {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i][:], keys[j][:]) < 0 })
+	return keys
+}
+
+func (r *accessRecorder) CreateAccount(addr common.Address) {
+	r.touch(addr)
+	r.StateDB.CreateAccount(addr)
+}
+
+func (r *accessRecorder) SubBalance(addr common.Address, amount *big.Int) {
+	r.touch(addr)
+	r.StateDB.SubBalance(addr, amount)
+}
+
+func (r *accessRecorder) AddBalance(addr common.Address, amount *big.Int) {
+	r.touch(addr)
+	r.StateDB.AddBalance(addr, amount)
+}
+
+func (r *accessRecorder) GetBalance(addr common.Address) *big.Int {
+	r.touch(addr)
+	return r.StateDB.GetBalance(addr)
+}
+
+func (r *accessRecorder) GetNonce(addr common.Address) uint64 {
+	r.touch(addr)
+	return r.StateDB.GetNonce(addr)
+}
+
+func (r *accessRecorder) SetNonce(addr common.Address, nonce uint64) {
+	r.touch(addr)
+	r.StateDB.SetNonce(addr, nonce)
+}
+
+func (r *accessRecorder) GetCodeHash(addr common.Address) common.Hash {
+	r.touch(addr)
+	return r.StateDB.GetCodeHash(addr)
+}
+
+func (r *accessRecorder) GetCode(addr common.Address) []byte {
+	r.touch(addr)
+	return r.StateDB.GetCode(addr)
+}
+
+func (r *accessRecorder) SetCode(addr common.Address, code []byte) {
+	r.touch(addr)
+	r.StateDB.SetCode(addr, code)
+}
+
+func (r *accessRecorder) GetCodeSize(addr common.Address) int {
+	r.touch(addr)
+	return r.StateDB.GetCodeSize(addr)
+}
+
+func (r *accessRecorder) GetCommittedState(addr common.Address, key common.Hash) common.Hash {
+	r.touchSlot(addr, key)
+	return r.StateDB.GetCommittedState(addr, key)
+}
+
+func (r *accessRecorder) GetState(addr common.Address, key common.Hash) common.Hash {
+	r.touchSlot(addr, key)
+	return r.StateDB.GetState(addr, key)
+}
+
+func (r *accessRecorder) SetState(addr common.Address, key common.Hash, value common.Hash) {
+	r.touchSlot(addr, key)
+	r.StateDB.SetState(addr, key, value)
+}
+
+func (r *accessRecorder) Suicide(addr common.Address) bool {
+	r.touch(addr)
+	return r.StateDB.Suicide(addr)
+}
+
+func (r *accessRecorder) HasSuicided(addr common.Address) bool {
+	r.touch(addr)
+	return r.StateDB.HasSuicided(addr)
+}
+
+func (r *accessRecorder) Exist(addr common.Address) bool {
+	r.touch(addr)
+	return r.StateDB.Exist(addr)
+}
+
+func (r *accessRecorder) Empty(addr common.Address) bool {
+	r.touch(addr)
+	return r.StateDB.Empty(addr)
+}
+
+func (r *accessRecorder) ForEachStorage(addr common.Address, cb func(key, value common.Hash) bool) error {
+	return r.StateDB.ForEachStorage(addr, func(key, value common.Hash) bool {
+		r.touchSlot(addr, key)
+		return cb(key, value)
+	})
+}
@@ -0,0 +1,172 @@
+package rollup
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// verifyWitnessProof verifies the Merkle proof of the key against the root, returning
+// the proven value, which is nil if the key does not exist.
+func verifyWitnessProof(t *testing.T, root common.Hash, key []byte, proof [][]byte) []byte {
+	db := memorydb.New()
+	for _, node := range proof {
+		db.Put(crypto.Keccak256(node), node)
+	}
+	value, _, err := trie.VerifyProof(root, crypto.Keccak256(key), db)
+	if err != nil {
+		t.Fatalf("invalid proof for key %x: %v", key, err)
+	}
+	return value
+}
+
+func TestTransitionWitness(t *testing.T) {
+	gspec := newVerifierTestGenesis()
+	blocks := createVerifierTestBlocks(t, gspec, 6)
+	batches := createVerifierTestBatches(blocks, 2)
+
+	db := rawdb.NewMemoryDatabase()
+	chain := newVerifierTestChain(t, db, gspec)
+	defer chain.Stop()
+
+	verifier := startTestVerifier(t, db, chain, batches)
+	waitForVerifierStatus(t, verifier, func(status *VerifierStatus) bool { return status.NextBatchIndex == 3 })
+	verifier.Stop()
+
+	witness, err := NewTransitionWitness(db, chain, 3)
+	if err != nil {
+		t.Fatalf("unable to create witness: %v", err)
+	}
+	if witness.BatchIndex != 1 || witness.TransitionIndex != 3 {
+		t.Fatalf("expected transition 3 in batch 1, got %d in batch %d", witness.TransitionIndex, witness.BatchIndex)
+	}
+	if witness.PreStateRoot != blocks[2].Root() || witness.PostStateRoot != blocks[3].Root() {
+		t.Fatalf("expected roots %s -> %s, got %s -> %s", blocks[2].Root().Hex(), blocks[3].Root().Hex(), witness.PreStateRoot.Hex(), witness.PostStateRoot.Hex())
+	}
+	if witness.Context.BlockNumber != 4 {
+		t.Fatalf("expected block 4, got %d", witness.Context.BlockNumber)
+	}
+	if tx, _ := rlp.EncodeToBytes(blocks[3].Transactions()[0]); !bytes.Equal(witness.Transaction, tx) {
+		t.Fatalf("unexpected witness transaction %x", witness.Transaction)
+	}
+
+	// Every account accessed must be proven against the pre-state root.
+	proven := make(map[common.Address]bool)
+	for _, account := range witness.Accounts {
+		proof := make([][]byte, len(account.Proof))
+		for i, node := range account.Proof {
+			proof[i] = node
+		}
+		value := verifyWitnessProof(t, witness.PreStateRoot, account.Address.Bytes(), proof)
+		if value != nil {
+			var decoded state.Account
+			if err := rlp.DecodeBytes(value, &decoded); err != nil {
+				t.Fatalf("unable to decode account %s: %v", account.Address.Hex(), err)
+			}
+			for _, slot := range account.Storage {
+				storageProof := make([][]byte, len(slot.Proof))
+				for i, node := range slot.Proof {
+					storageProof[i] = node
+				}
+				verifyWitnessProof(t, decoded.Root, slot.Key.Bytes(), storageProof)
+			}
+		}
+		proven[account.Address] = true
+	}
+	for _, addr := range []common.Address{crypto.PubkeyToAddress(testBankKey.PublicKey), blocks[3].Coinbase()} {
+		if !proven[addr] {
+			t.Fatalf("missing witness for account %s", addr.Hex())
+		}
+	}
+
+	encoded, err := EncodeTransitionWitness(witness)
+	if err != nil {
+		t.Fatalf("unable to encode witness: %v", err)
+	}
+	decoded, err := DecodeTransitionWitness(encoded)
+	if err != nil {
+		t.Fatalf("unable to decode witness: %v", err)
+	}
+	if reencoded, _ := EncodeTransitionWitness(decoded); !bytes.Equal(reencoded, encoded) {
+		t.Fatalf("witness encoding does not round trip")
+	}
+
+	if _, err := NewTransitionWitness(db, chain, 6); err != ErrTransitionNotFound {
+		t.Fatalf("expected %v, got %v", ErrTransitionNotFound, err)
+	}
+}
+
+func TestFindJournaledTransition(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	sizes := []int{2, 1, 3}
+	for i, size := range sizes {
+		first, err := nextTransitionIndex(db, uint64(i))
+		if err != nil {
+			t.Fatalf("unable to compute first transition index of batch %d: %v", i, err)
+		}
+		batch := newTestTransitionBatch(createBlocks(size, 1, true))
+		if err := writeJournaledBatch(db, uint64(i), newJournaledBatch(batch, BatchConfirmed, 1, uint64(size), first)); err != nil {
+			t.Fatalf("unable to journal batch %d: %v", i, err)
+		}
+		db.Put(NextBatchIndexDBKey, SerializeBlockNumber(uint64(i+1)))
+	}
+
+	for transition, expected := range []uint64{0, 0, 1, 2, 2, 2} {
+		batchIndex, journaled, err := FindJournaledTransition(db, uint64(transition))
+		if err != nil || journaled == nil {
+			t.Fatalf("transition %d: unable to find batch: %v", transition, err)
+		}
+		if batchIndex != expected {
+			t.Fatalf("transition %d: expected batch %d, got %d", transition, expected, batchIndex)
+		}
+	}
+	if _, journaled, err := FindJournaledTransition(db, 6); journaled != nil || err != nil {
+		t.Fatalf("expected transition 6 not to be found, got %v, %v", journaled, err)
+	}
+}
+
+func TestAccessRecorder(t *testing.T) {
+	var (
+		contract = common.HexToAddress("0xc0de")
+		other    = common.HexToAddress("0x07e5")
+	)
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()))
+	// SLOAD(1), SSTORE(2, 1), BALANCE(other)
+	code := append([]byte{
+		byte(vm.PUSH1), 0x01, byte(vm.SLOAD),
+		byte(vm.PUSH1), 0x01, byte(vm.PUSH1), 0x02, byte(vm.SSTORE),
+		byte(vm.PUSH20)}, other.Bytes()...)
+	statedb.SetCode(contract, append(code, byte(vm.BALANCE), byte(vm.STOP)))
+
+	recorder := newAccessRecorder(statedb)
+	vmenv := vm.NewEVM(vm.Context{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		BlockNumber: big.NewInt(1),
+	}, recorder, params.AllEthashProtocolChanges, vm.Config{})
+	if _, _, err := vmenv.Call(vm.AccountRef(testUserAddress), contract, nil, 100_000, big.NewInt(0)); err != nil {
+		t.Fatalf("unable to call contract: %v", err)
+	}
+
+	expected := []common.Address{other, contract, testUserAddress}
+	sort.Slice(expected, func(i, j int) bool { return bytes.Compare(expected[i][:], expected[j][:]) < 0 })
+	if addrs := recorder.addresses(); !reflect.DeepEqual(addrs, expected) {
+		t.Fatalf("expected accessed accounts %v, got %v", expected, addrs)
+	}
+	if keys := recorder.keys(contract); !reflect.DeepEqual(keys, []common.Hash{common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(2))}) {
+		t.Fatalf("unexpected accessed storage slots %v", keys)
+	}
+}
@@ -1,30 +1,164 @@
 package rollup
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 const (
-	MinTxBytes               = uint64(100)
-	MinTxGas                 = MinTxBytes*params.TxDataNonZeroGasEIP2028 + params.SstoreSetGas
-	TransitionBatchGasBuffer = uint64(1_000_000)
+	MinTxBytes = uint64(100)
+	// MinTxGas is the least L1 gas adding a transaction to a TransitionBatch is assumed to use.
+	MinTxGas = MinTxBytes*params.TxDataNonZeroGasEIP2028 + StateRootCommitmentGas
 )
 
 type BlockStore interface {
 	GetBlockByNumber(number uint64) *types.Block
+	// IntermediateRoots returns the state root after each transaction of the Block.
+	IntermediateRoots(block *types.Block) ([]common.Hash, error)
+}
+
+// TransitionContext is the context of the Geth Block a Transition was executed in,
+// needed to re-execute the Transition.
+type TransitionContext struct {
+	BlockNumber   uint64          `json:"blockNumber"`
+	Timestamp     uint64          `json:"timestamp"`
+	GasLimit      uint64          `json:"gasLimit"`
+	Coinbase      *common.Address `json:"coinbase" rlp:"nil"` // nil for the empty coinbase of non-voting clique blocks
+	L1BlockNumber uint64          `json:"l1BlockNumber"`      // L1 block of OVM blocks, whose timestamp is Timestamp
+	Difficulty    uint64          `json:"difficulty"`         // Zero if unknown, for contexts of the legacy layouts
+}
+
+// storedTransitionContextRLP is the RLP layout of a TransitionContext.
+type storedTransitionContextRLP TransitionContext
+
+// v1TransitionContextRLP is the RLP layout of a TransitionContext before it held the
+// block difficulty.
+type v1TransitionContextRLP struct {
+	BlockNumber   uint64
+	Timestamp     uint64
+	GasLimit      uint64
+	Coinbase      *common.Address `rlp:"nil"`
+	L1BlockNumber uint64
+}
+
+// v0TransitionContextRLP is the RLP layout of a TransitionContext before it held the
+// L1 block number.
+type v0TransitionContextRLP struct {
+	BlockNumber uint64
+	Timestamp   uint64
+	GasLimit    uint64
+	Coinbase    *common.Address `rlp:"nil"`
+}
+
+// DecodeRLP implements rlp.Decoder, and loads TransitionContexts of the current, the v1
+// and the v0 layout, which are still found in journals, witnesses and batches encoded
+// with the legacy BatchEncodings.
+func (c *TransitionContext) DecodeRLP(s *rlp.Stream) error {
+	blob, err := s.Raw()
+	if err != nil {
+		return err
+	}
+	if err := rlp.DecodeBytes(blob, (*storedTransitionContextRLP)(c)); err == nil {
+		return nil
+	}
+	var v1 v1TransitionContextRLP
+	if err := rlp.DecodeBytes(blob, &v1); err == nil {
+		*c = TransitionContext{
+			BlockNumber:   v1.BlockNumber,
+			Timestamp:     v1.Timestamp,
+			GasLimit:      v1.GasLimit,
+			Coinbase:      v1.Coinbase,
+			L1BlockNumber: v1.L1BlockNumber,
+		}
+		return nil
+	}
+	var stored v0TransitionContextRLP
+	if err := rlp.DecodeBytes(blob, &stored); err != nil {
+		return err
+	}
+	*c = TransitionContext{
+		BlockNumber: stored.BlockNumber,
+		Timestamp:   stored.Timestamp,
+		GasLimit:    stored.GasLimit,
+		Coinbase:    stored.Coinbase,
+	}
+	return nil
+}
+
+func newTransitionContext(header *types.Header) TransitionContext {
+	context := TransitionContext{
+		BlockNumber: header.Number.Uint64(),
+		Timestamp:   header.Time,
+		GasLimit:    header.GasLimit,
+		Difficulty:  header.Difficulty.Uint64(),
+	}
+	if header.Coinbase != (common.Address{}) {
+		coinbase := header.Coinbase
+		context.Coinbase = &coinbase
+	}
+	if l1Context, err := types.DecodeL1Context(header.Extra); err == nil {
+		context.L1BlockNumber = l1Context.BlockNumber
+	}
+	return context
+}
+
+// coinbase returns the coinbase of the Geth Block.
+func (c TransitionContext) coinbase() common.Address {
+	if c.Coinbase == nil {
+		return common.Address{}
+	}
+	return *c.Coinbase
+}
+
+// difficulty returns the difficulty of the Geth Block, assumed to be one for contexts
+// of the legacy layouts, as they were re-executed before they held it.
+func (c TransitionContext) difficulty() *big.Int {
+	if c.Difficulty == 0 {
+		return big.NewInt(1)
+	}
+	return new(big.Int).SetUint64(c.Difficulty)
+}
+
+// equal returns whether both contexts describe the same Geth Block. The difficulty is
+// only compared if both contexts hold it.
+func (c TransitionContext) equal(other TransitionContext) bool {
+	return c.BlockNumber == other.BlockNumber && c.Timestamp == other.Timestamp && c.GasLimit == other.GasLimit &&
+		c.coinbase() == other.coinbase() && c.L1BlockNumber == other.L1BlockNumber &&
+		(c.Difficulty == 0 || other.Difficulty == 0 || c.Difficulty == other.Difficulty)
+}
+
+// header returns the unsealed header of the Geth Block, on top of the provided parent, to
+// re-execute the Transition in. Its state root and gas used are left to be filled in.
+func (c TransitionContext) header(config *params.ChainConfig, parent *types.Header) *types.Header {
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Number:     new(big.Int).SetUint64(c.BlockNumber),
+		Time:       c.Timestamp,
+		GasLimit:   c.GasLimit,
+		Coinbase:   c.coinbase(),
+		Difficulty: c.difficulty(),
+	}
+	if config.IsOVM() {
+		header.Extra = types.L1Context{BlockNumber: c.L1BlockNumber, Timestamp: c.Timestamp}.Extra()
+	}
+	return header
 }
 
 type Transition struct {
 	transaction *types.Transaction
 	postState   common.Hash
+	context     TransitionContext
 }
 
-func newTransition(tx *types.Transaction, postState common.Hash) *Transition {
+func newTransition(tx *types.Transaction, postState common.Hash, context TransitionContext) *Transition {
 	return &Transition{
 		transaction: tx,
 		postState:   postState,
+		context:     context,
 	}
 }
 
@@ -36,7 +170,11 @@ func NewTransitionBatch(defaultSize int) *TransitionBatch {
 	return &TransitionBatch{transitions: make([]*Transition, 0, defaultSize)}
 }
 
-// addBlock adds a Geth Block to the TransitionBatch. This is just its transaction and state root.
-func (r *TransitionBatch) addBlock(block *types.Block) {
-	r.transitions = append(r.transitions, newTransition(block.Transactions()[0], block.Root()))
+// addBlock adds a Geth Block to the TransitionBatch. This is a Transition for each of its
+// transactions, committing to the provided state root after the transaction.
+func (r *TransitionBatch) addBlock(block *types.Block, roots []common.Hash) {
+	context := newTransitionContext(block.Header())
+	for i, tx := range block.Transactions() {
+		r.transitions = append(r.transitions, newTransition(tx, roots[i], context))
+	}
 }
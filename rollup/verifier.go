@@ -0,0 +1,318 @@
+package rollup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// States of a Verifier, as reported by its VerifierStatus.
+const (
+	VerifierVerifying = "verifying"
+	VerifierHalted    = "halted"
+)
+
+var (
+	ErrVerifierDisabled = errors.New("verifier disabled")
+
+	// VerifierNextBatchIndexDBKey tracks the index of the next TransitionBatch to verify.
+	VerifierNextBatchIndexDBKey = []byte("rollupVerifierNextBatchIndex")
+	// VerifierMismatchDBKey -> RLP(VerifierMismatch) of the Transition the Verifier halted at.
+	VerifierMismatchDBKey = []byte("rollupVerifierMismatch")
+)
+
+// VerifierMismatch describes a Transition whose committed post-state root could not be
+// reproduced by re-executing its transaction.
+type VerifierMismatch struct {
+	BatchIndex      hexutil.Uint64 `json:"batchIndex"`
+	TransitionIndex hexutil.Uint64 `json:"transitionIndex"`
+	BlockNumber     hexutil.Uint64 `json:"blockNumber"`
+	TxHash          common.Hash    `json:"txHash"`
+	Expected        common.Hash    `json:"expectedRoot"`
+	Actual          common.Hash    `json:"actualRoot"`
+	Error           string         `json:"error"`
+}
+
+// VerifierStatus reports the progress of a Verifier.
+type VerifierStatus struct {
+	State          string            `json:"state"`
+	NextBatchIndex hexutil.Uint64    `json:"nextBatchIndex"`
+	HeadBlock      hexutil.Uint64    `json:"headBlock"`
+	LastError      string            `json:"lastError,omitempty"`
+	Mismatch       *VerifierMismatch `json:"mismatch,omitempty"`
+}
+
+// Verifier derives the L2 chain from the TransitionBatches of a TransitionBatchSource.
+// Each Transition is re-executed on top of the Block derived for its parent and the
+// resulting state root is compared to the committed post-state root. Matching
+// Transitions are written to the BlockChain as unsealed Blocks. On the first mismatch
+// the Verifier halts and records the mismatch, which persists across restarts.
+type Verifier struct {
+	db           ethdb.Database
+	chain        *core.BlockChain
+	source       TransitionBatchSource
+	pollInterval time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	statusMu       sync.RWMutex
+	nextBatchIndex uint64
+	mismatch       *VerifierMismatch
+	lastErr        error
+}
+
+// NewVerifier creates a Verifier deriving the provided BlockChain, resuming from the
+// progress stored in db, and starts it.
+func NewVerifier(db ethdb.Database, chain *core.BlockChain, source TransitionBatchSource, pollInterval time.Duration) (*Verifier, error) {
+	mismatch, err := readVerifierMismatch(db)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	v := &Verifier{
+		db:             db,
+		chain:          chain,
+		source:         source,
+		pollInterval:   pollInterval,
+		ctx:            ctx,
+		cancel:         cancel,
+		done:           make(chan struct{}),
+		nextBatchIndex: readJournalIndex(db, VerifierNextBatchIndexDBKey),
+		mismatch:       mismatch,
+	}
+	if mismatch != nil {
+		logger.Error("Verifier halted at post-state root mismatch", "batch", mismatch.BatchIndex, "transition", mismatch.TransitionIndex, "block", mismatch.BlockNumber, "error", mismatch.Error)
+	}
+	go v.loop()
+	return v, nil
+}
+
+// Stop stops the Verifier and waits for it to exit.
+func (v *Verifier) Stop() {
+	v.cancel()
+	<-v.done
+}
+
+// Status returns the progress of the Verifier.
+func (v *Verifier) Status() *VerifierStatus {
+	v.statusMu.RLock()
+	defer v.statusMu.RUnlock()
+
+	status := &VerifierStatus{
+		State:          VerifierVerifying,
+		NextBatchIndex: hexutil.Uint64(v.nextBatchIndex),
+		HeadBlock:      hexutil.Uint64(v.chain.CurrentBlock().NumberU64()),
+		Mismatch:       v.mismatch,
+	}
+	if v.mismatch != nil {
+		status.State = VerifierHalted
+	}
+	if v.lastErr != nil {
+		status.LastError = v.lastErr.Error()
+	}
+	return status
+}
+
+func (v *Verifier) halted() bool {
+	v.statusMu.RLock()
+	defer v.statusMu.RUnlock()
+	return v.mismatch != nil
+}
+
+func (v *Verifier) loop() {
+	defer close(v.done)
+
+	for !v.halted() {
+		verified, err := v.verifyNextBatch()
+		v.statusMu.Lock()
+		v.lastErr = err
+		v.statusMu.Unlock()
+		if err != nil {
+			logger.Warn("Unable to verify transition batch", "error", err)
+		}
+		if verified {
+			continue
+		}
+		select {
+		case <-v.ctx.Done():
+			return
+		case <-time.After(v.pollInterval):
+		}
+	}
+	<-v.ctx.Done()
+}
+
+// verifyNextBatch verifies the next TransitionBatch if it is available, returning whether
+// it was verified. A mismatch is flagged rather than returned as an error.
+func (v *Verifier) verifyNextBatch() (bool, error) {
+	v.statusMu.RLock()
+	index := v.nextBatchIndex
+	v.statusMu.RUnlock()
+
+	batch, err := v.source.Batch(v.ctx, index)
+	if invalid, ok := err.(*InvalidBatchError); ok {
+		return false, v.flag(&VerifierMismatch{BatchIndex: hexutil.Uint64(index), Error: invalid.Error()})
+	}
+	if err != nil || batch == nil {
+		return false, err
+	}
+	if err := v.journalBatch(index, batch); err != nil {
+		return false, err
+	}
+	// Consecutive Transitions of the same Geth Block are executed together.
+	for start := 0; start < len(batch.transitions); {
+		end := start + 1
+		for end < len(batch.transitions) && batch.transitions[end].context.BlockNumber == batch.transitions[start].context.BlockNumber {
+			end++
+		}
+		i, mismatch, err := v.verifyBlock(batch.transitions[start:end])
+		if err != nil {
+			return false, err
+		}
+		if mismatch != nil {
+			mismatch.BatchIndex = hexutil.Uint64(index)
+			mismatch.TransitionIndex = hexutil.Uint64(start + i)
+			return false, v.flag(mismatch)
+		}
+		start = end
+	}
+	if err := v.db.Put(VerifierNextBatchIndexDBKey, SerializeBlockNumber(index+1)); err != nil {
+		return false, err
+	}
+	v.statusMu.Lock()
+	v.nextBatchIndex = index + 1
+	v.statusMu.Unlock()
+
+	verifierBatchMeter.Mark(1)
+	logger.Debug("Verified transition batch", "index", index, "transitions", len(batch.transitions))
+	return true, nil
+}
+
+// journalBatch journals the TransitionBatch with the provided index as confirmed, so that
+// its Transitions can be looked up like those of a TransitionBatchBuilder.
+func (v *Verifier) journalBatch(index uint64, batch *TransitionBatch) error {
+	firstTransitionIndex, err := nextTransitionIndex(v.db, index)
+	if err != nil {
+		return err
+	}
+	var firstBlockNumber, lastBlockNumber uint64
+	if len(batch.transitions) > 0 {
+		firstBlockNumber = batch.transitions[0].context.BlockNumber
+		lastBlockNumber = batch.transitions[len(batch.transitions)-1].context.BlockNumber
+	}
+	journaled := newJournaledBatch(batch, BatchConfirmed, firstBlockNumber, lastBlockNumber, firstTransitionIndex)
+
+	dbBatch := v.db.NewBatch()
+	if err := writeJournaledBatch(dbBatch, index, journaled); err != nil {
+		return err
+	}
+	if err := dbBatch.Put(NextBatchIndexDBKey, SerializeBlockNumber(index+1)); err != nil {
+		return err
+	}
+	if err := dbBatch.Put(NextUnconfirmedBatchIndexDBKey, SerializeBlockNumber(index+1)); err != nil {
+		return err
+	}
+	return dbBatch.Write()
+}
+
+// verifyBlock re-executes the Transitions of a Geth Block on top of the derived parent
+// Block and writes the resulting Block if the state root after each transaction matches
+// the committed post-state root. A mismatch is returned along with the index of the
+// Transition it was found at.
+func (v *Verifier) verifyBlock(transitions []*Transition) (int, *VerifierMismatch, error) {
+	var (
+		blockCtx = transitions[0].context
+		txs      = make(types.Transactions, len(transitions))
+		mismatch = &VerifierMismatch{
+			BlockNumber: hexutil.Uint64(blockCtx.BlockNumber),
+			TxHash:      transitions[0].transaction.Hash(),
+			Expected:    transitions[0].postState,
+		}
+	)
+	for i, transition := range transitions {
+		txs[i] = transition.transaction
+	}
+	if blockCtx.BlockNumber == 0 {
+		mismatch.Error = "transition in genesis block"
+		return 0, mismatch, nil
+	}
+	// Blocks derived before a restart are not executed again.
+	if existing := v.chain.GetBlockByNumber(blockCtx.BlockNumber); existing != nil && existing.Root() == transitions[len(transitions)-1].postState &&
+		types.DeriveSha(existing.Transactions()) == types.DeriveSha(txs) {
+		return 0, nil, nil
+	}
+	parent := v.chain.GetBlockByNumber(blockCtx.BlockNumber - 1)
+	if parent == nil {
+		mismatch.Error = fmt.Sprintf("missing parent block %d", blockCtx.BlockNumber-1)
+		return 0, mismatch, nil
+	}
+	statedb, err := v.chain.StateAt(parent.Root())
+	if err != nil {
+		return 0, nil, err
+	}
+	header := blockCtx.header(v.chain.Config(), parent.Header())
+	receipts, logs, roots, err := applyTransactions(v.chain, header, txs, statedb)
+	if err != nil {
+		mismatch.Error = err.Error()
+		return 0, mismatch, nil
+	}
+	for i, transition := range transitions {
+		if roots[i] != transition.postState {
+			mismatch.TxHash = transition.transaction.Hash()
+			mismatch.Expected, mismatch.Actual = transition.postState, roots[i]
+			mismatch.Error = "post-state root mismatch"
+			return i, mismatch, nil
+		}
+	}
+
+	block := types.NewBlock(header, txs, nil, receipts)
+	for _, log := range logs {
+		log.BlockHash = block.Hash()
+	}
+	if _, err := v.chain.WriteBlockWithState(block, receipts, logs, statedb, true); err != nil {
+		return 0, nil, err
+	}
+	return 0, nil, nil
+}
+
+// flag halts the Verifier at the provided mismatch.
+func (v *Verifier) flag(mismatch *VerifierMismatch) error {
+	logger.Error("Transition batch verification failed", "batch", mismatch.BatchIndex, "transition", mismatch.TransitionIndex,
+		"block", mismatch.BlockNumber, "tx", mismatch.TxHash.Hex(), "expected", mismatch.Expected.Hex(), "actual", mismatch.Actual.Hex(), "error", mismatch.Error)
+	verifierMismatchMeter.Mark(1)
+
+	v.statusMu.Lock()
+	v.mismatch = mismatch
+	v.statusMu.Unlock()
+
+	data, err := rlp.EncodeToBytes(mismatch)
+	if err != nil {
+		return err
+	}
+	return v.db.Put(VerifierMismatchDBKey, data)
+}
+
+// readVerifierMismatch retrieves the mismatch the Verifier halted at, returning nil if
+// it has not halted.
+func readVerifierMismatch(db ethdb.KeyValueReader) (*VerifierMismatch, error) {
+	data, _ := db.Get(VerifierMismatchDBKey)
+	if len(data) == 0 {
+		return nil, nil
+	}
+	mismatch := new(VerifierMismatch)
+	if err := rlp.DecodeBytes(data, mismatch); err != nil {
+		return nil, err
+	}
+	return mismatch, nil
+}
@@ -0,0 +1,404 @@
+package rollup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var testVerifierPollInterval = time.Millisecond
+
+// newVerifierTestGenesis returns a genesis funding the test bank account.
+func newVerifierTestGenesis() *core.Genesis {
+	return &core.Genesis{
+		Config: params.AllEthashProtocolChanges,
+		Alloc:  core.GenesisAlloc{crypto.PubkeyToAddress(testBankKey.PublicKey): {Balance: big.NewInt(1_000_000_000_000_000_000)}},
+	}
+}
+
+// createVerifierTestBlocks creates a chain of the provided length on top of the genesis,
+// with a single transaction from the test bank account in every block.
+func createVerifierTestBlocks(t *testing.T, gspec *core.Genesis, n int) types.Blocks {
+	db := rawdb.NewMemoryDatabase()
+	genesis := gspec.MustCommit(db)
+	blocks, _ := core.GenerateChain(gspec.Config, genesis, ethash.NewFaker(), db, n, func(i int, b *core.BlockGen) {
+		tx, err := types.SignTx(types.NewTransaction(uint64(i), testUserAddress, big.NewInt(1), params.TxGas, big.NewInt(0), nil, &testUserAddress, &testRollupTxId, types.QueueOriginSequencer, types.SighashEIP155), types.HomesteadSigner{}, testBankKey)
+		if err != nil {
+			t.Fatalf("unable to sign transaction: %v", err)
+		}
+		b.AddTx(tx)
+	})
+	return blocks
+}
+
+// newVerifierTestChain creates an empty BlockChain with the provided genesis.
+func newVerifierTestChain(t *testing.T, db ethdb.Database, gspec *core.Genesis) *core.BlockChain {
+	gspec.MustCommit(db)
+	chain, err := core.NewBlockChain(db, nil, gspec.Config, ethash.NewFaker(), vm.Config{}, nil)
+	if err != nil {
+		t.Fatalf("unable to create blockchain: %v", err)
+	}
+	return chain
+}
+
+// createVerifierTestBatches splits the blocks into TransitionBatches of the provided size.
+func createVerifierTestBatches(blocks types.Blocks, size int) []*TransitionBatch {
+	var batches []*TransitionBatch
+	for start := 0; start < len(blocks); start += size {
+		end := start + size
+		if end > len(blocks) {
+			end = len(blocks)
+		}
+		batches = append(batches, newTestTransitionBatch(blocks[start:end]))
+	}
+	return batches
+}
+
+// writeBatchFixture writes the TransitionBatches to a fixture file for a FileBatchSource.
+func writeBatchFixture(t *testing.T, batches []*TransitionBatch) string {
+	encoded := make([]hexutil.Bytes, len(batches))
+	for i, batch := range batches {
+		data, err := EncodeTransitionBatch(testCodec, batch)
+		if err != nil {
+			t.Fatalf("unable to encode batch %d: %v", i, err)
+		}
+		encoded[i] = data
+	}
+	data, err := json.Marshal(encoded)
+	if err != nil {
+		t.Fatalf("unable to marshal fixture: %v", err)
+	}
+	file, err := ioutil.TempFile("", "batches-*.json")
+	if err != nil {
+		t.Fatalf("unable to create fixture: %v", err)
+	}
+	defer file.Close()
+	if _, err := file.Write(data); err != nil {
+		t.Fatalf("unable to write fixture: %v", err)
+	}
+	return file.Name()
+}
+
+func startTestVerifier(t *testing.T, db ethdb.Database, chain *core.BlockChain, batches []*TransitionBatch) *Verifier {
+	path := writeBatchFixture(t, batches)
+	defer os.Remove(path)
+
+	source, err := NewFileBatchSource(path)
+	if err != nil {
+		t.Fatalf("unable to load fixture: %v", err)
+	}
+	verifier, err := NewVerifier(db, chain, source, testVerifierPollInterval)
+	if err != nil {
+		t.Fatalf("unable to create verifier: %v", err)
+	}
+	return verifier
+}
+
+func waitForVerifierStatus(t *testing.T, verifier *Verifier, done func(status *VerifierStatus) bool) *VerifierStatus {
+	var status *VerifierStatus
+	waitFor(t, time.Second, func() (bool, string) {
+		status = verifier.Status()
+		return done(status), fmt.Sprintf("verifier, status: %+v", status)
+	})
+	return status
+}
+
+func TestVerifierDerivesChain(t *testing.T) {
+	gspec := newVerifierTestGenesis()
+	blocks := createVerifierTestBlocks(t, gspec, 6)
+	batches := createVerifierTestBatches(blocks, 2)
+
+	db := rawdb.NewMemoryDatabase()
+	chain := newVerifierTestChain(t, db, gspec)
+	defer chain.Stop()
+
+	// Verify the first two batches, then resume with the third one appended.
+	verifier := startTestVerifier(t, db, chain, batches[:2])
+	waitForVerifierStatus(t, verifier, func(status *VerifierStatus) bool { return status.NextBatchIndex == 2 })
+	verifier.Stop()
+
+	verifier = startTestVerifier(t, db, chain, batches)
+	defer verifier.Stop()
+	status := waitForVerifierStatus(t, verifier, func(status *VerifierStatus) bool { return status.NextBatchIndex == 3 })
+
+	if status.State != VerifierVerifying || status.Mismatch != nil {
+		t.Fatalf("expected verifier to be verifying, got %+v", status)
+	}
+	if head := chain.CurrentBlock(); head.NumberU64() != 6 {
+		t.Fatalf("expected head block 6, got %d", head.NumberU64())
+	}
+	for _, block := range blocks {
+		derived := chain.GetBlockByNumber(block.NumberU64())
+		if derived.Root() != block.Root() {
+			t.Fatalf("block %d: expected root %s, got %s", block.NumberU64(), block.Root().Hex(), derived.Root().Hex())
+		}
+		if derived.Transactions()[0].Hash() != block.Transactions()[0].Hash() {
+			t.Fatalf("block %d: unexpected transaction %s", block.NumberU64(), derived.Transactions()[0].Hash().Hex())
+		}
+	}
+}
+
+func TestVerifierDerivesMultiTransactionBlocks(t *testing.T) {
+	gspec := newVerifierTestGenesis()
+	sequencerDb := rawdb.NewMemoryDatabase()
+	genesis := gspec.MustCommit(sequencerDb)
+	blocks, _ := core.GenerateChain(gspec.Config, genesis, ethash.NewFaker(), sequencerDb, 2, func(i int, b *core.BlockGen) {
+		for j := 0; j < 3; j++ {
+			tx, err := types.SignTx(types.NewTransaction(uint64(3*i+j), testUserAddress, big.NewInt(1), params.TxGas, big.NewInt(0), nil, &testUserAddress, &testRollupTxId, types.QueueOriginSequencer, types.SighashEIP155), types.HomesteadSigner{}, testBankKey)
+			if err != nil {
+				t.Fatalf("unable to sign transaction: %v", err)
+			}
+			b.AddTx(tx)
+		}
+	})
+	sequencer := newVerifierTestChain(t, rawdb.NewMemoryDatabase(), gspec)
+	defer sequencer.Stop()
+	if _, err := sequencer.InsertChain(blocks); err != nil {
+		t.Fatalf("unable to import blocks: %v", err)
+	}
+
+	store := NewChainBlockStore(sequencer)
+	batch := NewTransitionBatch(6)
+	for _, block := range blocks {
+		roots, err := store.IntermediateRoots(block)
+		if err != nil {
+			t.Fatalf("block %d: unable to compute intermediate roots: %v", block.NumberU64(), err)
+		}
+		if len(roots) != 3 || roots[2] != block.Root() || roots[0] == roots[1] || roots[1] == roots[2] {
+			t.Fatalf("block %d: unexpected intermediate roots %x", block.NumberU64(), roots)
+		}
+		batch.addBlock(block, roots)
+	}
+
+	db := rawdb.NewMemoryDatabase()
+	chain := newVerifierTestChain(t, db, gspec)
+	defer chain.Stop()
+
+	verifier := startTestVerifier(t, db, chain, []*TransitionBatch{batch})
+	defer verifier.Stop()
+	status := waitForVerifierStatus(t, verifier, func(status *VerifierStatus) bool { return status.NextBatchIndex == 1 || status.Mismatch != nil })
+	if status.Mismatch != nil {
+		t.Fatalf("unexpected mismatch: %+v", status.Mismatch)
+	}
+	for _, block := range blocks {
+		derived := chain.GetBlockByNumber(block.NumberU64())
+		if derived == nil || derived.Root() != block.Root() {
+			t.Fatalf("block %d: expected root %s", block.NumberU64(), block.Root().Hex())
+		}
+		if derived.TxHash() != block.TxHash() {
+			t.Fatalf("block %d: transactions mismatch", block.NumberU64())
+		}
+	}
+
+	// Witnesses of later transactions in a block start from the preceding intermediate root.
+	verifier.Stop()
+	witness, err := NewTransitionWitness(db, chain, 4)
+	if err != nil {
+		t.Fatalf("unable to create witness: %v", err)
+	}
+	if witness.PreStateRoot != batch.transitions[3].postState || witness.PostStateRoot != batch.transitions[4].postState {
+		t.Fatalf("unexpected witness roots %s -> %s", witness.PreStateRoot.Hex(), witness.PostStateRoot.Hex())
+	}
+
+	// A wrong intermediate root is reported at its own transition.
+	batch.transitions[4].postState = common.Hash{0x01}
+	db = rawdb.NewMemoryDatabase()
+	chain = newVerifierTestChain(t, db, gspec)
+	defer chain.Stop()
+
+	verifier = startTestVerifier(t, db, chain, []*TransitionBatch{batch})
+	defer verifier.Stop()
+	status = waitForVerifierStatus(t, verifier, func(status *VerifierStatus) bool { return status.Mismatch != nil })
+	if status.Mismatch.TransitionIndex != 4 || status.Mismatch.TxHash != blocks[1].Transactions()[1].Hash() {
+		t.Fatalf("unexpected mismatch: %+v", status.Mismatch)
+	}
+
+	// The mismatched block is not in the chain, so its witnesses are rebuilt from the journal.
+	verifier.Stop()
+	if chain.GetBlockByNumber(blocks[1].NumberU64()) != nil {
+		t.Fatalf("mismatched block %d written to the chain", blocks[1].NumberU64())
+	}
+	witness, err = NewTransitionWitness(db, chain, 4)
+	if err != nil {
+		t.Fatalf("unable to create witness: %v", err)
+	}
+	if witness.PreStateRoot != batch.transitions[3].postState || witness.PostStateRoot != batch.transitions[4].postState {
+		t.Fatalf("unexpected witness roots %s -> %s", witness.PreStateRoot.Hex(), witness.PostStateRoot.Hex())
+	}
+	if _, err := NewTransitionWitness(db, chain, 5); err == nil || !strings.Contains(err.Error(), ErrPreStateMismatch.Error()) {
+		t.Fatalf("expected pre-state mismatch, got %v", err)
+	}
+}
+
+// Tests that transitions are re-executed in blocks of the difficulty they were mined at.
+func TestVerifierDerivesDifficulty(t *testing.T) {
+	gspec := newVerifierTestGenesis()
+	db := rawdb.NewMemoryDatabase()
+	genesis := gspec.MustCommit(db)
+	// The contract stores the DIFFICULTY it is created at
+	code := []byte{byte(vm.DIFFICULTY), byte(vm.PUSH1), 0x00, byte(vm.SSTORE), byte(vm.STOP)}
+	blocks, _ := core.GenerateChain(gspec.Config, genesis, ethash.NewFaker(), db, 1, func(i int, b *core.BlockGen) {
+		tx, err := types.SignTx(types.NewContractCreation(0, big.NewInt(0), 100000, big.NewInt(0), code, &testUserAddress, &testRollupTxId, types.QueueOriginSequencer), types.HomesteadSigner{}, testBankKey)
+		if err != nil {
+			t.Fatalf("unable to sign transaction: %v", err)
+		}
+		b.AddTx(tx)
+	})
+	if blocks[0].Difficulty().Cmp(common.Big1) <= 0 {
+		t.Fatalf("expected a difficulty above one, got %d", blocks[0].Difficulty())
+	}
+
+	db = rawdb.NewMemoryDatabase()
+	chain := newVerifierTestChain(t, db, gspec)
+	defer chain.Stop()
+
+	verifier := startTestVerifier(t, db, chain, createVerifierTestBatches(blocks, 1))
+	defer verifier.Stop()
+	status := waitForVerifierStatus(t, verifier, func(status *VerifierStatus) bool { return status.NextBatchIndex == 1 || status.Mismatch != nil })
+	if status.Mismatch != nil {
+		t.Fatalf("unexpected mismatch: %+v", status.Mismatch)
+	}
+	if derived := chain.GetBlockByNumber(1); derived.Difficulty().Cmp(blocks[0].Difficulty()) != 0 {
+		t.Fatalf("difficulty mismatch: have %d, want %d", derived.Difficulty(), blocks[0].Difficulty())
+	}
+}
+
+func TestVerifierFlagsMismatch(t *testing.T) {
+	gspec := newVerifierTestGenesis()
+	blocks := createVerifierTestBlocks(t, gspec, 6)
+	batches := createVerifierTestBatches(blocks, 2)
+	badRoot := common.HexToHash("0xbad")
+	batches[1].transitions[1].postState = badRoot
+
+	db := rawdb.NewMemoryDatabase()
+	chain := newVerifierTestChain(t, db, gspec)
+	defer chain.Stop()
+
+	verifier := startTestVerifier(t, db, chain, batches)
+	status := waitForVerifierStatus(t, verifier, func(status *VerifierStatus) bool { return status.State == VerifierHalted })
+	verifier.Stop()
+
+	expected := &VerifierMismatch{
+		BatchIndex:      1,
+		TransitionIndex: 1,
+		BlockNumber:     4,
+		TxHash:          blocks[3].Transactions()[0].Hash(),
+		Expected:        badRoot,
+		Actual:          blocks[3].Root(),
+		Error:           "post-state root mismatch",
+	}
+	if *status.Mismatch != *expected {
+		t.Fatalf("expected mismatch %+v, got %+v", expected, status.Mismatch)
+	}
+	if status.NextBatchIndex != 1 {
+		t.Fatalf("expected next batch index 1, got %d", status.NextBatchIndex)
+	}
+	if head := chain.CurrentBlock().NumberU64(); head != 3 {
+		t.Fatalf("expected head block 3, got %d", head)
+	}
+
+	// The mismatch must survive a restart.
+	verifier = startTestVerifier(t, db, chain, batches)
+	defer verifier.Stop()
+	if status := verifier.Status(); status.State != VerifierHalted || *status.Mismatch != *expected {
+		t.Fatalf("expected verifier to stay halted at %+v, got %+v", expected, status)
+	}
+}
+
+func TestVerifierFlagsInvalidBatch(t *testing.T) {
+	gspec := newVerifierTestGenesis()
+	db := rawdb.NewMemoryDatabase()
+	chain := newVerifierTestChain(t, db, gspec)
+	defer chain.Stop()
+
+	path := writeBatchFixture(t, nil)
+	defer os.Remove(path)
+	if err := ioutil.WriteFile(path, []byte(`["0xff00"]`), 0644); err != nil {
+		t.Fatalf("unable to write fixture: %v", err)
+	}
+	source, err := NewFileBatchSource(path)
+	if err != nil {
+		t.Fatalf("unable to load fixture: %v", err)
+	}
+	verifier, err := NewVerifier(db, chain, source, testVerifierPollInterval)
+	if err != nil {
+		t.Fatalf("unable to create verifier: %v", err)
+	}
+	defer verifier.Stop()
+
+	status := waitForVerifierStatus(t, verifier, func(status *VerifierStatus) bool { return status.State == VerifierHalted })
+	if status.Mismatch.BatchIndex != 0 || status.Mismatch.Error == "" {
+		t.Fatalf("expected batch 0 to be flagged, got %+v", status.Mismatch)
+	}
+}
+
+func TestL1BatchSource(t *testing.T) {
+	sim := newTestL1Backend()
+	defer sim.Close()
+
+	signer := types.HomesteadSigner{}
+	send := func(nonce uint64, to common.Address, data []byte) {
+		tx, err := types.SignTx(types.NewTransaction(nonce, to, big.NewInt(0), 1_000_000, big.NewInt(1), data, nil, nil, types.QueueOriginSequencer, types.SighashEIP155), signer, testL1Key)
+		if err != nil {
+			t.Fatalf("unable to sign L1 transaction: %v", err)
+		}
+		if err := sim.SendTransaction(context.Background(), tx); err != nil {
+			t.Fatalf("unable to send L1 transaction: %v", err)
+		}
+	}
+
+	batch := newTestTransitionBatch(createBlocks(2, 1, true))
+	encoded, err := EncodeTransitionBatch(testCodec, batch)
+	if err != nil {
+		t.Fatalf("unable to encode batch: %v", err)
+	}
+	calldata, err := canonicalTransitionChainAbi.Pack(appendTransitionBatchMethod, encoded)
+	if err != nil {
+		t.Fatalf("unable to pack calldata: %v", err)
+	}
+	// Only the call to the canonical transition chain contract appends a batch.
+	send(0, testUserAddress, calldata)
+	send(1, testCanonicalChainAddr, calldata)
+	sim.Commit()
+
+	source := NewL1BatchSource(sim, testCanonicalChainAddr, 1, 2)
+	for i := 0; i < 2; i++ {
+		if batch, err := source.Batch(context.Background(), 0); batch != nil || err != nil {
+			t.Fatalf("expected unconfirmed batch to be unavailable, got %v, %v", batch, err)
+		}
+		sim.Commit()
+	}
+
+	decoded, err := source.Batch(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("unable to read batch: %v", err)
+	}
+	if decoded == nil || len(decoded.transitions) != len(batch.transitions) {
+		t.Fatalf("expected batch with %d transitions, got %v", len(batch.transitions), decoded)
+	}
+	for i, transition := range batch.transitions {
+		if decoded.transitions[i].transaction.Hash() != transition.transaction.Hash() || decoded.transitions[i].postState != transition.postState {
+			t.Fatalf("transition %d does not match", i)
+		}
+	}
+	if next, err := source.Batch(context.Background(), 1); next != nil || err != nil {
+		t.Fatalf("expected no further batches, got %v, %v", next, err)
+	}
+}
@@ -0,0 +1,5 @@
+// This test checks that the code and data of an error returned by a method
+// are included in the response.
+
+--> {"jsonrpc": "2.0", "id": 2, "method": "test_returnError", "params": []}
+<-- {"jsonrpc":"2.0","id":2,"error":{"code":444,"message":"testError","data":"testError data"}}
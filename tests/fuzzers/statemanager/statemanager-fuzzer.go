@@ -0,0 +1,53 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package statemanager
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// chainConfig is the configuration of an OVM chain, for which the state manager
+// calls are intercepted.
+var chainConfig = &params.ChainConfig{
+	ChainID:        big.NewInt(1),
+	HomesteadBlock: new(big.Int),
+	EIP150Block:    new(big.Int),
+	EIP155Block:    new(big.Int),
+	EIP158Block:    new(big.Int),
+	ByzantiumBlock: new(big.Int),
+	OVM:            &params.OVMConfig{},
+}
+
+// Fuzz calls the state manager with the input as calldata, which must not crash
+// the node however malformed it is.
+func Fuzz(input []byte) int {
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()))
+	// The state manager is only called if its account exists.
+	statedb.SetCode(params.OVMContractsV0.StateManager, []byte{byte(vm.STOP)})
+
+	if _, _, err := runtime.Call(params.OVMContractsV0.StateManager, input, &runtime.Config{ChainConfig: chainConfig, State: statedb}); err != nil {
+		return 0
+	}
+	return 1
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"io/ioutil"
 	"math/big"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -35,6 +36,7 @@ func init() {
 		EIP150Block:         new(big.Int),
 		EIP155Block:         new(big.Int),
 		EIP158Block:         new(big.Int),
+		OVM:                 &params.OVMConfig{StateDump: filepath.Join("..", "core", "vm", "ovm_state_dump.json")},
 	}
 }
 
@@ -156,8 +158,8 @@ func TestSloadAndStore(t *testing.T) {
 	storeCalldata, _ := stateManagerAbi.Pack("setStorage", address, key, value)
 	getCalldata, _ := stateManagerAbi.Pack("getStorage", address, key)
 
-	call(t, state, vm.StateManagerAddress, storeCalldata)
-	getStorageReturnValue, _ := call(t, state, vm.StateManagerAddress, getCalldata)
+	call(t, state, params.OVMContractsV0.StateManager, storeCalldata)
+	getStorageReturnValue, _ := call(t, state, params.OVMContractsV0.StateManager, getCalldata)
 
 	if !bytes.Equal(value[:], getStorageReturnValue) {
 		t.Errorf("Expected %020x; got %020x", value[:], getStorageReturnValue)
@@ -187,15 +189,15 @@ func TestGetAndIncrementNonce(t *testing.T) {
 	getNonceCalldata, _ := stateManagerAbi.Pack("getOvmContractNonce", address)
 	incrementNonceCalldata, _ := stateManagerAbi.Pack("incrementOvmContractNonce", address)
 
-	getStorageReturnValue1, _ := call(t, state, vm.StateManagerAddress, getNonceCalldata)
+	getStorageReturnValue1, _ := call(t, state, params.OVMContractsV0.StateManager, getNonceCalldata)
 
 	expectedReturnValue1 := makeUint256WithUint64(0)
 	if !bytes.Equal(getStorageReturnValue1, expectedReturnValue1) {
 		t.Errorf("Expected %020x; got %020x", expectedReturnValue1, getStorageReturnValue1)
 	}
 
-	call(t, state, vm.StateManagerAddress, incrementNonceCalldata)
-	getStorageReturnValue2, _ := call(t, state, vm.StateManagerAddress, getNonceCalldata)
+	call(t, state, params.OVMContractsV0.StateManager, incrementNonceCalldata)
+	getStorageReturnValue2, _ := call(t, state, params.OVMContractsV0.StateManager, getNonceCalldata)
 
 	expectedReturnValue2 := makeUint256WithUint64(1)
 	if !bytes.Equal(getStorageReturnValue2, expectedReturnValue2) {
@@ -212,7 +214,7 @@ func TestGetCodeContractAddressSucceedsForNormalContract(t *testing.T) {
 
 	getCodeContractAddressCalldata, _ := stateManagerAbi.Pack("getCodeContractAddressFromOvmAddress", address)
 
-	getCodeContractAddressReturnValue, _ := call(t, state, vm.StateManagerAddress, getCodeContractAddressCalldata)
+	getCodeContractAddressReturnValue, _ := call(t, state, params.OVMContractsV0.StateManager, getCodeContractAddressCalldata)
 
 	if !bytes.Equal(getCodeContractAddressReturnValue[12:], address.Bytes()) {
 		t.Errorf("Expected %020x; got %020x", getCodeContractAddressReturnValue[12:], address.Bytes())
@@ -228,7 +230,7 @@ func TestGetCodeContractAddressFailsForDeadContract(t *testing.T) {
 
 	getCodeContractAddressCalldata, _ := stateManagerAbi.Pack("getCodeContractAddressFromOvmAddress", deadAddress)
 
-	_, err := call(t, state, vm.StateManagerAddress, getCodeContractAddressCalldata)
+	_, err := call(t, state, params.OVMContractsV0.StateManager, getCodeContractAddressCalldata)
 
 	if err == nil {
 		t.Errorf("Expected error to be thrown accessing dead address!")
@@ -244,12 +246,89 @@ func TestAssociateCodeContract(t *testing.T) {
 
 	getCodeContractAddressCalldata, _ := stateManagerAbi.Pack("associateCodeContract", address, address)
 
-	_, err := call(t, state, vm.StateManagerAddress, getCodeContractAddressCalldata)
+	_, err := call(t, state, params.OVMContractsV0.StateManager, getCodeContractAddressCalldata)
 	if err != nil {
 		t.Errorf("Failed to call associateCodeContract: %s", err)
 	}
 }
 
+func TestAssociatedCodeContractIsUsed(t *testing.T) {
+	rawStateManagerAbi, _ := ioutil.ReadFile("./StateManagerABI.json")
+	stateManagerAbi, _ := abi.JSON(strings.NewReader(string(rawStateManagerAbi)))
+	state := newState()
+
+	ovmAddress := common.HexToAddress("9999999999999999999999999999999999999999")
+	codeAddress := common.HexToAddress("7777777777777777777777777777777777777777")
+	code := common.FromHex("602a60005260206000f3")
+	state.SetCode(codeAddress, code)
+
+	associateCalldata, _ := stateManagerAbi.Pack("associateCodeContract", ovmAddress, codeAddress)
+	if _, err := call(t, state, params.OVMContractsV0.StateManager, associateCalldata); err != nil {
+		t.Fatalf("Failed to call associateCodeContract: %s", err)
+	}
+
+	getCodeContractAddressCalldata, _ := stateManagerAbi.Pack("getCodeContractAddressFromOvmAddress", ovmAddress)
+	getCodeContractAddressReturnValue, _ := call(t, state, params.OVMContractsV0.StateManager, getCodeContractAddressCalldata)
+	if !bytes.Equal(getCodeContractAddressReturnValue, common.LeftPadBytes(codeAddress.Bytes(), 32)) {
+		t.Errorf("Expected %020x; got %020x", codeAddress.Bytes(), getCodeContractAddressReturnValue)
+	}
+	getCodeContractHashCalldata, _ := stateManagerAbi.Pack("getCodeContractHash", ovmAddress)
+	getCodeContractHashReturnValue, _ := call(t, state, params.OVMContractsV0.StateManager, getCodeContractHashCalldata)
+	if !bytes.Equal(getCodeContractHashReturnValue, crypto.Keccak256(code)) {
+		t.Errorf("Expected %020x; got %020x", crypto.Keccak256(code), getCodeContractHashReturnValue)
+	}
+	getCodeContractBytecodeCalldata, _ := stateManagerAbi.Pack("getCodeContractBytecode", ovmAddress)
+	getCodeContractBytecodeReturnValue, _ := call(t, state, params.OVMContractsV0.StateManager, getCodeContractBytecodeCalldata)
+	var bytecode []byte
+	if err := stateManagerAbi.Unpack(&bytecode, "getCodeContractBytecode", getCodeContractBytecodeReturnValue); err != nil {
+		t.Fatalf("Failed to decode getCodeContractBytecode output: %s", err)
+	}
+	if !bytes.Equal(bytecode, code) {
+		t.Errorf("Expected %020x; got %020x", code, bytecode)
+	}
+}
+
+func TestCreateRegistersCodeContract(t *testing.T) {
+	state := newState()
+	applyMessageToState(state, OTHER_FROM_ADDR, ZERO_ADDRESS, GAS_LIMIT, common.FromHex(returnFortyTwoInitCode))
+
+	address := crypto.CreateAddress(OTHER_FROM_ADDR, 0)
+	if codeAddress := getCodeContractMapping(state, address); codeAddress != address {
+		t.Errorf("Expected code contract %s; got %s", address.Hex(), codeAddress.Hex())
+	}
+}
+
+func TestCreate2RegistersCodeContract(t *testing.T) {
+	// The factory passes a salt and the init code appended to its own code to
+	// ovmCREATE2, and deploys the returned address as its runtime code.
+	childInitCode := common.FromHex(returnFortyTwoInitCode)
+	salt := common.BigToHash(big.NewInt(7))
+	selector := crypto.Keccak256([]byte("ovmCREATE2()"))[:4]
+	factoryLength := byte(42) // length of the factory code preceding the child init code
+	factoryInitCode := append([]byte{
+		byte(vm.PUSH4), selector[0], selector[1], selector[2], selector[3], byte(vm.PUSH1), 0xe0, byte(vm.SHL), byte(vm.PUSH1), 0x00, byte(vm.MSTORE),
+		byte(vm.PUSH1), salt[31], byte(vm.PUSH1), 0x04, byte(vm.MSTORE),
+		byte(vm.PUSH1), byte(len(childInitCode)), byte(vm.PUSH1), factoryLength, byte(vm.PUSH1), 0x24, byte(vm.CODECOPY),
+		byte(vm.PUSH1), 0x20, byte(vm.PUSH1), 0x80, byte(vm.PUSH1), byte(0x24 + len(childInitCode)), byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00, byte(vm.CALLER), byte(vm.GAS), byte(vm.CALL), byte(vm.POP),
+		byte(vm.PUSH1), 0x20, byte(vm.PUSH1), 0x80, byte(vm.RETURN),
+	}, childInitCode...)
+
+	state := newState()
+	applyMessageToState(state, OTHER_FROM_ADDR, ZERO_ADDRESS, GAS_LIMIT, factoryInitCode)
+
+	factory := crypto.CreateAddress(OTHER_FROM_ADDR, 0)
+	child := crypto.CreateAddress2(factory, salt, crypto.Keccak256(childInitCode))
+	if created := common.BytesToAddress(state.GetCode(factory)); created != child {
+		t.Fatalf("Expected ovmCREATE2 to create %s; got %s", child.Hex(), created.Hex())
+	}
+	if codeAddress := getCodeContractMapping(state, child); codeAddress != child {
+		t.Errorf("Expected code contract %s; got %s", child.Hex(), codeAddress.Hex())
+	}
+	if code := state.GetCode(child); !bytes.Equal(code, common.FromHex("602a60005260206000f3")) {
+		t.Errorf("Unexpected code contract bytecode %x", code)
+	}
+}
+
 func TestGetCodeContractBytecode(t *testing.T) {
 	state := newState()
 	initCode, _ := hex.DecodeString("6080604052348015600f57600080fd5b5060b28061001e6000396000f3fe6080604052348015600f57600080fd5b506004361060285760003560e01c80639b0b0fda14602d575b600080fd5b606060048036036040811015604157600080fd5b8101908080359060200190929190803590602001909291905050506062565b005b8060008084815260200190815260200160002081905550505056fea265627a7a7231582053ac32a8b70d1cf87fb4ebf5a538ea9d9e773351e6c8afbc4bf6a6c273187f4a64736f6c63430005110032")
@@ -270,13 +349,23 @@ func TestGetCodeContractHash(t *testing.T) {
 	rawStateManagerAbi, _ := ioutil.ReadFile("./StateManagerABI.json")
 	stateManagerAbi, _ := abi.JSON(strings.NewReader(string(rawStateManagerAbi)))
 	getCodeContractBytecodeCalldata, _ := stateManagerAbi.Pack("getCodeContractHash", crypto.CreateAddress(OTHER_FROM_ADDR, 0))
-	getCodeContractBytecodeReturnValue, _ := call(t, state, vm.StateManagerAddress, getCodeContractBytecodeCalldata)
+	getCodeContractBytecodeReturnValue, _ := call(t, state, params.OVMContractsV0.StateManager, getCodeContractBytecodeCalldata)
 	expectedCreatedCodeHash := crypto.Keccak256(common.FromHex("6080604052348015600f57600080fd5b506004361060285760003560e01c80639b0b0fda14602d575b600080fd5b606060048036036040811015604157600080fd5b8101908080359060200190929190803590602001909291905050506062565b005b8060008084815260200190815260200160002081905550505056fea265627a7a7231582053ac32a8b70d1cf87fb4ebf5a538ea9d9e773351e6c8afbc4bf6a6c273187f4a64736f6c63430005110032"))
 	if !bytes.Equal(getCodeContractBytecodeReturnValue, expectedCreatedCodeHash) {
 		t.Errorf("Expected %020x; got %020x", getCodeContractBytecodeReturnValue, expectedCreatedCodeHash)
 	}
 }
 
+// returnFortyTwoInitCode deploys a contract returning 42 from every call.
+const returnFortyTwoInitCode = "600a600c600039600a6000f3602a60005260206000f3"
+
+// getCodeContractMapping reads the code contract of an OVM contract from the
+// mapping stored by the state manager.
+func getCodeContractMapping(state *state.StateDB, address common.Address) common.Address {
+	key := crypto.Keccak256Hash(common.LeftPadBytes(address.Bytes(), 32), common.LeftPadBytes([]byte{2}, 32))
+	return common.BytesToAddress(state.GetState(params.OVMContractsV0.StateManager, key).Bytes())
+}
+
 func makeUint256WithUint64(num uint64) []byte {
 	b := make([]byte, 8)
 	binary.BigEndian.PutUint64(b, num)
@@ -285,10 +374,12 @@ func makeUint256WithUint64(num uint64) []byte {
 }
 
 func newState() *state.StateDB {
-	db := state.NewDatabase(rawdb.NewMemoryDatabase())
-	state, _ := state.New(common.Hash{}, db)
-	core.ApplyOvmStateToState(state)
-	_, _ = state.Commit(false)
+	db := rawdb.NewMemoryDatabase()
+	genesis, err := (&core.Genesis{Config: &chainConfig}).ToBlock(db)
+	if err != nil {
+		panic(err)
+	}
+	state, _ := state.New(genesis.Root(), state.NewDatabase(db))
 	return state
 }
 